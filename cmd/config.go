@@ -0,0 +1,48 @@
+package main
+
+import (
+	"io"
+	"log"
+
+	"github.com/poolcamacho/interviews-service/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// runConfig runs the config command
+// Only "config print" exists; it writes the configuration the other commands would use as YAML, so the
+// output can be kept as a configuration file once the redacted secrets are filled in.
+func runConfig(args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("config", "print", `Prints the effective configuration, after the YAML file, the environment and flags, with
+secrets redacted. Exits with status 1 and lists the problems when the configuration is invalid.`, stderr)
+	settings := config.RegisterFlags(flags)
+
+	if len(args) == 0 || args[0] != "print" {
+		if code, ok := parseFlags(flags, args); !ok {
+			return code
+		}
+		return usageError(flags, "missing config command, only print is supported")
+	}
+	if code, ok := parseFlags(flags, args[1:]); !ok {
+		return code
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "unexpected argument %q", flags.Arg(0))
+	}
+
+	cfg, err := config.Load(settings)
+	if err != nil {
+		log.Printf("Invalid configuration: %v", err)
+		return exitFailure
+	}
+	encoder := yaml.NewEncoder(stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(cfg.Redacted()); err != nil {
+		log.Printf("Failed to write the configuration: %v", err)
+		return exitFailure
+	}
+	if err := cfg.Validate(); err != nil {
+		log.Printf("Invalid configuration:\n%v", err)
+		return exitFailure
+	}
+	return exitOK
+}
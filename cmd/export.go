@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/pkg/config"
+	"github.com/poolcamacho/interviews-service/pkg/db"
+)
+
+// csvHeader names the columns written by writeCSV
+var csvHeader = []string{"id", "candidate_id", "job_id", "interview_date", "stage", "status", "candidate_email",
+	"feedback", "cancellation_reason", "panel"}
+
+// runExport runs the export command
+// Writes the interviews to stdout so they can be piped to a file or another tool.
+func runExport(args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("export", "", "Writes every interview with its panel to stdout.", stderr)
+	format := flags.String("format", "json", "output format, json or csv")
+	status := flags.String("status", "", "only export interviews in this status, e.g. scheduled")
+	settings := config.RegisterFlags(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "unexpected argument %q", flags.Arg(0))
+	}
+	if *format != "json" && *format != "csv" {
+		return usageError(flags, "invalid format %q", *format)
+	}
+
+	cfg, err := loadDatabaseConfig(settings)
+	if err != nil {
+		log.Printf("Invalid configuration:\n%v", err)
+		return exitFailure
+	}
+	interviewRepository, _, err := newInterviewStore(cfg, db.Connect(cfg.DatabaseURL))
+	if err != nil {
+		log.Printf("Invalid DB_QUERY_TIMEOUT: %v", err)
+		return exitFailure
+	}
+	var interviews []*domain.Interview
+	if *status == "" {
+		interviews, err = interviewRepository.FindAll(context.Background())
+	} else {
+		interviews, err = interviewRepository.FindByStatus(context.Background(), domain.InterviewStatus(*status))
+	}
+	if err != nil {
+		log.Printf("Failed to retrieve interviews: %v", err)
+		return exitFailure
+	}
+
+	if *format == "csv" {
+		err = writeCSV(stdout, interviews)
+	} else {
+		err = writeJSON(stdout, interviews)
+	}
+	if err != nil {
+		log.Printf("Failed to write interviews: %v", err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+// writeJSON writes the interviews as an indented JSON array, empty rather than null when there are none
+func writeJSON(w io.Writer, interviews []*domain.Interview) error {
+	if interviews == nil {
+		interviews = []*domain.Interview{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(interviews)
+}
+
+// writeCSV writes the interviews as CSV with a header row
+// The panel column lists the panelists as "name <email>", separated by semicolons.
+func writeCSV(w io.Writer, interviews []*domain.Interview) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, i := range interviews {
+		panel := make([]string, 0, len(i.Panel))
+		for _, p := range i.Panel {
+			panel = append(panel, p.Name+" <"+p.Email+">")
+		}
+		if err := writer.Write([]string{
+			strconv.Itoa(i.ID),
+			strconv.Itoa(i.CandidateID),
+			strconv.Itoa(i.JobID),
+			i.InterviewDate.UTC().Format(time.RFC3339),
+			i.Stage,
+			string(i.Status),
+			i.CandidateEmail,
+			i.Feedback,
+			i.CancellationReason,
+			strings.Join(panel, "; "),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -1,17 +1,19 @@
 package main
 
 import (
-	"log"
-
-	"github.com/gin-gonic/gin"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	_ "time/tzdata" // Time zones of FEEDBACK_TIMEZONE on images without a zoneinfo database
+
+	"github.com/poolcamacho/interviews-service/internal/leader"
 	"github.com/poolcamacho/interviews-service/internal/repository"
-	"github.com/poolcamacho/interviews-service/internal/service"
-	"github.com/poolcamacho/interviews-service/internal/transport"
 	"github.com/poolcamacho/interviews-service/pkg/config"
 	"github.com/poolcamacho/interviews-service/pkg/db"
-	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
-	swaggerFiles "github.com/swaggo/files"
-	ginSwagger "github.com/swaggo/gin-swagger"
 
 	_ "github.com/poolcamacho/interviews-service/docs" // Import Swagger docs
 )
@@ -31,66 +33,185 @@ import (
 // @host localhost:8080
 // @BasePath /
 
+// Exit codes shared by every command
+const (
+	exitOK      = 0 // The command succeeded
+	exitFailure = 1 // The command failed, e.g. the database could not be reached
+	exitUsage   = 2 // The command line is invalid
+)
+
+// command is a subcommand of the binary
+type command struct {
+	name    string                                            // Name typed after the binary, e.g. migrate
+	summary string                                            // One-line description shown by help
+	run     func(args []string, stdout, stderr io.Writer) int // Runs the command with the arguments following its name and returns the exit code
+}
+
+// commands lists the subcommands in the order help shows them
+func commands() []command {
+	return []command{
+		{name: "serve", summary: "start the API server and background workers (default)", run: runServe},
+		{name: "migrate", summary: "apply, revert or list database schema migrations", run: runMigrate},
+		{name: "seed", summary: "load fixture interviews into the database", run: runSeed},
+		{name: "export", summary: "write every interview to stdout as JSON or CSV", run: runExport},
+		{name: "token", summary: "mint a JWT for testing the API", run: runToken},
+		{name: "config", summary: "print the effective configuration with secrets redacted", run: runConfig},
+		{name: "help", summary: "show help for the binary or a command", run: runHelp},
+	}
+}
+
 // main initializes and starts the Interview Service API server
 // @Summary Start the Interview Service
 // @Description Initializes the Interview Service API with routes and Swagger documentation.
 // @Tags Initialization
 func main() {
-	// Load configuration
-	cfg := config.Load()
-
-	// Connect to the database
-	dbConn := db.Connect(cfg.DatabaseURL)
-
-	// Initialize repository
-	interviewRepository := repository.NewInterviewRepository(dbConn)
-
-	// Initialize service
-	interviewService := service.NewInterviewService(interviewRepository)
-
-	// Initialize Gin and routes
-	r := gin.Default()
-	handler := transport.NewInterviewHandler(interviewService)
-
-	// Swagger route
-	// @Summary Swagger Documentation
-	// @Description Provides Swagger UI for the API
-	// @Tags Swagger
-	// @Router /swagger/*any [get]
-	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
-
-	// Register routes
-	// @Summary Get all interviews
-	// @Description Fetch a list of all interviews
-	// @Tags Interviews
-	// @Produce json
-	// @Success 200 {array} domain.Interview
-	// @Router /interviews [get]
-	r.GET("/interviews", jwtUtil.AuthMiddleware(cfg.JWTSecretKey), handler.GetInterviews)
-
-	// @Summary Create a new interview
-	// @Description Add a new interview record to the database
-	// @Tags Interviews
-	// @Accept json
-	// @Produce json
-	// @Param request body domain.Interview true "Interview Data"
-	// @Success 201 {object} map[string]string "Interview created successfully"
-	// @Failure 400 {object} map[string]string "Invalid request"
-	// @Failure 500 {object} map[string]string "Internal server error"
-	// @Router /interviews [post]
-	r.POST("/interviews", jwtUtil.AuthMiddleware(cfg.JWTSecretKey), handler.CreateInterview)
-
-	// Health check route
-	// @Summary Health Check
-	// @Description Returns the health status of the service
-	// @Tags Health
-	// @Produce json
-	// @Success 200 {object} map[string]string "Service is healthy"
-	// @Router /health [get]
-	r.GET("/health", func(c *gin.Context) { c.JSON(200, gin.H{"status": "healthy"}) })
-
-	log.Printf("Interview Service is running on port %s", cfg.Port)
-	if err := r.Run(":" + cfg.Port); err != nil {
-		log.Fatalf("Failed to start server: %v", err)
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run dispatches the command line to a command
+// Without a command the server is started, so deployments running the bare binary keep working.
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		switch {
+		case args[0] == "-h" || args[0] == "-help" || args[0] == "--help":
+			return runHelp(nil, stdout, stderr)
+		case !strings.HasPrefix(args[0], "-"):
+			for _, cmd := range commands() {
+				if cmd.name == args[0] {
+					return cmd.run(args[1:], stdout, stderr)
+				}
+			}
+			fmt.Fprintf(stderr, "unknown command %q\n\n", args[0])
+			printUsage(stderr)
+			return exitUsage
+		}
+	}
+	return runServe(args, stdout, stderr)
+}
+
+// runHelp runs the help command
+// Shows the list of commands, or the usage of the command given as argument.
+func runHelp(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stdout)
+		return exitOK
+	}
+	for _, cmd := range commands() {
+		if cmd.name == args[0] && cmd.name != "help" {
+			return cmd.run([]string{"-h"}, stdout, stdout)
+		}
+	}
+	fmt.Fprintf(stderr, "unknown command %q\n\n", args[0])
+	printUsage(stderr)
+	return exitUsage
+}
+
+// printUsage writes the list of commands
+func printUsage(w io.Writer) {
+	fmt.Fprint(w, "usage: interviews-service [command] [flags]\n\ncommands:\n")
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-8s  %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprint(w, "\nRun 'interviews-service help <command>' for the flags of a command.\n"+
+		"Settings are read from a YAML file, the environment and flags, see the README.\n")
+}
+
+// newFlagSet creates the flag set of a command with a consistent usage message
+// usage lists the positional arguments after the flags, empty when the command takes none.
+func newFlagSet(name, usage, description string, output io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.Usage = func() {
+		fmt.Fprintf(output, "usage: %s\n\n%s\n", strings.TrimSpace("interviews-service "+name+" [flags] "+usage), description)
+		hasFlags := false
+		flags.VisitAll(func(*flag.Flag) { hasFlags = true })
+		if hasFlags {
+			fmt.Fprint(output, "\nflags:\n")
+			flags.PrintDefaults()
+		}
+	}
+	return flags
+}
+
+// parseFlags parses the arguments of a command
+// Returns ok false when the command should stop, with exitOK after -h and exitUsage after an invalid flag;
+// the flag package has already printed the usage in both cases.
+func parseFlags(flags *flag.FlagSet, args []string) (code int, ok bool) {
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK, false
+		}
+		return exitUsage, false
+	}
+	return exitOK, true
+}
+
+// usageError reports an invalid command line followed by the usage of the command
+func usageError(flags *flag.FlagSet, format string, args ...interface{}) int {
+	fmt.Fprintf(flags.Output(), format+"\n\n", args...)
+	flags.Usage()
+	return exitUsage
+}
+
+// loadConfig loads the configuration of a command and checks that it is valid
+// flags holds the settings given on the command line, see config.RegisterFlags.
+func loadConfig(flags *config.Flags) (*config.Config, error) {
+	cfg, err := config.Load(flags)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadDatabaseConfig loads the configuration of a command that works on the database of DATABASE_URL
+func loadDatabaseConfig(flags *config.Flags) (*config.Config, error) {
+	cfg, err := loadConfig(flags)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Storage != "database" {
+		return nil, fmt.Errorf("STORAGE is %s, this command needs the database of DATABASE_URL", cfg.Storage)
+	}
+	return cfg, nil
+}
+
+// newInterviewStore creates the interview repository and the transaction manager writes run through
+// The MySQL, PostgreSQL or SQLite implementation is picked from the scheme of DATABASE_URL. Interview queries
+// are cancelled when they outlive DB_QUERY_TIMEOUT or the caller's context.
+func newInterviewStore(cfg *config.Config, dbConn *sql.DB) (repository.InterviewRepository, repository.TxManager, error) {
+	queryTimeout, err := time.ParseDuration(cfg.QueryTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	txConfig := repository.DefaultTxConfig()
+	txConfig.Timeout = queryTimeout
+	switch db.DialectOf(cfg.DatabaseURL) {
+	case db.Postgres:
+		return repository.NewPostgresInterviewRepository(dbConn, queryTimeout),
+			repository.NewPostgresTxManager(dbConn, txConfig),
+			nil
+	case db.SQLite:
+		return repository.NewSQLiteInterviewRepository(dbConn, queryTimeout),
+			repository.NewSQLiteTxManager(dbConn, txConfig),
+			nil
+	}
+	return repository.NewInterviewRepository(dbConn, queryTimeout),
+		repository.NewTxManager(dbConn, txConfig), // Retries units of work that lose a deadlock
+		nil
+}
+
+// newLeaderLock creates the lock electing the replica that runs a background job
+// Replicas on MySQL or PostgreSQL compete for a lock of the database; SQLite and memory storage run as a
+// single instance and need none.
+func newLeaderLock(pool *sql.DB, dialect db.Dialect, name string) leader.Lock {
+	switch {
+	case pool == nil || dialect == db.SQLite:
+		return nil
+	case dialect == db.Postgres:
+		return leader.NewPostgresLock(pool, name)
 	}
+	return leader.NewMySQLLock(pool, name)
 }
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_Usage(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []string
+		code   int
+		stdout string
+		stderr string
+	}{
+		{name: "help", args: []string{"help"}, code: exitOK, stdout: "commands:"},
+		{name: "help flag", args: []string{"--help"}, code: exitOK, stdout: "commands:"},
+		{name: "help for a command", args: []string{"help", "export"}, code: exitOK, stdout: "-format"},
+		{name: "command help flag", args: []string{"migrate", "-h"}, code: exitOK, stderr: "down N"},
+		{name: "unknown command", args: []string{"deploy"}, code: exitUsage, stderr: `unknown command "deploy"`},
+		{name: "unknown flag", args: []string{"export", "-verbose"}, code: exitUsage, stderr: "flag provided but not defined"},
+		{name: "invalid argument", args: []string{"migrate", "down", "zero"}, code: exitUsage, stderr: `invalid number of migrations "zero"`},
+		{name: "invalid format", args: []string{"export", "-format", "xml"}, code: exitUsage, stderr: `invalid format "xml"`},
+		{name: "missing subcommand", args: []string{"token"}, code: exitUsage, stderr: "only mint is supported"},
+		{name: "missing config subcommand", args: []string{"config"}, code: exitUsage, stderr: "only print is supported"},
+		{name: "config flags", args: []string{"help", "serve"}, code: exitOK, stdout: "overrides REMINDER_OFFSETS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			var stdout, stderr bytes.Buffer
+
+			// Execute
+			code := run(tt.args, &stdout, &stderr)
+
+			// Assertions
+			assert.Equal(t, tt.code, code)
+			assert.Contains(t, stdout.String(), tt.stdout)
+			assert.Contains(t, stderr.String(), tt.stderr)
+		})
+	}
+}
+
+func TestRunToken(t *testing.T) {
+	// Setup
+	var stdout, stderr bytes.Buffer
+
+	// Execute
+	code := run([]string{"token", "mint", "-secret", "test-secret", "-sub", "recruiter", "-claim", "role=admin", "-claim", "level=3"},
+		&stdout, &stderr)
+
+	// Assertions
+	require.Equal(t, exitOK, code, stderr.String())
+	claims, err := jwtUtil.ValidateToken("test-secret", strings.TrimSpace(stdout.String()))
+	require.NoError(t, err)
+	assert.Equal(t, "recruiter", claims["sub"])
+	assert.Equal(t, "admin", claims["role"])
+	assert.Equal(t, float64(3), claims["level"])
+	assert.InDelta(t, time.Now().Add(time.Hour).Unix(), claims["exp"], 5)
+}
+
+func TestRunConfig(t *testing.T) {
+	// Setup
+	t.Setenv("CONFIG_FILE", "")
+	t.Setenv("APP_ENV", "production")
+	t.Setenv("DATABASE_URL", "admin:db-password@tcp(db:3306)/interviews")
+	t.Setenv("JWT_SECRET_KEY", "0f8b6c1d2e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a")
+	var stdout, stderr, invalidOut bytes.Buffer
+
+	// Execute
+	code := run([]string{"config", "print", "-port", "4000"}, &stdout, &stderr)
+	t.Setenv("JWT_SECRET_KEY", "tu-secreto-jwt")
+	invalidCode := run([]string{"config", "print"}, &invalidOut, &stderr)
+
+	// Assertions
+	require.Equal(t, exitOK, code, stderr.String())
+	assert.Contains(t, stdout.String(), `port: "4000"`)
+	assert.Contains(t, stdout.String(), "database_url: admin:xxxxx@tcp(db:3306)/interviews")
+	assert.Contains(t, stdout.String(), "jwt_secret_key: xxxxx")
+	assert.NotContains(t, stdout.String(), "db-password")
+	assert.NotContains(t, stdout.String(), "0f8b6c1d")
+	assert.Equal(t, exitFailure, invalidCode)
+	assert.Contains(t, invalidOut.String(), "jwt_secret_key: xxxxx") // Printed so the problem can be found
+}
+
+func TestStopAll(t *testing.T) {
+	// Setup
+	var stopped []string
+	var mu sync.Mutex
+	record := func(name string) func() {
+		return func() {
+			mu.Lock()
+			defer mu.Unlock()
+			stopped = append(stopped, name)
+		}
+	}
+	hung := make(chan struct{})
+	defer close(hung)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	// Execute
+	allStopped := stopAll(context.Background(), stopper{name: "relay", stop: record("relay")}, stopper{name: "detector", stop: record("detector")})
+	gaveUp := !stopAll(ctx, stopper{name: "dispatcher", stop: record("dispatcher")}, stopper{name: "notifier", stop: func() { <-hung }})
+
+	// Assertions
+	assert.True(t, allStopped)
+	assert.True(t, gaveUp)
+	assert.ElementsMatch(t, []string{"relay", "detector", "dispatcher"}, stopped)
+}
+
+func TestRebaseDates(t *testing.T) {
+	// Setup
+	now := time.Date(2026, 3, 10, 18, 0, 0, 0, time.UTC)
+	interviews := []*domain.Interview{
+		{InterviewDate: time.Date(2025, 1, 8, 15, 0, 0, 0, time.UTC)},
+		{InterviewDate: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC)},
+	}
+
+	// Execute
+	rebaseDates(interviews, now)
+
+	// Assertions
+	assert.Equal(t, time.Date(2026, 3, 13, 15, 0, 0, 0, time.UTC), interviews[0].InterviewDate)
+	assert.Equal(t, time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC), interviews[1].InterviewDate)
+}
+
+func TestWriteCSV(t *testing.T) {
+	// Setup
+	var out bytes.Buffer
+	interviews := []*domain.Interview{{
+		ID: 1, CandidateID: 101, JobID: 201, InterviewDate: time.Date(2025, 1, 6, 9, 0, 0, 0, time.UTC),
+		Stage: "onsite", Status: domain.StatusScheduled, Feedback: "Strong, but slow",
+		Panel: []domain.Panelist{{Name: "Alice", Email: "alice@example.com"}, {Name: "Bob", Email: "bob@example.com"}},
+	}}
+
+	// Execute
+	err := writeCSV(&out, interviews)
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, "id,candidate_id,job_id,interview_date,stage,status,candidate_email,feedback,cancellation_reason,panel\n"+
+		`1,101,201,2025-01-06T09:00:00Z,onsite,scheduled,,"Strong, but slow",,Alice <alice@example.com>; Bob <bob@example.com>`+"\n",
+		out.String())
+}
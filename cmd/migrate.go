@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+
+	"github.com/poolcamacho/interviews-service/internal/migrate"
+	"github.com/poolcamacho/interviews-service/pkg/config"
+	"github.com/poolcamacho/interviews-service/pkg/db"
+)
+
+// runMigrate runs the migrate command
+// Applies, reverts or lists the embedded schema migrations and prints what was done to stdout.
+func runMigrate(args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("migrate", "up | down N | status", `Manages the database schema:
+  up        apply every pending migration
+  down N    revert the last N applied migrations
+  status    list the migrations and when they were applied`, stderr)
+	settings := config.RegisterFlags(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	var down int
+	switch flags.Arg(0) {
+	case "up", "status":
+		if flags.NArg() != 1 {
+			return usageError(flags, "unexpected argument %q", flags.Arg(1))
+		}
+	case "down":
+		if flags.NArg() != 2 {
+			return usageError(flags, "down takes the number of migrations to revert")
+		}
+		n, err := strconv.Atoi(flags.Arg(1))
+		if err != nil || n < 1 {
+			return usageError(flags, "invalid number of migrations %q", flags.Arg(1))
+		}
+		down = n
+	case "":
+		return usageError(flags, "missing migrate command")
+	default:
+		return usageError(flags, "unknown migrate command %q", flags.Arg(0))
+	}
+
+	cfg, err := loadDatabaseConfig(settings)
+	if err != nil {
+		log.Printf("Invalid configuration:\n%v", err)
+		return exitFailure
+	}
+	dsn := cfg.DatabaseURL
+	dialect := db.DialectOf(dsn)
+	migrations, err := migrate.Load(dialect)
+	if err != nil {
+		log.Printf("Failed to load migrations: %v", err)
+		return exitFailure
+	}
+	migrator := migrate.NewMigrator(db.Connect(dsn), dialect, migrations)
+	ctx := context.Background()
+
+	switch flags.Arg(0) {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Fprintf(stdout, "applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Printf("Migration failed: %v", err)
+			return exitFailure
+		}
+		if len(applied) == 0 {
+			fmt.Fprintln(stdout, "schema is up to date")
+		}
+	case "down":
+		reverted, err := migrator.Down(ctx, down)
+		for _, m := range reverted {
+			fmt.Fprintf(stdout, "reverted %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Printf("Migration failed: %v", err)
+			return exitFailure
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			log.Printf("Failed to read migration status: %v", err)
+			return exitFailure
+		}
+		for _, s := range statuses {
+			state := "pending"
+			if s.AppliedAt != nil {
+				state = "applied " + s.AppliedAt.UTC().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(stdout, "%04d_%-32s %s\n", s.Version, s.Name, state)
+		}
+	}
+	return exitOK
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/pkg/config"
+	"github.com/poolcamacho/interviews-service/pkg/db"
+)
+
+// fixtureInterviews holds the interviews created by seed when no file is given
+//
+//go:embed fixtures/interviews.json
+var fixtureInterviews []byte
+
+// runSeed runs the seed command
+// Creates the fixture interviews through the interview service, so their interview.created events
+// are recorded in the outbox like those of any other interview.
+func runSeed(args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("seed", "", `Creates fixture interviews for local development. Candidate and job IDs are not checked
+against the candidates and jobs services, and the fixture addresses use example.com.`, stderr)
+	file := flags.String("file", "", "JSON array of interviews to create instead of the built-in fixtures")
+	keepDates := flags.Bool("keep-dates", false, "keep the dates of the fixtures instead of moving them to start tomorrow")
+	settings := config.RegisterFlags(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "unexpected argument %q", flags.Arg(0))
+	}
+
+	data := fixtureInterviews
+	if *file != "" {
+		var err error
+		if data, err = os.ReadFile(*file); err != nil {
+			log.Printf("Failed to read fixtures: %v", err)
+			return exitFailure
+		}
+	}
+	var interviews []*domain.Interview
+	if err := json.Unmarshal(data, &interviews); err != nil {
+		log.Printf("Invalid fixtures: %v", err)
+		return exitFailure
+	}
+	if !*keepDates {
+		rebaseDates(interviews, time.Now().UTC())
+	}
+
+	cfg, err := loadDatabaseConfig(settings)
+	if err != nil {
+		log.Printf("Invalid configuration:\n%v", err)
+		return exitFailure
+	}
+	interviewRepository, txManager, err := newInterviewStore(cfg, db.Connect(cfg.DatabaseURL))
+	if err != nil {
+		log.Printf("Invalid DB_QUERY_TIMEOUT: %v", err)
+		return exitFailure
+	}
+	interviewService := service.NewInterviewService(interviewRepository, txManager, nil, nil)
+
+	for _, interview := range interviews {
+		if err := interviewService.AddInterview(context.Background(), interview); err != nil {
+			log.Printf("Failed to create the interview of candidate %d: %v", interview.CandidateID, err)
+			return exitFailure
+		}
+		fmt.Fprintf(stdout, "created interview %d for candidate %d on %s\n",
+			interview.ID, interview.CandidateID, interview.InterviewDate.Format(time.RFC3339))
+	}
+	return exitOK
+}
+
+// rebaseDates moves interviews by whole days so the earliest one falls on the day after now
+// Times of day and the gaps between interviews are kept, so fixtures stay upcoming whenever they are loaded.
+func rebaseDates(interviews []*domain.Interview, now time.Time) {
+	if len(interviews) == 0 {
+		return
+	}
+	earliest := interviews[0].InterviewDate
+	for _, interview := range interviews[1:] {
+		if interview.InterviewDate.Before(earliest) {
+			earliest = interview.InterviewDate
+		}
+	}
+
+	day := 24 * time.Hour
+	shift := now.Truncate(day).Add(day).Sub(earliest.Truncate(day))
+	for _, interview := range interviews {
+		interview.InterviewDate = interview.InterviewDate.Add(shift)
+	}
+}
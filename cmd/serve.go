@@ -0,0 +1,586 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/attention"
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/consumer"
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/graph"
+	"github.com/poolcamacho/interviews-service/internal/health"
+	"github.com/poolcamacho/interviews-service/internal/migrate"
+	"github.com/poolcamacho/interviews-service/internal/notification"
+	"github.com/poolcamacho/interviews-service/internal/nudge"
+	"github.com/poolcamacho/interviews-service/internal/outbox"
+	"github.com/poolcamacho/interviews-service/internal/reminder"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/internal/sla"
+	"github.com/poolcamacho/interviews-service/internal/tracing"
+	"github.com/poolcamacho/interviews-service/internal/transport"
+	"github.com/poolcamacho/interviews-service/internal/webhook"
+	"github.com/poolcamacho/interviews-service/pkg/config"
+	"github.com/poolcamacho/interviews-service/pkg/db"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	swaggerFiles "github.com/swaggo/files"
+	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
+)
+
+// runServe runs the serve command, the default when no command is given
+// Starts the API server and the background workers and blocks until SIGTERM or SIGINT. Shutdown then fails the
+// readiness probe, drains the servers, stops the workers and closes the database, all within SHUTDOWN_GRACE_PERIOD.
+// Fatal startup errors are logged and exit the process with status 1.
+func runServe(args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("serve", "", `Starts the HTTP, gRPC and GraphQL APIs and the background workers.
+On SIGHUP the configuration is read again and the log level, reminder offsets, feedback SLA and legacy route dates are applied.`, stderr)
+	settings := config.RegisterFlags(flags)
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "unexpected argument %q", flags.Arg(0))
+	}
+
+	// Load configuration
+	cfg, err := loadConfig(settings)
+	if err != nil {
+		log.Printf("Invalid configuration:\n%v", err)
+		return exitFailure
+	}
+
+	// Log JSON lines with sensitive fields redacted; LOG_LEVEL is applied again on reload
+	logLevel := new(slog.LevelVar)
+	level, _ := logger.ParseLevel(cfg.LogLevel) // Checked by Validate
+	logLevel.Set(level)
+	logger.Init(stdout, logLevel)
+
+	// Trace requests through the service, the repositories and the calls to other services
+	sampleRatio, _ := strconv.ParseFloat(cfg.TracesSampleRatio, 64) // Checked by Validate
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Exporter:    cfg.TracesExporter,
+		Endpoint:    cfg.OTLPEndpoint,
+		SampleRatio: sampleRatio,
+	}, stdout)
+	if err != nil {
+		slog.Error("Failed to set up tracing", "error", err)
+		return exitFailure
+	}
+
+	// Initialize the repositories on MySQL, PostgreSQL or SQLite, picked from the DATABASE_URL scheme, or in memory.
+	// Webhooks, feedback, reminders and nudges need a database and are left out in memory.
+	var pool *sql.DB       // Connection pool of DATABASE_URL, nil with STORAGE=memory
+	var dialect db.Dialect // Database engine of pool
+	var interviewRepository repository.InterviewRepository
+	var txManager repository.TxManager
+	var outboxRepository repository.OutboxRepository
+	var webhookRepository repository.WebhookRepository   // nil with STORAGE=memory
+	var feedbackRepository repository.FeedbackRepository // nil with STORAGE=memory
+	var reminderRepository repository.ReminderRepository // nil with STORAGE=memory
+	switch cfg.Storage {
+	case "database":
+		dialect = db.DialectOf(cfg.DatabaseURL)
+		conn := db.Connect(cfg.DatabaseURL)
+		pool = conn
+		migrateOnStart(cfg, conn, dialect)
+
+		// Interview queries are cancelled when they outlive the timeout or the request
+		interviewRepository, txManager, err = newInterviewStore(cfg, conn)
+		if err != nil {
+			fatal("Invalid DB_QUERY_TIMEOUT", "error", err)
+		}
+		interviewRepository = repository.InstrumentInterviewRepository(interviewRepository)
+		txManager = repository.InstrumentTxManager(txManager)
+		prometheus.MustRegister(collectors.NewDBStatsCollector(conn, "interviews")) // Connection pool usage
+		switch dialect {
+		case db.Postgres:
+			outboxRepository = repository.NewPostgresOutboxRepository(conn)
+			webhookRepository = repository.NewPostgresWebhookRepository(conn)
+			feedbackRepository = repository.NewPostgresFeedbackRepository(conn)
+			reminderRepository = repository.NewPostgresReminderRepository(conn)
+		case db.SQLite:
+			outboxRepository = repository.NewOutboxRepository(conn)
+			webhookRepository = repository.NewSQLiteWebhookRepository(conn)
+			feedbackRepository = repository.NewSQLiteFeedbackRepository(conn)
+			reminderRepository = repository.NewSQLiteReminderRepository(conn)
+		default:
+			outboxRepository = repository.NewOutboxRepository(conn)
+			webhookRepository = repository.NewWebhookRepository(conn)
+			feedbackRepository = repository.NewFeedbackRepository(conn)
+			reminderRepository = repository.NewReminderRepository(conn)
+		}
+	case "memory":
+		store := repository.NewMemoryStore()
+		interviewRepository, txManager, outboxRepository = store.Interviews(), store.TxManager(), store.Outbox()
+		slog.Warn("STORAGE is memory, interviews are lost on exit and webhooks, feedback, reminders and nudges are disabled")
+	default:
+		fatal("Invalid STORAGE, expected database or memory", "storage", cfg.Storage)
+	}
+
+	// Initialize notifications; email is only sent when an SMTP server is configured
+	var notifier notification.Notifier = notification.NopNotifier{}
+	var asyncNotifier *notification.AsyncNotifier // Flushed on shutdown, nil without SMTP
+	var mailer notification.Mailer
+	templates, err := notification.LoadTemplates()
+	if err != nil {
+		fatal("Failed to load notification templates", "error", err)
+	}
+	if cfg.SMTPHost != "" {
+		mailer = notification.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword)
+		asyncNotifier = notification.NewAsyncNotifier(mailer, templates, cfg.MailFrom, 100, 2)
+		notifier = asyncNotifier
+	}
+
+	// Initialize webhook delivery; failed deliveries are retried in the background by the replica holding the lock
+	var webhookService service.WebhookService
+	var dispatcher *webhook.Dispatcher
+	if webhookRepository != nil {
+		lock := newLeaderLock(pool, dialect, "interviews-service.webhooks")
+		dispatcher = webhook.NewDispatcher(webhookRepository, webhook.NewClient(10*time.Second), lock, webhook.DefaultConfig())
+		dispatcher.Start()
+		webhookService = service.NewWebhookService(webhookRepository)
+	}
+
+	// Relay events recorded in the outbox to notifications and webhooks
+	// Only the replica holding the lock relays; memory and SQLite storage run as a single instance.
+	destinations := []event.Publisher{event.NotifierPublisher(notifier)}
+	if dispatcher != nil {
+		destinations = append(destinations, dispatcher)
+	}
+	relayLock := newLeaderLock(pool, dialect, "interviews-service.outbox")
+	relay := outbox.NewRelay(outboxRepository, event.NewFanout(destinations...), relayLock, outbox.DefaultConfig())
+	relay.Start()
+
+	// Remind participants ahead of their interviews by email; only the replica holding the lock sends.
+	// The scheduler idles without offsets so a reload can turn reminders on.
+	reminderOffsets, err := reminder.ParseOffsets(cfg.ReminderOffsets)
+	if err != nil {
+		fatal("Invalid REMINDER_OFFSETS", "error", err)
+	}
+	var reminders *reminder.Scheduler
+	if mailer != nil && reminderRepository != nil {
+		reminderCfg := reminder.DefaultConfig()
+		reminderCfg.Offsets = reminderOffsets
+		lock := newLeaderLock(pool, dialect, "interviews-service.reminders")
+		reminders = reminder.NewScheduler(reminderRepository, mailer, templates, cfg.MailFrom, lock, reminderCfg)
+		reminders.Start()
+	} else if len(reminderOffsets) > 0 && reminderRepository != nil {
+		slog.Warn("SMTP_HOST is not set, interview reminders are disabled")
+	}
+
+	// Initialize clients of the candidates and jobs services used to validate new interviews
+	var candidateClient client.CandidateClient
+	if cfg.CandidatesServiceURL != "" {
+		clientCfg := client.DefaultHTTPConfig(cfg.CandidatesServiceURL)
+		clientCfg.Token = cfg.ServiceToken
+		candidateClient = client.NewHTTPCandidateClient(clientCfg)
+	} else {
+		slog.Warn("CANDIDATES_SERVICE_URL is not set, candidate IDs will not be validated")
+	}
+	var jobClient client.JobClient
+	if cfg.JobsServiceURL != "" {
+		clientCfg := client.DefaultHTTPConfig(cfg.JobsServiceURL)
+		clientCfg.Token = cfg.ServiceToken
+		jobClient = client.NewHTTPJobClient(clientCfg)
+	} else {
+		slog.Warn("JOBS_SERVICE_URL is not set, job IDs will not be validated")
+	}
+
+	// Readiness checks behind /readyz; failing downstream services degrade readiness without failing it
+	checks := health.NewRegistry(health.DefaultConfig())
+	if pool != nil {
+		checks.Register("database", pool.PingContext)
+		pending, err := pendingMigrationsCheck(pool, dialect)
+		if err != nil {
+			fatal("Failed to load migrations", "error", err)
+		}
+		checks.Register("migrations", pending)
+	}
+	if pinger, ok := candidateClient.(client.Pinger); ok {
+		checks.RegisterOptional("candidates-service", pinger.Ping)
+	}
+	if pinger, ok := jobClient.(client.Pinger); ok {
+		checks.RegisterOptional("jobs-service", pinger.Ping)
+	}
+	for _, result := range checks.Check(context.Background()).Checks {
+		if result.Status != health.StatusOK {
+			slog.Warn("Readiness check is failing", "check", result.Name, "error", result.Error)
+		}
+	}
+
+	// Initialize services
+	interviewService := service.InstrumentInterviewService(service.NewInterviewService(interviewRepository, txManager, candidateClient, jobClient))
+
+	// Feedback deadlines per stage, counted in business hours
+	feedbackPolicy, err := feedbackPolicyOf(cfg)
+	if err != nil {
+		fatal("Invalid configuration", "error", err)
+	}
+	var feedbackService service.FeedbackService
+	if feedbackRepository != nil {
+		feedbackService = service.NewFeedbackService(feedbackRepository, feedbackPolicy)
+	}
+
+	// Nudge interviewers, then hiring managers, about overdue feedback; only the replica holding the lock sends
+	var nudger *nudge.Nudger
+	if mailer != nil && feedbackService != nil {
+		lock := newLeaderLock(pool, dialect, "interviews-service.feedback-nudges")
+		nudger = nudge.NewNudger(feedbackService, jobClient, mailer, templates, cfg.MailFrom, feedbackPolicy, lock, 15*time.Minute)
+		nudger.Start()
+	}
+
+	// Flag interviews that ended without an outcome so recruiters can follow up
+	detector := attention.NewDetector(interviewService, 5*time.Minute)
+	detector.Start()
+
+	// Serve the same API over gRPC on its own port
+	// Every replica follows the outbox to feed its WatchInterviews streams, whichever replica relays the events.
+	var grpcServer *grpc.Server
+	var broadcaster *event.Broadcaster
+	var tail *outbox.Tail
+	if cfg.GRPCPort != "" {
+		listener, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+		if err != nil {
+			fatal("Failed to listen on the gRPC port", "port", cfg.GRPCPort, "error", err)
+		}
+		broadcaster = event.NewBroadcaster()
+		tail = outbox.NewTail(outboxRepository, broadcaster, outbox.DefaultConfig())
+		tail.Start()
+		grpcServer = transport.NewGRPCServer(transport.NewInterviewServer(interviewService, broadcaster), cfg.JWTSecretKey)
+		go func() {
+			slog.Info("Interview Service gRPC API is running", "port", cfg.GRPCPort)
+			if err := grpcServer.Serve(listener); err != nil {
+				fatal("Failed to start the gRPC server", "error", err)
+			}
+		}()
+	}
+
+	// Initialize Gin and routes
+	r := gin.New()
+	r.Use(transport.Tracing(tracing.ServiceName), transport.RequestID(), transport.AccessLog(), transport.Metrics(), transport.Recovery())
+	handlers := transport.Handlers{
+		Interviews:   transport.NewInterviewHandler(interviewService),
+		InterviewsV2: transport.NewInterviewHandlerV2(interviewService),
+		Events:       transport.NewEventHandler(consumer.NewInterviewConsumer(interviewService)),
+	}
+	if webhookService != nil {
+		handlers.Webhooks = transport.NewWebhookHandler(webhookService)
+	}
+	if feedbackService != nil {
+		handlers.Feedback = transport.NewFeedbackHandler(feedbackService)
+	}
+	graphHandler, err := graph.NewHandler(interviewService, graph.DefaultConfig())
+	if err != nil {
+		fatal("Failed to load the GraphQL schema", "error", err)
+	}
+
+	// Unversioned routes are deprecated aliases of /v1 until their sunset date
+	legacyPolicy, err := legacyPolicyOf(cfg)
+	if err != nil {
+		fatal("Invalid configuration", "error", err)
+	}
+	deprecation := transport.NewDeprecation(legacyPolicy)
+
+	// Apply the reloadable settings again on SIGHUP
+	reloadOnHangup(cfg, settings, &reloadable{
+		logLevel:    logLevel,
+		deprecation: deprecation,
+		feedback:    feedbackService,
+		nudger:      nudger,
+		reminders:   reminders,
+	})
+
+	// Swagger route
+	// @Summary Swagger Documentation
+	// @Description Provides Swagger UI for the API
+	// @Tags Swagger
+	// @Router /swagger/*any [get]
+	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+
+	// Register versioned routes; see the handlers for their documentation
+	transport.RegisterV1(r.Group("/v1", jwtUtil.AuthMiddleware(cfg.JWTSecretKey)), handlers)
+	transport.RegisterV2(r.Group("/v2", jwtUtil.AuthMiddleware(cfg.JWTSecretKey)), handlers)
+	transport.RegisterV1(r.Group("", deprecation.Middleware(time.Now), jwtUtil.AuthMiddleware(cfg.JWTSecretKey)), handlers)
+
+	// GraphQL queries and mutations over interviews, their panel and scorecards
+	// @Summary GraphQL endpoint
+	// @Description Runs a GraphQL query or mutation; see internal/graph/schema.graphql for the schema.
+	// @Description Queries above the complexity limit are rejected with 400 before running.
+	// @Tags GraphQL
+	// @Accept json
+	// @Produce json
+	// @Success 200 {object} map[string]interface{} "GraphQL response"
+	// @Failure 400 {object} map[string]interface{} "Invalid or too complex query"
+	// @Router /graphql [post]
+	r.POST("/graphql", jwtUtil.AuthMiddleware(cfg.JWTSecretKey), gin.WrapH(graphHandler))
+
+	// Prometheus metrics of requests, the database and the workers
+	// @Summary Prometheus metrics
+	// @Description Exposes metrics in the Prometheus text format: http_requests_total and http_request_duration_seconds
+	// @Description per route and status, go_sql_* connection pool statistics, repository_query_duration_seconds,
+	// @Description interviews_created_total, interviews_cancelled_total, interviews_flagged_total, outbox_backlog,
+	// @Description outbox_published_total, outbox_failed_total, reminders_sent_total and feedback_nudges_sent_total
+	// @Tags Health
+	// @Produce plain
+	// @Success 200 {string} string "Metrics"
+	// @Router /metrics [get]
+	r.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
+	// Liveness and readiness probes; see the handler for their documentation
+	transport.RegisterHealth(r, transport.NewHealthHandler(checks))
+
+	server := newHTTPServer(cfg, r)
+	go func() {
+		slog.Info("Interview Service is running", "port", cfg.Port)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fatal("Failed to start the server", "error", err)
+		}
+	}()
+
+	// Wait for SIGTERM or SIGINT; a second signal stops the process at once
+	signals, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	<-signals.Done()
+	stop()
+
+	grace := validDuration(cfg.ShutdownGracePeriod)
+	ctx, cancel := context.WithTimeout(context.Background(), grace)
+	defer cancel()
+	slog.Info("Shutting down", "grace_period", grace.String())
+
+	// Fail readiness first, so load balancers stop routing new requests before connections are drained
+	checks.MarkShuttingDown()
+	sleepContext(ctx, validDuration(cfg.ShutdownDelay))
+
+	// Drain the servers; gRPC watch streams end when the broadcaster closes
+	servers := []stopper{httpStopper(ctx, server)}
+	if grpcServer != nil {
+		tail.Stop()
+		broadcaster.Close()
+		servers = append(servers, grpcStopper(ctx, grpcServer))
+	}
+	stopAll(ctx, servers...)
+
+	// Stop the workers, then what they publish to, so events relayed during shutdown are still delivered
+	workers := []stopper{{name: "attention detector", stop: detector.Stop}, {name: "outbox relay", stop: relay.Stop}}
+	if reminders != nil {
+		workers = append(workers, stopper{name: "reminder scheduler", stop: reminders.Stop})
+	}
+	if nudger != nil {
+		workers = append(workers, stopper{name: "feedback nudger", stop: nudger.Stop})
+	}
+	stopAll(ctx, workers...)
+	var publishers []stopper
+	if dispatcher != nil {
+		publishers = append(publishers, stopper{name: "webhook dispatcher", stop: dispatcher.Stop})
+	}
+	if asyncNotifier != nil {
+		publishers = append(publishers, stopper{name: "email notifier", stop: asyncNotifier.Close})
+	}
+	stopAll(ctx, publishers...)
+
+	// Flush the spans of the last requests
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Failed to flush traces", "error", err)
+	}
+
+	// Close the database last, once nothing uses it anymore
+	if pool != nil {
+		if err := pool.Close(); err != nil {
+			slog.Error("Failed to close the database", "error", err)
+		}
+	}
+	slog.Info("Interview Service stopped")
+	return exitOK
+}
+
+// newHTTPServer creates the HTTP server of the API with the timeouts of the configuration
+// Request headers must arrive within 5 seconds at most, so slow clients cannot hold connections open.
+func newHTTPServer(cfg *config.Config, handler http.Handler) *http.Server {
+	readTimeout := validDuration(cfg.HTTPReadTimeout)
+	readHeaderTimeout := 5 * time.Second
+	if readTimeout > 0 && readTimeout < readHeaderTimeout {
+		readHeaderTimeout = readTimeout
+	}
+	return &http.Server{
+		Addr:              ":" + cfg.Port,
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      validDuration(cfg.HTTPWriteTimeout),
+		IdleTimeout:       validDuration(cfg.HTTPIdleTimeout),
+	}
+}
+
+// fatal logs an error that prevents the server from running and exits with status 1
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(exitFailure)
+}
+
+// validDuration parses a duration setting that config.Validate has already checked
+func validDuration(value string) time.Duration {
+	d, _ := time.ParseDuration(value)
+	return d
+}
+
+// migrateOnStart brings the schema up to date before serving when DB_AUTO_MIGRATE is true
+// Replicas starting together take turns through a database lock, so only the first one applies migrations.
+func migrateOnStart(cfg *config.Config, dbConn *sql.DB, dialect db.Dialect) {
+	autoMigrate, err := strconv.ParseBool(cfg.AutoMigrate)
+	if err != nil {
+		fatal("Invalid DB_AUTO_MIGRATE", "error", err)
+	}
+	if !autoMigrate {
+		return
+	}
+	migrations, err := migrate.Load(dialect)
+	if err != nil {
+		fatal("Failed to load migrations", "error", err)
+	}
+	applied, err := migrate.NewMigrator(dbConn, dialect, migrations).Up(context.Background())
+	if err != nil {
+		fatal("Failed to migrate the database", "error", err)
+	}
+	slog.Info("Applied migrations", "count", len(applied))
+}
+
+// pendingMigrationsCheck returns a readiness check failing while migrations of the binary are not applied
+// Replicas started before the migrate command has run stay out of rotation instead of failing requests.
+func pendingMigrationsCheck(conn *sql.DB, dialect db.Dialect) (health.Check, error) {
+	migrations, err := migrate.Load(dialect)
+	if err != nil {
+		return nil, err
+	}
+	migrator := migrate.NewMigrator(conn, dialect, migrations)
+	return func(ctx context.Context) error {
+		pending, err := migrator.Pending(ctx)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			return fmt.Errorf("%d migrations pending, starting with %d_%s", len(pending), pending[0].Version, pending[0].Name)
+		}
+		return nil
+	}, nil
+}
+
+// feedbackPolicyOf parses the feedback deadlines of FEEDBACK_SLA and FEEDBACK_ESCALATION, counted in the working
+// hours of FEEDBACK_WORKING_HOURS and FEEDBACK_TIMEZONE
+func feedbackPolicyOf(cfg *config.Config) (sla.Policy, error) {
+	escalation, err := time.ParseDuration(cfg.FeedbackEscalation)
+	if err != nil {
+		return sla.Policy{}, fmt.Errorf("invalid FEEDBACK_ESCALATION: %w", err)
+	}
+	policy, err := sla.ParsePolicy(cfg.FeedbackSLA, escalation)
+	if err != nil {
+		return sla.Policy{}, fmt.Errorf("invalid FEEDBACK_SLA: %w", err)
+	}
+	policy.Hours, err = sla.ParseWorkingHours(cfg.FeedbackWorkingHours, cfg.FeedbackTimezone)
+	if err != nil {
+		return sla.Policy{}, fmt.Errorf("invalid FEEDBACK_WORKING_HOURS or FEEDBACK_TIMEZONE: %w", err)
+	}
+	return policy, nil
+}
+
+// legacyPolicyOf parses the deprecation of the unversioned routes from LEGACY_API_DEPRECATED_AT and LEGACY_API_SUNSET
+func legacyPolicyOf(cfg *config.Config) (transport.DeprecationPolicy, error) {
+	deprecatedAt, err := time.Parse("2006-01-02", cfg.LegacyAPIDeprecatedAt)
+	if err != nil {
+		return transport.DeprecationPolicy{}, fmt.Errorf("invalid LEGACY_API_DEPRECATED_AT: %w", err)
+	}
+	sunset, err := time.Parse("2006-01-02", cfg.LegacyAPISunset)
+	if err != nil {
+		return transport.DeprecationPolicy{}, fmt.Errorf("invalid LEGACY_API_SUNSET: %w", err)
+	}
+	return transport.DeprecationPolicy{DeprecatedAt: deprecatedAt, Sunset: sunset, Successor: "/v1"}, nil
+}
+
+// reloadable holds the components whose settings are applied again when the configuration is reloaded
+type reloadable struct {
+	logLevel    *slog.LevelVar          // Lowest level of the default logger
+	deprecation *transport.Deprecation  // Guards the unversioned routes
+	feedback    service.FeedbackService // Computes feedback deadlines, nil without a database
+	nudger      *nudge.Nudger           // Escalates overdue feedback, nil without a database or SMTP
+	reminders   *reminder.Scheduler     // Sends interview reminders, nil without a database or SMTP
+}
+
+// apply hands the reloadable settings of a configuration to the components
+// Every setting is parsed first, so the components are left untouched when one of them is invalid.
+func (r *reloadable) apply(cfg *config.Config) error {
+	level, err := logger.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return fmt.Errorf("invalid LOG_LEVEL: %w", err)
+	}
+	offsets, err := reminder.ParseOffsets(cfg.ReminderOffsets)
+	if err != nil {
+		return fmt.Errorf("invalid REMINDER_OFFSETS: %w", err)
+	}
+	feedbackPolicy, err := feedbackPolicyOf(cfg)
+	if err != nil {
+		return err
+	}
+	legacyPolicy, err := legacyPolicyOf(cfg)
+	if err != nil {
+		return err
+	}
+
+	r.logLevel.Set(level)
+	r.deprecation.SetPolicy(legacyPolicy)
+	if r.feedback != nil {
+		r.feedback.SetPolicy(feedbackPolicy)
+	}
+	if r.nudger != nil {
+		r.nudger.SetPolicy(feedbackPolicy)
+	}
+	if r.reminders != nil {
+		r.reminders.SetOffsets(offsets)
+	}
+	return nil
+}
+
+// reloadOnHangup reloads the configuration every time the process receives SIGHUP
+// The YAML file and _FILE variables are read again; settings that need a restart keep their value and are logged.
+// A configuration that is invalid is logged and ignored, the previous one stays in effect.
+func reloadOnHangup(cfg *config.Config, settings *config.Flags, components *reloadable) {
+	hangups := make(chan os.Signal, 1)
+	signal.Notify(hangups, syscall.SIGHUP)
+	go func() {
+		for range hangups {
+			next, restart, err := cfg.Reload(settings)
+			if err == nil {
+				err = components.apply(next)
+			}
+			if err != nil {
+				slog.Error("Configuration not reloaded, keeping the current one", "error", err)
+				continue
+			}
+			if changed := cfg.Diff(next); len(changed) > 0 {
+				slog.Info("Configuration reloaded", "applied", changed)
+			} else {
+				slog.Info("Configuration reloaded, nothing to apply")
+			}
+			if len(restart) > 0 {
+				slog.Warn("Restart to apply the other changes", "settings", restart)
+			}
+			cfg = next
+		}
+	}()
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// stopper stops a component of the server during shutdown
+type stopper struct {
+	name string // Name used in logs, e.g. outbox relay
+	stop func() // Stops the component and blocks until it has stopped
+}
+
+// stopAll stops components concurrently and waits until every one has stopped or ctx expires
+// Components still stopping when ctx expires are logged and left behind, so shutdown always finishes on time.
+// Returns false when some component did not stop in time.
+func stopAll(ctx context.Context, stoppers ...stopper) bool {
+	var wg sync.WaitGroup
+	stopped := make([]chan struct{}, len(stoppers))
+	for i, s := range stoppers {
+		stopped[i] = make(chan struct{})
+		wg.Add(1)
+		go func(s stopper, done chan struct{}) {
+			defer wg.Done()
+			defer close(done)
+			s.stop()
+		}(s, stopped[i])
+	}
+
+	all := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(all)
+	}()
+	select {
+	case <-all:
+		return true
+	case <-ctx.Done():
+	}
+	for i, done := range stopped {
+		select {
+		case <-done:
+		default:
+			slog.Warn("Gave up waiting for a component to stop", "component", stoppers[i].name)
+		}
+	}
+	return false
+}
+
+// httpStopper drains an HTTP server, closing the connections still open when ctx expires
+func httpStopper(ctx context.Context, server *http.Server) stopper {
+	return stopper{name: "HTTP server", stop: func() {
+		if err := server.Shutdown(ctx); err != nil {
+			slog.Warn("HTTP requests still running after the grace period", "error", err)
+			server.Close()
+		}
+	}}
+}
+
+// grpcStopper drains a gRPC server, cancelling the calls still running when ctx expires
+func grpcStopper(ctx context.Context, server *grpc.Server) stopper {
+	return stopper{name: "gRPC server", stop: func() {
+		drained := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(drained)
+		}()
+		select {
+		case <-drained:
+		case <-ctx.Done():
+			slog.Warn("gRPC calls still running after the grace period")
+			server.Stop()
+		}
+	}}
+}
+
+// sleepContext waits for d or until ctx expires, whichever comes first
+func sleepContext(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
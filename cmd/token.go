@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/poolcamacho/interviews-service/pkg/config"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+)
+
+// claimFlags collects repeated -claim key=value flags
+type claimFlags map[string]interface{}
+
+// String formats the claims for the flag package
+func (c claimFlags) String() string {
+	pairs := make([]string, 0, len(c))
+	for key, value := range c {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", key, value))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// Set adds a claim; values that parse as JSON, such as numbers, booleans or arrays, keep their type
+func (c claimFlags) Set(pair string) error {
+	key, value, ok := strings.Cut(pair, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("claim %q must look like key=value", pair)
+	}
+	var typed interface{}
+	if err := json.Unmarshal([]byte(value), &typed); err != nil {
+		typed = value
+	}
+	c[key] = typed
+	return nil
+}
+
+// runToken runs the token command
+// Only "token mint" exists; it prints a token signed with JWT_SECRET_KEY so it can be pasted into
+// an Authorization header.
+func runToken(args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet("token", "mint", `Mints a JWT accepted by the API, signed with JWT_SECRET_KEY unless -secret is given.
+Example: interviews-service token mint -sub recruiter -ttl 8h -claim role=admin`, stderr)
+	sub := flags.String("sub", "dev", "subject of the token")
+	ttl := flags.Duration("ttl", time.Hour, "how long the token is valid")
+	secret := flags.String("secret", "", "key to sign the token with instead of JWT_SECRET_KEY")
+	claims := claimFlags{}
+	flags.Var(claims, "claim", "extra claim as key=value, repeatable; JSON values such as 3 or true keep their type")
+
+	if len(args) == 0 || args[0] != "mint" {
+		if code, ok := parseFlags(flags, args); !ok {
+			return code
+		}
+		return usageError(flags, "missing token command, only mint is supported")
+	}
+	if code, ok := parseFlags(flags, args[1:]); !ok {
+		return code
+	}
+	if flags.NArg() > 0 {
+		return usageError(flags, "unexpected argument %q", flags.Arg(0))
+	}
+	if *ttl <= 0 {
+		return usageError(flags, "-ttl must be positive")
+	}
+
+	key := *secret
+	if key == "" {
+		cfg, err := config.Load(nil)
+		if err != nil {
+			log.Printf("Invalid configuration: %v", err)
+			return exitFailure
+		}
+		if key = cfg.JWTSecretKey; key == "" {
+			log.Printf("JWT_SECRET_KEY is not set, set it or pass -secret")
+			return exitFailure
+		}
+	}
+	now := time.Now()
+	mapClaims := jwt.MapClaims{"sub": *sub, "iat": now.Unix(), "exp": now.Add(*ttl).Unix()}
+	for name, value := range claims {
+		mapClaims[name] = value
+	}
+
+	token, err := jwtUtil.GenerateToken(key, mapClaims)
+	if err != nil {
+		log.Printf("Failed to sign the token: %v", err)
+		return exitFailure
+	}
+	fmt.Fprintln(stdout, token)
+	return exitOK
+}
@@ -26,7 +26,7 @@ const docTemplate = `{
     "paths": {
         "/health": {
             "get": {
-                "description": "Returns the health status of the interview service",
+                "description": "Deprecated alias of /readyz kept for existing monitors: returns healthy, or unhealthy with 503",
                 "produces": [
                     "application/json"
                 ],
@@ -43,11 +43,167 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "503": {
+                        "description": "Service is unhealthy",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/livez": {
+            "get": {
+                "description": "Returns 200 while the process can serve requests, whatever the state of its dependencies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "Process is alive",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Runs the dependency checks (database ping, applied migrations, downstream services), caching their\nresults for a few seconds. Returns 503 when a required check fails or the service is shutting down;\nfailing downstream services only turn the status to degraded. Add ?verbose to list each check with\nits status, latency and error.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness probe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "List every check",
+                        "name": "verbose",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Ready, status ok or degraded",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "503": {
+                        "description": "Not ready, status fail",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/events": {
+            "post": {
+                "description": "Accepts candidate.withdrawn (data.candidate_id) and job.closed (data.job_id) events and cancels the\naffected future interviews, notifying their participants. Delivering the same event twice is harmless.\nOnly tokens whose role claim is service or admin are accepted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Events"
+                ],
+                "summary": "Receive an event from another service",
+                "parameters": [
+                    {
+                        "description": "Event",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.InboundEvent"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Event processed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "The token has neither the service nor the admin role",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unsupported event type",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to process event",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/feedback/overdue": {
+            "get": {
+                "description": "Retrieve the feedback missing past its SLA deadline, grouped by interviewer",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feedback"
+                ],
+                "summary": "List overdue feedback",
+                "responses": {
+                    "200": {
+                        "description": "Overdue feedback",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.FeedbackAssignment"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch overdue feedback",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
                     }
                 }
             }
         },
-        "/interviews": {
+        "/v1/interviews": {
             "get": {
                 "description": "Retrieve a list of all interviews in the system",
                 "produces": [
@@ -70,16 +226,13 @@ const docTemplate = `{
                     "500": {
                         "description": "Failed to fetch interviews",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/problem.Problem"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Add a new interview by providing candidate_id, job_id, interview_date, and feedback",
+                "description": "Add a new interview by providing candidate_id, job_id, interview_date, and feedback.\nParticipants listed in candidate_email and panel are notified by email with a calendar invite.\nThe candidate must be active in the candidates service and the job open in the jobs service.",
                 "consumes": [
                     "application/json"
                 ],
@@ -112,50 +265,1242 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Malformed request body or missing fields",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unknown or inactive candidate or job",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create interview",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Candidates or jobs service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/interviews/attention": {
+            "get": {
+                "description": "Retrieve interviews that ended without an outcome or feedback, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interviews"
+                ],
+                "summary": "List interviews needing attention",
+                "responses": {
+                    "200": {
+                        "description": "List of interviews needing attention",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.Interview"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch interviews",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/interviews/attention/resolve": {
+            "post": {
+                "description": "Record completed or no_show for the given interviews. Interviews that are no longer flagged are skipped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interviews"
+                ],
+                "summary": "Resolve interviews needing attention",
+                "parameters": [
+                    {
+                        "description": "Interviews and outcome",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.AttentionResolution"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Number of interviews updated",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "type": "string"
+                                "type": "integer"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Malformed request body",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid status or no interviews given",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
                     "500": {
-                        "description": "Failed to create interview",
+                        "description": "Failed to resolve interviews",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/interviews/{id}/panel/{panelist_id}/feedback": {
+            "post": {
+                "description": "Store the feedback of a panelist. The time of the first submission is used for the SLA.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feedback"
+                ],
+                "summary": "Submit interviewer feedback",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Interview ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Panelist ID",
+                        "name": "panelist_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Feedback",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.FeedbackSubmission"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Feedback submitted",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    },
+                    "400": {
+                        "description": "Invalid ID or malformed request body",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Panelist not found",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Empty feedback",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to submit feedback",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.Interview": {
-            "type": "object",
-            "properties": {
-                "candidate_id": {
-                    "description": "Foreign key referencing the candidate's ID",
-                    "type": "integer"
-                },
-                "feedback": {
-                    "description": "Feedback or notes about the interview",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "Unique identifier for the interview",
-                    "type": "integer"
-                },
-                "interview_date": {
-                    "description": "Date and time of the interview",
-                    "type": "string"
-                },
-                "job_id": {
-                    "description": "Foreign key referencing the job's ID",
+        },
+        "/v1/reports/feedback-sla": {
+            "get": {
+                "description": "Summarise on-time, late, overdue and pending feedback per interviewer and per job for interviews\nheld in [from, to). Dates are RFC 3339 or YYYY-MM-DD; the default period is the last 30 days.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Feedback"
+                ],
+                "summary": "Feedback SLA compliance report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start of the period",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "SLA report",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SLAReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid period",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to build report",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks": {
+            "get": {
+                "description": "Retrieve all webhook subscriptions; secrets are not included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook subscriptions",
+                "responses": {
+                    "200": {
+                        "description": "List of subscriptions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.WebhookSubscription"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch subscriptions",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Subscribe a URL to interview events. Payloads are signed with HMAC-SHA256 over \"\u003cX-Webhook-Timestamp\u003e.\u003cbody\u003e\"\nusing the subscription secret and sent in the X-Webhook-Signature header. A secret is generated when omitted\nand is only returned in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a webhook subscription",
+                "parameters": [
+                    {
+                        "description": "Subscription Data",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.WebhookSubscription"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Subscription created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.WebhookSubscription"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request body",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Invalid URL or event types",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create subscription",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/dead-letters": {
+            "get": {
+                "description": "Retrieve deliveries that exhausted their retries and can be replayed",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List dead webhook deliveries",
+                "responses": {
+                    "200": {
+                        "description": "Dead deliveries",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/deliveries/{id}/replay": {
+            "post": {
+                "description": "Schedule a dead delivery to be sent again with the original payload and a fresh set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Replay a dead webhook delivery",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Delivery scheduled",
+                        "schema": {
+                            "$ref": "#/definitions/domain.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid delivery ID",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Delivery not found",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Delivery is not dead",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to replay delivery",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{id}": {
+            "delete": {
+                "description": "Remove a subscription together with its delivery log",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Subscription deleted"
+                    },
+                    "400": {
+                        "description": "Invalid subscription ID",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to delete subscription",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Retrieve every delivery made to a subscription with its status, attempts and last error",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List deliveries of a webhook subscription",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delivery log",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/domain.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid subscription ID",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Subscription not found",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch deliveries",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/interviews": {
+            "get": {
+                "description": "Retrieve every interview with its schedule, panel and feedback progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interviews v2"
+                ],
+                "summary": "Get all interviews",
+                "responses": {
+                    "200": {
+                        "description": "List of interviews",
+                        "schema": {
+                            "$ref": "#/definitions/transport.InterviewListV2"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch interviews",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a new interview; the request body is the same as in version 1. Responds with the created\ninterview and its location.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interviews v2"
+                ],
+                "summary": "Create a new interview",
+                "parameters": [
+                    {
+                        "description": "Interview Creation Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.Interview"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "The created interview",
+                        "schema": {
+                            "$ref": "#/definitions/transport.InterviewV2"
+                        }
+                    },
+                    "400": {
+                        "description": "Malformed request body",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Missing fields, or unknown or inactive candidate or job",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to create interview",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Candidates or jobs service unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/interviews/attention": {
+            "get": {
+                "description": "Retrieve interviews that ended without an outcome or feedback, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interviews v2"
+                ],
+                "summary": "List interviews needing attention",
+                "responses": {
+                    "200": {
+                        "description": "List of interviews needing attention",
+                        "schema": {
+                            "$ref": "#/definitions/transport.InterviewListV2"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch interviews",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/v2/interviews/{id}": {
+            "get": {
+                "description": "Retrieve an interview with its schedule, panel and feedback progress",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Interviews v2"
+                ],
+                "summary": "Get an interview",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Interview ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The interview",
+                        "schema": {
+                            "$ref": "#/definitions/transport.InterviewV2"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid interview ID",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Interview not found",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Failed to fetch interview",
+                        "schema": {
+                            "$ref": "#/definitions/problem.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.AttentionResolution": {
+            "type": "object",
+            "properties": {
+                "interview_ids": {
+                    "description": "Interviews to resolve",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "status": {
+                    "description": "Outcome recorded for every interview",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.InterviewStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "domain.DeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "dead"
+            ],
+            "x-enum-comments": {
+                "DeliveryDead": "Gave up after exhausting retries, kept for replay",
+                "DeliveryPending": "Waiting for its first or next attempt",
+                "DeliverySucceeded": "Acknowledged by the receiver with a 2xx response"
+            },
+            "x-enum-varnames": [
+                "DeliveryPending",
+                "DeliverySucceeded",
+                "DeliveryDead"
+            ]
+        },
+        "domain.EventType": {
+            "type": "string",
+            "enum": [
+                "interview.created",
+                "interview.rescheduled",
+                "interview.cancelled",
+                "interview.reminder"
+            ],
+            "x-enum-comments": {
+                "EventInterviewCancelled": "An interview was cancelled",
+                "EventInterviewCreated": "A new interview was scheduled",
+                "EventInterviewReminder": "An interview is coming up, only sent to participants",
+                "EventInterviewRescheduled": "An interview was moved to a different date"
+            },
+            "x-enum-varnames": [
+                "EventInterviewCreated",
+                "EventInterviewRescheduled",
+                "EventInterviewCancelled",
+                "EventInterviewReminder"
+            ]
+        },
+        "domain.FeedbackAssignment": {
+            "type": "object",
+            "properties": {
+                "deadline": {
+                    "description": "Time by which the feedback is due",
+                    "type": "string"
+                },
+                "interview": {
+                    "description": "Interview the feedback is about",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Interview"
+                        }
+                    ]
+                },
+                "nudge_level": {
+                    "description": "Highest reminder sent so far: 0 none, 1 interviewer, 2 hiring manager",
+                    "type": "integer"
+                },
+                "panelist": {
+                    "description": "Interviewer who owes the feedback",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Panelist"
+                        }
+                    ]
+                }
+            }
+        },
+        "domain.FeedbackSubmission": {
+            "type": "object",
+            "properties": {
+                "feedback": {
+                    "description": "Feedback about the candidate",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.InboundEvent": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "Event specific data, e.g. {\"candidate_id\": 101}",
+                    "type": "object"
+                },
+                "id": {
+                    "description": "Identifier assigned by the producer",
+                    "type": "string"
+                },
+                "occurred_at": {
+                    "description": "Time at which the event happened",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Event type, e.g. candidate.withdrawn",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Interview": {
+            "type": "object",
+            "required": [
+                "candidate_id",
+                "interview_date",
+                "job_id"
+            ],
+            "properties": {
+                "cancellation_reason": {
+                    "description": "Reason given when the interview was cancelled",
+                    "type": "string"
+                },
+                "candidate_email": {
+                    "description": "Address used to notify the candidate",
+                    "type": "string"
+                },
+                "candidate_id": {
+                    "description": "Foreign key referencing the candidate's ID",
+                    "type": "integer"
+                },
+                "feedback": {
+                    "description": "Feedback or notes about the interview",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique identifier for the interview",
+                    "type": "integer"
+                },
+                "interview_date": {
+                    "description": "Date and time of the interview",
+                    "type": "string"
+                },
+                "job_id": {
+                    "description": "Foreign key referencing the job's ID",
+                    "type": "integer"
+                },
+                "panel": {
+                    "description": "Interviewers taking part in the interview",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.Panelist"
+                    }
+                },
+                "stage": {
+                    "description": "Hiring stage, e.g. screening or onsite, used to pick the feedback SLA",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Lifecycle status, scheduled on creation",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.InterviewStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "domain.InterviewStatus": {
+            "type": "string",
+            "enum": [
+                "scheduled",
+                "cancelled",
+                "needs_attention",
+                "completed",
+                "no_show"
+            ],
+            "x-enum-comments": {
+                "StatusCancelled": "The interview was called off",
+                "StatusCompleted": "The interview took place",
+                "StatusNeedsAttention": "The interview is over with no outcome or feedback recorded",
+                "StatusNoShow": "The candidate did not attend",
+                "StatusScheduled": "The interview is planned and will take place"
+            },
+            "x-enum-varnames": [
+                "StatusScheduled",
+                "StatusCancelled",
+                "StatusNeedsAttention",
+                "StatusCompleted",
+                "StatusNoShow"
+            ]
+        },
+        "domain.Panelist": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Address used to notify the interviewer",
+                    "type": "string"
+                },
+                "feedback": {
+                    "description": "Feedback submitted by the interviewer",
+                    "type": "string"
+                },
+                "feedback_submitted_at": {
+                    "description": "Time the feedback was submitted, nil while pending",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "Unique identifier for the panelist",
+                    "type": "integer"
+                },
+                "interview_id": {
+                    "description": "Foreign key referencing the interview's ID",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Display name of the interviewer",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.SLAEntry": {
+            "type": "object",
+            "properties": {
+                "compliance_rate": {
+                    "description": "Share of due feedback submitted on time, 1 when none is due yet",
+                    "type": "number"
+                },
+                "key": {
+                    "description": "Interviewer email or job ID",
+                    "type": "string"
+                },
+                "late": {
+                    "description": "Feedback submitted after the deadline",
+                    "type": "integer"
+                },
+                "on_time": {
+                    "description": "Feedback submitted before the deadline",
+                    "type": "integer"
+                },
+                "overdue": {
+                    "description": "Feedback missing past the deadline",
+                    "type": "integer"
+                },
+                "pending": {
+                    "description": "Feedback missing but not yet due",
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.SLAReport": {
+            "type": "object",
+            "properties": {
+                "by_interviewer": {
+                    "description": "One entry per interviewer email",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SLAEntry"
+                    }
+                },
+                "by_job": {
+                    "description": "One entry per job",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.SLAEntry"
+                    }
+                },
+                "from": {
+                    "description": "Start of the period, inclusive",
+                    "type": "string"
+                },
+                "to": {
+                    "description": "End of the period, exclusive",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.WebhookDelivery": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "description": "Number of attempts made so far",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "Time at which the delivery was created",
+                    "type": "string"
+                },
+                "event_id": {
+                    "description": "Identifier shared by all deliveries of the same event",
+                    "type": "string"
+                },
+                "event_type": {
+                    "description": "Type of the delivered event",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.EventType"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Unique identifier for the delivery",
+                    "type": "integer"
+                },
+                "last_error": {
+                    "description": "Error of the last failed attempt",
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "description": "Time at which the next attempt is due",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "Exact body sent to the endpoint",
+                    "type": "object"
+                },
+                "response_code": {
+                    "description": "HTTP status of the last attempt, 0 if no response",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Current delivery status",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.DeliveryStatus"
+                        }
+                    ]
+                },
+                "subscription_id": {
+                    "description": "Foreign key referencing the subscription's ID",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "description": "Time of the last attempt or status change",
+                    "type": "string"
+                }
+            }
+        },
+        "domain.WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "Time at which the subscription was created",
+                    "type": "string"
+                },
+                "event_types": {
+                    "description": "Events the endpoint is interested in",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.EventType"
+                    }
+                },
+                "id": {
+                    "description": "Unique identifier for the subscription",
+                    "type": "integer"
+                },
+                "secret": {
+                    "description": "Key used to sign payloads, only returned on creation",
+                    "type": "string"
+                },
+                "url": {
+                    "description": "Endpoint receiving the events",
+                    "type": "string"
+                }
+            }
+        },
+        "problem.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Name of the field as sent by the client, e.g. candidate_id",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "What is wrong with the value",
+                    "type": "string"
+                }
+            }
+        },
+        "problem.Problem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Explanation specific to this occurrence",
+                    "type": "string"
+                },
+                "errors": {
+                    "description": "Invalid fields, only set for validation errors",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/problem.FieldError"
+                    }
+                },
+                "instance": {
+                    "description": "Path of the request that caused the problem",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "HTTP status code",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Short summary of the problem type",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "URI identifying the problem type",
+                    "type": "string"
+                }
+            }
+        },
+        "transport.CandidateRefV2": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Address used to notify the candidate",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the candidate in the candidates service",
+                    "type": "integer"
+                }
+            }
+        },
+        "transport.FeedbackSummaryV2": {
+            "type": "object",
+            "properties": {
+                "pending": {
+                    "description": "Interviewers yet to submit feedback",
+                    "type": "integer"
+                },
+                "submitted": {
+                    "description": "Interviewers who submitted feedback",
+                    "type": "integer"
+                }
+            }
+        },
+        "transport.InterviewListV2": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Number of interviews in data",
+                    "type": "integer"
+                },
+                "data": {
+                    "description": "The interviews",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transport.InterviewV2"
+                    }
+                }
+            }
+        },
+        "transport.InterviewV2": {
+            "type": "object",
+            "properties": {
+                "cancellation_reason": {
+                    "description": "Reason given when the interview was cancelled",
+                    "type": "string"
+                },
+                "candidate": {
+                    "description": "The candidate being interviewed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/transport.CandidateRefV2"
+                        }
+                    ]
+                },
+                "feedback": {
+                    "description": "Overall feedback or notes about the interview",
+                    "type": "string"
+                },
+                "feedback_summary": {
+                    "description": "Progress of the panel's feedback",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/transport.FeedbackSummaryV2"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Unique identifier for the interview",
+                    "type": "integer"
+                },
+                "job": {
+                    "description": "The job the candidate is interviewed for",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/transport.JobRefV2"
+                        }
+                    ]
+                },
+                "links": {
+                    "description": "Related resources, keyed by relation",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "panel": {
+                    "description": "Interviewers taking part in the interview",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transport.PanelistV2"
+                    }
+                },
+                "schedule": {
+                    "description": "When the interview takes place",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/transport.ScheduleV2"
+                        }
+                    ]
+                },
+                "stage": {
+                    "description": "Hiring stage, e.g. screening or onsite",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Lifecycle status",
+                    "type": "string"
+                }
+            }
+        },
+        "transport.JobRefV2": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID of the job in the jobs service",
+                    "type": "integer"
+                }
+            }
+        },
+        "transport.PanelFeedbackV2": {
+            "type": "object",
+            "properties": {
+                "submitted": {
+                    "description": "Whether the interviewer submitted feedback",
+                    "type": "boolean"
+                },
+                "submitted_at": {
+                    "description": "Time the feedback was submitted",
+                    "type": "string"
+                },
+                "text": {
+                    "description": "The feedback itself",
+                    "type": "string"
+                }
+            }
+        },
+        "transport.PanelistV2": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "description": "Address used to notify the interviewer",
+                    "type": "string"
+                },
+                "feedback": {
+                    "description": "Feedback submitted by the interviewer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/transport.PanelFeedbackV2"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "Unique identifier for the panelist",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Display name of the interviewer",
+                    "type": "string"
+                }
+            }
+        },
+        "transport.ScheduleV2": {
+            "type": "object",
+            "properties": {
+                "duration_minutes": {
+                    "description": "Expected length of the interview",
                     "type": "integer"
+                },
+                "ends_at": {
+                    "description": "Expected end of the interview",
+                    "type": "string"
+                },
+                "starts_at": {
+                    "description": "Start of the interview",
+                    "type": "string"
                 }
             }
         }
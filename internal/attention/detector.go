@@ -0,0 +1,84 @@
+package attention
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// flaggedTotal counts interviews flagged as needing attention since start, exported on /metrics
+var flaggedTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "interviews_flagged_total",
+	Help: "Interviews flagged as needing attention since start.",
+})
+
+// Detector periodically flags interviews that are over but have no outcome or feedback
+// Flagging is a single guarded UPDATE, so every replica may run a detector without coordination.
+type Detector struct {
+	service  service.InterviewService // Service flagging the interviews
+	interval time.Duration            // How often overdue interviews are looked up
+	now      func() time.Time         // Clock, replaced in tests
+	stop     chan struct{}            // Closed to stop the background loop
+	done     chan struct{}            // Closed once the background loop has exited
+	once     sync.Once                // Guards stop against double close
+}
+
+// NewDetector creates a new Detector instance
+// @param interviewService service.InterviewService - The service used to flag interviews
+// @param interval time.Duration - How often overdue interviews are looked up
+// @return *Detector - A detector that is not yet running
+func NewDetector(interviewService service.InterviewService, interval time.Duration) *Detector {
+	return &Detector{
+		service:  interviewService,
+		interval: interval,
+		now:      time.Now,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the detector loop in the background until Stop is called
+func (d *Detector) Start() {
+	go func() {
+		defer close(d.done)
+		ticker := time.NewTicker(d.interval)
+		defer ticker.Stop()
+		for {
+			if _, err := d.RunOnce(context.Background()); err != nil {
+				slog.Error("Failed to flag overdue interviews", "component", "attention", "error", err)
+			}
+			select {
+			case <-d.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the detector loop to exit and waits for the current run to finish
+func (d *Detector) Stop() {
+	d.once.Do(func() { close(d.stop) })
+	<-d.done
+}
+
+// RunOnce flags the interviews that are currently overdue
+// @param ctx context.Context - Cancels the run and carries its deadline
+// @return int - The number of interviews flagged
+// @return error - An error if the interviews could not be flagged
+func (d *Detector) RunOnce(ctx context.Context) (int, error) {
+	flagged, err := d.service.FlagOverdueInterviews(ctx, d.now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	if flagged > 0 {
+		flaggedTotal.Add(float64(flagged))
+		slog.Info("Flagged interviews as needing attention", "component", "attention", "count", flagged)
+	}
+	return flagged, nil
+}
@@ -0,0 +1,34 @@
+package attention
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRunOnce(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 30, 14, 0, 0, 0, time.UTC)
+	mockService := new(service.MockInterviewService)
+	detector := NewDetector(mockService, time.Minute)
+	detector.now = func() time.Time { return now }
+
+	// Mock behavior
+	mockService.On("FlagOverdueInterviews", mock.Anything, now).Return(3, nil).Once()
+	mockService.On("FlagOverdueInterviews", mock.Anything, now).Return(0, errors.New("database error")).Once()
+
+	// Execute
+	flagged, err := detector.RunOnce(context.Background())
+	_, failErr := detector.RunOnce(context.Background())
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 3, flagged)
+	assert.EqualError(t, failErr, "database error")
+	mockService.AssertExpectations(t)
+}
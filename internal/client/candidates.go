@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"strconv"
+)
+
+// Candidate represents a candidate as returned by the candidates service
+type Candidate struct {
+	ID     int    `json:"id"`     // Unique identifier for the candidate
+	Name   string `json:"name"`   // Full name of the candidate
+	Email  string `json:"email"`  // Contact address of the candidate
+	Status string `json:"status"` // Status in the hiring process, e.g. active or withdrawn
+}
+
+// Active reports whether the candidate can still be interviewed
+// @return bool - True unless the candidate withdrew, was rejected or was hired
+func (c *Candidate) Active() bool {
+	switch c.Status {
+	case "withdrawn", "rejected", "hired", "inactive":
+		return false
+	}
+	return true
+}
+
+// CandidateClient defines methods for looking up candidates in the candidates service
+type CandidateClient interface {
+	// GetCandidate retrieves a candidate by ID
+	// @param ctx context.Context - Cancels the request and carries its deadline and trace
+	// @param id int - The ID of the candidate
+	// @return *Candidate - The candidate
+	// @return error - ErrNotFound if the candidate does not exist, ErrUnavailable if the service cannot be reached
+	GetCandidate(ctx context.Context, id int) (*Candidate, error)
+}
+
+type httpCandidateClient struct {
+	getter *httpGetter // Performs the HTTP calls
+}
+
+// NewHTTPCandidateClient creates a CandidateClient backed by the candidates service REST API
+// Requests time out, are retried on transient failures, and stop while the service keeps failing.
+// @param cfg HTTPConfig - The connection settings
+// @return CandidateClient - An instance of the client interface implementation
+func NewHTTPCandidateClient(cfg HTTPConfig) CandidateClient {
+	return &httpCandidateClient{getter: newHTTPGetter(cfg)}
+}
+
+// GetCandidate retrieves a candidate with GET /candidates/{id}
+// @param ctx context.Context - Cancels the request and carries its deadline and trace
+// @param id int - The ID of the candidate
+// @return *Candidate - The candidate
+// @return error - ErrNotFound if the candidate does not exist, ErrUnavailable if the service cannot be reached
+func (c *httpCandidateClient) GetCandidate(ctx context.Context, id int) (*Candidate, error) {
+	var candidate Candidate
+	if err := c.getter.getJSON(ctx, "/candidates/"+strconv.Itoa(id), &candidate); err != nil {
+		return nil, err
+	}
+	return &candidate, nil
+}
+
+// Ping checks that the candidates service answers its health path
+// @param ctx context.Context - Cancels the check and carries its deadline
+// @return error - ErrUnavailable if the service cannot be reached, is unhealthy or its circuit is open
+func (c *httpCandidateClient) Ping(ctx context.Context) error {
+	return c.getter.ping(ctx)
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCandidateClient is a mock implementation of CandidateClient for testing
+type MockCandidateClient struct {
+	mock.Mock
+}
+
+// GetCandidate mocks the GetCandidate method
+func (m *MockCandidateClient) GetCandidate(ctx context.Context, id int) (*Candidate, error) {
+	args := m.Called(ctx, id)
+	if candidate, ok := args.Get(0).(*Candidate); ok {
+		return candidate, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// MockJobClient is a mock implementation of JobClient for testing
+type MockJobClient struct {
+	mock.Mock
+}
+
+// GetJob mocks the GetJob method
+func (m *MockJobClient) GetJob(ctx context.Context, id int) (*Job, error) {
+	args := m.Called(ctx, id)
+	if job, ok := args.Get(0).(*Job); ok {
+		return job, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+	"sync"
+)
+
+// FakeCandidateClient is an in-memory CandidateClient for tests and local development
+type FakeCandidateClient struct {
+	mu         sync.RWMutex
+	candidates map[int]*Candidate
+}
+
+// NewFakeCandidateClient creates a FakeCandidateClient knowing the given candidates
+// @param candidates ...*Candidate - The candidates the fake returns
+// @return *FakeCandidateClient - The fake client
+func NewFakeCandidateClient(candidates ...*Candidate) *FakeCandidateClient {
+	f := &FakeCandidateClient{candidates: make(map[int]*Candidate)}
+	for _, c := range candidates {
+		f.Put(c)
+	}
+	return f
+}
+
+// Put adds or replaces a candidate
+// @param candidate *Candidate - The candidate to store
+func (f *FakeCandidateClient) Put(candidate *Candidate) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.candidates[candidate.ID] = candidate
+}
+
+// GetCandidate returns a copy of a stored candidate
+// @param ctx context.Context - Unused
+// @param id int - The ID of the candidate
+// @return *Candidate - The candidate
+// @return error - ErrNotFound if the candidate is not stored
+func (f *FakeCandidateClient) GetCandidate(ctx context.Context, id int) (*Candidate, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	c, ok := f.candidates[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *c
+	return &copied, nil
+}
+
+// FakeJobClient is an in-memory JobClient for tests and local development
+type FakeJobClient struct {
+	mu   sync.RWMutex
+	jobs map[int]*Job
+}
+
+// NewFakeJobClient creates a FakeJobClient knowing the given jobs
+// @param jobs ...*Job - The jobs the fake returns
+// @return *FakeJobClient - The fake client
+func NewFakeJobClient(jobs ...*Job) *FakeJobClient {
+	f := &FakeJobClient{jobs: make(map[int]*Job)}
+	for _, j := range jobs {
+		f.Put(j)
+	}
+	return f
+}
+
+// Put adds or replaces a job
+// @param job *Job - The job to store
+func (f *FakeJobClient) Put(job *Job) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.jobs[job.ID] = job
+}
+
+// GetJob returns a copy of a stored job
+// @param ctx context.Context - Unused
+// @param id int - The ID of the job
+// @return *Job - The job
+// @return error - ErrNotFound if the job is not stored
+func (f *FakeJobClient) GetJob(ctx context.Context, id int) (*Job, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	j, ok := f.jobs[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	copied := *j
+	return &copied, nil
+}
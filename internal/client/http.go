@@ -0,0 +1,173 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/pkg/circuitbreaker"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// ErrNotFound is returned when the remote service does not know the requested record
+var ErrNotFound = errors.New("record not found")
+
+// ErrUnavailable is returned when the remote service cannot be reached or keeps failing
+var ErrUnavailable = errors.New("service unavailable")
+
+// HTTPConfig holds the settings shared by the HTTP clients
+type HTTPConfig struct {
+	BaseURL          string        // Base URL of the remote service, e.g. http://candidates-service:3000
+	Token            string        // Bearer token sent on every request, empty to send none
+	Timeout          time.Duration // Timeout of a single attempt
+	MaxRetries       int           // Retries after the first attempt for transport errors, 429 and 5xx
+	RetryBackoff     time.Duration // Delay before the first retry, doubled on every further retry
+	FailureThreshold int           // Consecutive failed calls that open the circuit breaker
+	OpenTimeout      time.Duration // Time the circuit stays open before a trial call
+	HealthPath       string        // Path answered by the remote service while it is up, checked by Ping
+}
+
+// DefaultHTTPConfig returns the settings used when only the base URL is configured
+// @param baseURL string - The base URL of the remote service
+// @return HTTPConfig - The default settings
+func DefaultHTTPConfig(baseURL string) HTTPConfig {
+	return HTTPConfig{
+		BaseURL:          baseURL,
+		Timeout:          2 * time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     100 * time.Millisecond,
+		FailureThreshold: 5,
+		OpenTimeout:      30 * time.Second,
+		HealthPath:       "/health",
+	}
+}
+
+// Pinger is implemented by clients that can check whether their remote service is up
+type Pinger interface {
+	// Ping checks that the remote service answers its health path
+	// @param ctx context.Context - Cancels the check and carries its deadline
+	// @return error - ErrUnavailable if the service cannot be reached, is unhealthy or its circuit is open
+	Ping(ctx context.Context) error
+}
+
+// httpGetter performs JSON GET requests with retries behind a circuit breaker
+type httpGetter struct {
+	cfg     HTTPConfig              // Connection settings
+	client  *http.Client            // Client with the per-attempt timeout, passing the trace context on
+	breaker *circuitbreaker.Breaker // Stops calling the service while it is failing
+	sleep   func(time.Duration)     // Used between retries, replaced in tests
+}
+
+// newHTTPGetter creates a getter for the given settings
+// Every attempt is traced as a client span and sends the traceparent header of the request context.
+func newHTTPGetter(cfg HTTPConfig) *httpGetter {
+	return &httpGetter{
+		cfg:     cfg,
+		client:  &http.Client{Timeout: cfg.Timeout, Transport: otelhttp.NewTransport(http.DefaultTransport)},
+		breaker: circuitbreaker.New(cfg.FailureThreshold, cfg.OpenTimeout),
+		sleep:   time.Sleep,
+	}
+}
+
+// retryableError marks failures worth retrying and counting against the circuit breaker
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string { return e.err.Error() }
+func (e *retryableError) Unwrap() error { return e.err }
+
+// getJSON fetches path from the remote service and decodes the response into out
+// @param ctx context.Context - Cancels the request and its retries, and carries its deadline and trace
+// @param path string - The path relative to the base URL
+// @param out interface{} - The value the JSON response is decoded into
+// @return error - ErrNotFound for a 404, ErrUnavailable when the service cannot be reached, or a decoding error
+func (g *httpGetter) getJSON(ctx context.Context, path string, out interface{}) error {
+	err := g.breaker.Execute(func() error {
+		var err error
+		for attempt := 0; attempt <= g.cfg.MaxRetries; attempt++ {
+			if attempt > 0 {
+				g.sleep(g.cfg.RetryBackoff << (attempt - 1))
+			}
+			if err = g.attempt(ctx, path, out); err == nil {
+				return nil
+			}
+			var retryable *retryableError
+			if !errors.As(err, &retryable) || ctx.Err() != nil {
+				return err // Not worth retrying, or the caller gave up
+			}
+		}
+		return err
+	}, func(err error) bool {
+		var retryable *retryableError
+		return errors.As(err, &retryable)
+	})
+
+	var retryable *retryableError
+	if errors.Is(err, circuitbreaker.ErrOpen) || errors.As(err, &retryable) {
+		return fmt.Errorf("%w: %s: %v", ErrUnavailable, g.cfg.BaseURL, err)
+	}
+	return err
+}
+
+// attempt performs a single GET request
+func (g *httpGetter) attempt(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(g.cfg.BaseURL, "/")+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if g.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.cfg.Token)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return &retryableError{err: err}
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return &retryableError{err: fmt.Errorf("unexpected response status %d", resp.StatusCode)}
+	case resp.StatusCode < 200 || resp.StatusCode >= 300:
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(out)
+}
+
+// ping performs a single GET request on the health path of the remote service
+// An open circuit fails without calling the service, so readiness reflects what requests experience. The outcome
+// is not recorded by the circuit breaker, which only counts calls made on behalf of requests.
+// @param ctx context.Context - Cancels the request and carries its deadline
+// @return error - ErrUnavailable if the circuit is open, the service cannot be reached or answers with an error
+func (g *httpGetter) ping(ctx context.Context) error {
+	if g.breaker.State() == circuitbreaker.Open {
+		return fmt.Errorf("%w: %s: %v", ErrUnavailable, g.cfg.BaseURL, circuitbreaker.ErrOpen)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(g.cfg.BaseURL, "/")+g.cfg.HealthPath, nil)
+	if err != nil {
+		return err
+	}
+	if g.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.cfg.Token)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrUnavailable, g.cfg.BaseURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %s: unexpected response status %d", ErrUnavailable, g.cfg.BaseURL, resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// newTestConfig returns settings that retry without sleeping for long
+func newTestConfig(baseURL string) HTTPConfig {
+	cfg := DefaultHTTPConfig(baseURL)
+	cfg.RetryBackoff = time.Millisecond
+	cfg.Token = "service-token"
+	return cfg
+}
+
+func TestHTTPCandidateClient_GetCandidate(t *testing.T) {
+	// Setup
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer service-token", r.Header.Get("Authorization"))
+		if r.URL.Path != "/candidates/101" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":101,"name":"Ana","email":"ana@example.com","status":"active"}`))
+	}))
+	defer server.Close()
+	candidates := NewHTTPCandidateClient(newTestConfig(server.URL))
+
+	// Execute
+	candidate, err := candidates.GetCandidate(context.Background(), 101)
+	_, notFoundErr := candidates.GetCandidate(context.Background(), 999)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "ana@example.com", candidate.Email)
+	assert.True(t, candidate.Active())
+	assert.ErrorIs(t, notFoundErr, ErrNotFound)
+}
+
+func TestHTTPClient_PropagatesTraceContext(t *testing.T) {
+	// Setup
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("Traceparent")
+		_, _ = w.Write([]byte(`{"id":101,"status":"active"}`))
+	}))
+	defer server.Close()
+	candidates := NewHTTPCandidateClient(newTestConfig(server.URL))
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(),
+		propagation.HeaderCarrier(http.Header{"Traceparent": []string{traceparent}}))
+
+	// Execute
+	_, err := candidates.GetCandidate(ctx, 101)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, traceparent, received) // Passed on unchanged while no tracer provider records spans
+}
+
+func TestHTTPJobClient_RetriesTransientFailures(t *testing.T) {
+	// Setup
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":201,"title":"Backend Engineer","status":"closed"}`))
+	}))
+	defer server.Close()
+	jobs := NewHTTPJobClient(newTestConfig(server.URL))
+
+	// Execute
+	job, err := jobs.GetJob(context.Background(), 201)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.False(t, job.Open())
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestHTTPJobClient_OpensCircuit(t *testing.T) {
+	// Setup
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	cfg := newTestConfig(server.URL)
+	cfg.MaxRetries = 0
+	cfg.FailureThreshold = 2
+	jobs := NewHTTPJobClient(cfg)
+
+	// Execute
+	for i := 0; i < 4; i++ {
+		_, err := jobs.GetJob(context.Background(), 201)
+
+		// Assertions
+		assert.ErrorIs(t, err, ErrUnavailable)
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls)) // Calls after the threshold never reach the server
+}
+
+func TestHTTPClient_Ping(t *testing.T) {
+	// Setup
+	var healthy atomic.Bool
+	healthy.Store(true)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/health" && healthy.Load() {
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	cfg := newTestConfig(server.URL)
+	cfg.MaxRetries = 0
+	cfg.FailureThreshold = 1
+	jobs := NewHTTPJobClient(cfg)
+	pinger, ok := jobs.(Pinger)
+	ctx := context.Background()
+
+	// Execute
+	err := pinger.Ping(ctx)
+	healthy.Store(false)
+	unhealthyErr := pinger.Ping(ctx)
+	healthy.Store(true)
+	_, _ = jobs.GetJob(context.Background(), 201) // Opens the circuit
+	openErr := pinger.Ping(ctx)
+
+	// Assertions
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, unhealthyErr, ErrUnavailable)
+	assert.ErrorIs(t, openErr, ErrUnavailable)
+	assert.ErrorContains(t, openErr, "circuit breaker is open")
+}
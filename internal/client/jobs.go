@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"strconv"
+)
+
+// Job represents a job opening as returned by the jobs service
+type Job struct {
+	ID                 int    `json:"id"`                   // Unique identifier for the job
+	Title              string `json:"title"`                // Title of the position
+	Status             string `json:"status"`               // Status of the opening, e.g. open or closed
+	HiringManagerEmail string `json:"hiring_manager_email"` // Contact address of the hiring manager
+}
+
+// Open reports whether the job still accepts interviews
+// @return bool - True unless the job was closed, filled or archived
+func (j *Job) Open() bool {
+	switch j.Status {
+	case "closed", "filled", "archived", "inactive":
+		return false
+	}
+	return true
+}
+
+// JobClient defines methods for looking up jobs in the jobs service
+type JobClient interface {
+	// GetJob retrieves a job by ID
+	// @param ctx context.Context - Cancels the request and carries its deadline and trace
+	// @param id int - The ID of the job
+	// @return *Job - The job
+	// @return error - ErrNotFound if the job does not exist, ErrUnavailable if the service cannot be reached
+	GetJob(ctx context.Context, id int) (*Job, error)
+}
+
+type httpJobClient struct {
+	getter *httpGetter // Performs the HTTP calls
+}
+
+// NewHTTPJobClient creates a JobClient backed by the jobs service REST API
+// Requests time out, are retried on transient failures, and stop while the service keeps failing.
+// @param cfg HTTPConfig - The connection settings
+// @return JobClient - An instance of the client interface implementation
+func NewHTTPJobClient(cfg HTTPConfig) JobClient {
+	return &httpJobClient{getter: newHTTPGetter(cfg)}
+}
+
+// GetJob retrieves a job with GET /jobs/{id}
+// @param ctx context.Context - Cancels the request and carries its deadline and trace
+// @param id int - The ID of the job
+// @return *Job - The job
+// @return error - ErrNotFound if the job does not exist, ErrUnavailable if the service cannot be reached
+func (c *httpJobClient) GetJob(ctx context.Context, id int) (*Job, error) {
+	var job Job
+	if err := c.getter.getJSON(ctx, "/jobs/"+strconv.Itoa(id), &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Ping checks that the jobs service answers its health path
+// @param ctx context.Context - Cancels the check and carries its deadline
+// @return error - ErrUnavailable if the service cannot be reached, is unhealthy or its circuit is open
+func (c *httpJobClient) Ping(ctx context.Context) error {
+	return c.getter.ping(ctx)
+}
@@ -0,0 +1,114 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+)
+
+// Event types published by the candidates and jobs services
+const (
+	EventCandidateWithdrawn = "candidate.withdrawn" // A candidate left the hiring process
+	EventJobClosed          = "job.closed"          // A job opening was closed
+)
+
+// Cancellation reasons recorded on interviews and sent to participants
+const (
+	ReasonCandidateWithdrawn = "The candidate withdrew from the hiring process"
+	ReasonJobClosed          = "The job opening was closed"
+)
+
+// ErrUnsupportedEvent is returned for event types this service does not react to
+// Broker integrations should acknowledge such messages instead of retrying them.
+var ErrUnsupportedEvent = errors.New("unsupported event type")
+
+// ErrInvalidEvent is returned when an event is missing the data it needs
+// Broker integrations should dead-letter such messages since retrying cannot succeed.
+var ErrInvalidEvent = errors.New("invalid event")
+
+// Consumer defines methods for reacting to events from other services
+// Handle is safe to call more than once with the same event, so it can be driven by
+// at-least-once brokers: return nil to acknowledge and an error to have the message redelivered.
+type Consumer interface {
+	// Handle reacts to a single event
+	// @param ctx context.Context - Cancels the handling and carries its deadline
+	// @param event domain.InboundEvent - The event to handle
+	// @return int - The number of interviews affected
+	// @return error - ErrUnsupportedEvent, ErrInvalidEvent, or an error if the event could not be processed
+	Handle(ctx context.Context, event domain.InboundEvent) (int, error)
+}
+
+// HandleMessage decodes a raw broker message and passes it to a consumer
+// Intended for broker subscriptions that deliver the JSON envelope as the message body.
+// Unsupported event types are acknowledged so that shared topics do not redeliver them.
+// @param ctx context.Context - Cancels the handling and carries its deadline
+// @param c Consumer - The consumer handling the event
+// @param body []byte - The JSON encoded domain.InboundEvent
+// @return error - ErrInvalidEvent if the body cannot be decoded, or the error returned by the consumer
+func HandleMessage(ctx context.Context, c Consumer, body []byte) error {
+	var event domain.InboundEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidEvent, err)
+	}
+	if _, err := c.Handle(ctx, event); err != nil && !errors.Is(err, ErrUnsupportedEvent) {
+		return err
+	}
+	return nil
+}
+
+type interviewConsumer struct {
+	service service.InterviewService // Service used to cancel interviews
+}
+
+// NewInterviewConsumer creates a Consumer that cancels interviews affected by other services' events
+// On candidate.withdrawn every future interview of the candidate is cancelled, and on
+// job.closed every future interview for the job.
+// @param interviewService service.InterviewService - The service used to cancel interviews
+// @return Consumer - An instance of the consumer interface implementation
+func NewInterviewConsumer(interviewService service.InterviewService) Consumer {
+	return &interviewConsumer{service: interviewService}
+}
+
+// Handle cancels the interviews affected by an event
+// @param ctx context.Context - Cancels the handling and carries its deadline
+// @param event domain.InboundEvent - The event to handle
+// @return int - The number of interviews cancelled
+// @return error - ErrUnsupportedEvent, ErrInvalidEvent, or an error if the interviews could not be cancelled
+func (c *interviewConsumer) Handle(ctx context.Context, event domain.InboundEvent) (int, error) {
+	switch event.Type {
+	case EventCandidateWithdrawn:
+		var data struct {
+			CandidateID int `json:"candidate_id"`
+		}
+		if err := json.Unmarshal(event.Data, &data); err != nil || data.CandidateID <= 0 {
+			return 0, fmt.Errorf("%w: %s requires data.candidate_id", ErrInvalidEvent, event.Type)
+		}
+		cancelled, err := c.service.CancelUpcomingForCandidate(ctx, data.CandidateID, ReasonCandidateWithdrawn)
+		if err == nil {
+			logger.FromContext(ctx).Info("Cancelled upcoming interviews of candidate", "component", "consumer",
+				"event_type", event.Type, "event_id", event.ID, "count", cancelled, "candidate_id", data.CandidateID)
+		}
+		return cancelled, err
+
+	case EventJobClosed:
+		var data struct {
+			JobID int `json:"job_id"`
+		}
+		if err := json.Unmarshal(event.Data, &data); err != nil || data.JobID <= 0 {
+			return 0, fmt.Errorf("%w: %s requires data.job_id", ErrInvalidEvent, event.Type)
+		}
+		cancelled, err := c.service.CancelUpcomingForJob(ctx, data.JobID, ReasonJobClosed)
+		if err == nil {
+			logger.FromContext(ctx).Info("Cancelled upcoming interviews for job", "component", "consumer",
+				"event_type", event.Type, "event_id", event.ID, "count", cancelled, "job_id", data.JobID)
+		}
+		return cancelled, err
+	}
+
+	return 0, fmt.Errorf("%w: %q", ErrUnsupportedEvent, event.Type)
+}
@@ -0,0 +1,23 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockConsumer is a mock implementation of Consumer for testing
+type MockConsumer struct {
+	mock.Mock
+}
+
+// Handle mocks the Handle method
+// @param ctx context.Context - The context of the call
+// @param event domain.InboundEvent - The event to handle
+// @return int - The number of interviews affected
+// @return error - An error if the operation fails
+func (m *MockConsumer) Handle(ctx context.Context, event domain.InboundEvent) (int, error) {
+	args := m.Called(ctx, event)
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,115 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandle_CandidateWithdrawn(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	interviewConsumer := NewInterviewConsumer(mockService)
+
+	// Mock behavior
+	mockService.On("CancelUpcomingForCandidate", mock.Anything, 101, ReasonCandidateWithdrawn).Return(2, nil)
+
+	// Execute
+	cancelled, err := interviewConsumer.Handle(context.Background(), domain.InboundEvent{
+		ID:   "evt-1",
+		Type: EventCandidateWithdrawn,
+		Data: json.RawMessage(`{"candidate_id":101}`),
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cancelled)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandle_JobClosed(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	interviewConsumer := NewInterviewConsumer(mockService)
+
+	// Mock behavior
+	mockService.On("CancelUpcomingForJob", mock.Anything, 201, ReasonJobClosed).Return(3, nil)
+
+	// Execute
+	cancelled, err := interviewConsumer.Handle(context.Background(), domain.InboundEvent{
+		ID:   "evt-2",
+		Type: EventJobClosed,
+		Data: json.RawMessage(`{"job_id":201}`),
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 3, cancelled)
+	mockService.AssertExpectations(t)
+}
+
+func TestHandle_InvalidData(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	interviewConsumer := NewInterviewConsumer(mockService)
+
+	// Execute
+	_, err := interviewConsumer.Handle(context.Background(), domain.InboundEvent{Type: EventJobClosed, Data: json.RawMessage(`{"job_id":0}`)})
+
+	// Assertions
+	assert.ErrorIs(t, err, ErrInvalidEvent)
+	mockService.AssertNotCalled(t, "CancelUpcomingForJob", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandle_UnsupportedEvent(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	interviewConsumer := NewInterviewConsumer(mockService)
+
+	// Execute
+	_, err := interviewConsumer.Handle(context.Background(), domain.InboundEvent{Type: "candidate.created"})
+
+	// Assertions
+	assert.ErrorIs(t, err, ErrUnsupportedEvent)
+}
+
+func TestHandleMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		result   error
+		expected error
+	}{
+		{name: "processed", body: `{"type":"job.closed","data":{"job_id":1}}`},
+		{name: "unsupported is acknowledged", body: `{"type":"job.opened"}`, result: ErrUnsupportedEvent},
+		{name: "processing error is returned", body: `{"type":"job.closed","data":{"job_id":1}}`, result: errors.New("database error"), expected: errors.New("database error")},
+		{name: "malformed body", body: `not json`, expected: ErrInvalidEvent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockConsumer := new(MockConsumer)
+			mockConsumer.On("Handle", mock.Anything, mock.Anything).Return(0, tt.result)
+
+			// Execute
+			err := HandleMessage(context.Background(), mockConsumer, []byte(tt.body))
+
+			// Assertions
+			switch {
+			case tt.expected == nil:
+				assert.NoError(t, err)
+			case errors.Is(tt.expected, ErrInvalidEvent):
+				assert.ErrorIs(t, err, ErrInvalidEvent)
+			default:
+				assert.EqualError(t, err, tt.expected.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// EventType identifies the kind of change that happened to an interview
+type EventType string
+
+const (
+	EventInterviewCreated     EventType = "interview.created"     // A new interview was scheduled
+	EventInterviewRescheduled EventType = "interview.rescheduled" // An interview was moved to a different date
+	EventInterviewCancelled   EventType = "interview.cancelled"   // An interview was cancelled
+	EventInterviewReminder    EventType = "interview.reminder"    // An interview is coming up, only sent to participants
+)
+
+// Event describes a change to an interview
+// Events are recorded in the outbox in the same transaction as the write that caused them and
+// are then relayed to notifications and webhooks.
+type Event struct {
+	ID           string     `json:"id"`                      // Unique identifier, stable across redeliveries
+	Type         EventType  `json:"type"`                    // Kind of change
+	Interview    Interview  `json:"interview"`               // Snapshot of the interview after the change
+	PreviousDate *time.Time `json:"previous_date,omitempty"` // Interview date before a reschedule
+	Reason       string     `json:"reason,omitempty"`        // Reason given for a cancellation
+	OccurredAt   time.Time  `json:"occurred_at"`             // Time at which the change happened
+}
+
+// Valid reports whether the event type is one emitted by the service
+// Reminders are not included since they are only emailed to participants, never published.
+func (t EventType) Valid() bool {
+	switch t {
+	case EventInterviewCreated, EventInterviewRescheduled, EventInterviewCancelled:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// FeedbackAssignment is the feedback an interviewer owes for an interview
+// Deadline is derived from the interview's stage and the configured SLA.
+type FeedbackAssignment struct {
+	Interview  Interview `json:"interview"`   // Interview the feedback is about
+	Panelist   Panelist  `json:"panelist"`    // Interviewer who owes the feedback
+	Deadline   time.Time `json:"deadline"`    // Time by which the feedback is due
+	NudgeLevel int       `json:"nudge_level"` // Highest reminder sent so far: 0 none, 1 interviewer, 2 hiring manager
+}
+
+// FeedbackSubmission is the body of a feedback submission by an interviewer
+type FeedbackSubmission struct {
+	Feedback string `json:"feedback"` // Feedback about the candidate
+}
+
+// SLAEntry summarises feedback timeliness for one interviewer or job
+type SLAEntry struct {
+	Key            string  `json:"key"`             // Interviewer email or job ID
+	OnTime         int     `json:"on_time"`         // Feedback submitted before the deadline
+	Late           int     `json:"late"`            // Feedback submitted after the deadline
+	Overdue        int     `json:"overdue"`         // Feedback missing past the deadline
+	Pending        int     `json:"pending"`         // Feedback missing but not yet due
+	ComplianceRate float64 `json:"compliance_rate"` // Share of due feedback submitted on time, 1 when none is due yet
+}
+
+// SLAReport summarises feedback timeliness for interviews held in a period
+type SLAReport struct {
+	From          time.Time  `json:"from"`           // Start of the period, inclusive
+	To            time.Time  `json:"to"`             // End of the period, exclusive
+	ByInterviewer []SLAEntry `json:"by_interviewer"` // One entry per interviewer email
+	ByJob         []SLAEntry `json:"by_job"`         // One entry per job
+}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// InboundEvent represents an event received from another service
+// The same envelope is accepted on the HTTP endpoint and from a message broker.
+type InboundEvent struct {
+	ID         string          `json:"id"`                        // Identifier assigned by the producer
+	Type       string          `json:"type"`                      // Event type, e.g. candidate.withdrawn
+	OccurredAt time.Time       `json:"occurred_at"`               // Time at which the event happened
+	Data       json.RawMessage `json:"data" swaggertype:"object"` // Event specific data, e.g. {"candidate_id": 101}
+}
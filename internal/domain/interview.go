@@ -2,12 +2,60 @@ package domain
 
 import "time"
 
+// DefaultInterviewDuration is the length assumed for an interview when building calendar invites
+// and when deciding whether an interview is over
+const DefaultInterviewDuration = time.Hour
+
+// InterviewStatus describes where an interview is in its lifecycle
+type InterviewStatus string
+
+const (
+	StatusScheduled      InterviewStatus = "scheduled"       // The interview is planned and will take place
+	StatusCancelled      InterviewStatus = "cancelled"       // The interview was called off
+	StatusNeedsAttention InterviewStatus = "needs_attention" // The interview is over with no outcome or feedback recorded
+	StatusCompleted      InterviewStatus = "completed"       // The interview took place
+	StatusNoShow         InterviewStatus = "no_show"         // The candidate did not attend
+)
+
 // Interview represents an interview record in the system
 // This struct defines the schema of an interview as it is stored in the database.
 type Interview struct {
-	ID            int       `json:"id"`             // Unique identifier for the interview
-	CandidateID   int       `json:"candidate_id"`   // Foreign key referencing the candidate's ID
-	JobID         int       `json:"job_id"`         // Foreign key referencing the job's ID
-	InterviewDate time.Time `json:"interview_date"` // Date and time of the interview
-	Feedback      string    `json:"feedback"`       // Feedback or notes about the interview
+	ID                 int             `json:"id"`                                // Unique identifier for the interview
+	CandidateID        int             `json:"candidate_id" binding:"required"`   // Foreign key referencing the candidate's ID
+	JobID              int             `json:"job_id" binding:"required"`         // Foreign key referencing the job's ID
+	InterviewDate      time.Time       `json:"interview_date" binding:"required"` // Date and time of the interview
+	Feedback           string          `json:"feedback"`                          // Feedback or notes about the interview
+	Stage              string          `json:"stage,omitempty"`                   // Hiring stage, e.g. screening or onsite, used to pick the feedback SLA
+	CandidateEmail     string          `json:"candidate_email,omitempty"`         // Address used to notify the candidate
+	Panel              []Panelist      `json:"panel,omitempty"`                   // Interviewers taking part in the interview
+	Status             InterviewStatus `json:"status,omitempty"`                  // Lifecycle status, scheduled on creation
+	CancellationReason string          `json:"cancellation_reason,omitempty"`     // Reason given when the interview was cancelled
+}
+
+// Panelist represents an interviewer assigned to an interview
+// Panelists are stored in the interview_panelists table and receive the same notifications as the candidate.
+type Panelist struct {
+	ID          int    `json:"id"`           // Unique identifier for the panelist
+	InterviewID int    `json:"interview_id"` // Foreign key referencing the interview's ID
+	Name        string `json:"name"`         // Display name of the interviewer
+	Email       string `json:"email"`        // Address used to notify the interviewer
+
+	Feedback            string     `json:"feedback,omitempty"`              // Feedback submitted by the interviewer
+	FeedbackSubmittedAt *time.Time `json:"feedback_submitted_at,omitempty"` // Time the feedback was submitted, nil while pending
+}
+
+// AttentionResolution is a request to settle interviews flagged as needing attention
+// Status must be completed or no_show.
+type AttentionResolution struct {
+	InterviewIDs []int           `json:"interview_ids"` // Interviews to resolve
+	Status       InterviewStatus `json:"status"`        // Outcome recorded for every interview
+}
+
+// InterviewFilter selects interviews by status, candidate and job
+// Zero values match every interview, and a zero Limit returns every match.
+type InterviewFilter struct {
+	Status      InterviewStatus // Status the interviews must be in, empty for any
+	CandidateID int             // Candidate the interviews must belong to, zero for any
+	JobID       int             // Job the interviews must be for, zero for any
+	Limit       int             // Maximum number of interviews returned, lowest IDs first; zero for no limit
 }
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// OutboxMessage represents an event waiting in the outbox table to be relayed
+// Messages are written in the same transaction as the interview change they describe, so an
+// event is recorded if and only if the change is committed. A message that keeps failing is
+// retried with a growing delay and abandoned after too many attempts.
+type OutboxMessage struct {
+	ID            int        `json:"id"`              // Unique identifier, also the relay order
+	InterviewID   int        `json:"interview_id"`    // Interview the event belongs to, used to keep per-interview ordering
+	Event         Event      `json:"event"`           // The event to relay
+	Attempts      int        `json:"attempts"`        // Number of failed relay attempts
+	LastError     string     `json:"last_error"`      // Error of the last failed relay attempt
+	NextAttemptAt *time.Time `json:"next_attempt_at"` // Time before which a failed message is not retried, nil if it never failed
+	CreatedAt     time.Time  `json:"created_at"`      // Time at which the message was written
+	PublishedAt   *time.Time `json:"published_at"`    // Time at which the message was relayed, nil while pending
+	AbandonedAt   *time.Time `json:"abandoned_at"`    // Time at which the relay gave up on the message, nil while it is retried
+}
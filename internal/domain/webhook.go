@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DeliveryStatus describes where a webhook delivery is in its lifecycle
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"   // Waiting for its first or next attempt
+	DeliverySucceeded DeliveryStatus = "succeeded" // Acknowledged by the receiver with a 2xx response
+	DeliveryDead      DeliveryStatus = "dead"      // Gave up after exhausting retries, kept for replay
+)
+
+// WebhookSubscription represents an external endpoint subscribed to interview events
+// This struct defines the schema of a subscription as it is stored in the webhook_subscriptions table.
+type WebhookSubscription struct {
+	ID         int         `json:"id"`               // Unique identifier for the subscription
+	URL        string      `json:"url"`              // Endpoint receiving the events
+	Secret     string      `json:"secret,omitempty"` // Key used to sign payloads, only returned on creation
+	EventTypes []EventType `json:"event_types"`      // Events the endpoint is interested in
+	CreatedAt  time.Time   `json:"created_at"`       // Time at which the subscription was created
+}
+
+// Accepts reports whether the subscription wants events of the given type
+func (s *WebhookSubscription) Accepts(eventType EventType) bool {
+	for _, t := range s.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery represents a single event sent to a single subscription
+// This struct defines the schema of a delivery as it is stored in the webhook_deliveries table
+// and doubles as the delivery log and the dead-letter list.
+type WebhookDelivery struct {
+	ID             int             `json:"id"`                           // Unique identifier for the delivery
+	SubscriptionID int             `json:"subscription_id"`              // Foreign key referencing the subscription's ID
+	EventID        string          `json:"event_id"`                     // Identifier shared by all deliveries of the same event
+	EventType      EventType       `json:"event_type"`                   // Type of the delivered event
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"` // Exact body sent to the endpoint
+	Status         DeliveryStatus  `json:"status"`                       // Current delivery status
+	Attempts       int             `json:"attempts"`                     // Number of attempts made so far
+	ResponseCode   int             `json:"response_code"`                // HTTP status of the last attempt, 0 if no response
+	LastError      string          `json:"last_error"`                   // Error of the last failed attempt
+	NextAttemptAt  time.Time       `json:"next_attempt_at"`              // Time at which the next attempt is due
+	CreatedAt      time.Time       `json:"created_at"`                   // Time at which the delivery was created
+	UpdatedAt      time.Time       `json:"updated_at"`                   // Time of the last attempt or status change
+}
@@ -0,0 +1,79 @@
+package event
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// Broadcaster is a Publisher that hands every event to in-process subscribers
+// It backs streaming APIs such as the gRPC watch. Publishing never blocks: a subscriber
+// whose buffer is full misses the event rather than holding up the outbox relay.
+type Broadcaster struct {
+	mu          sync.RWMutex
+	subscribers map[int]chan domain.Event // Subscriber channels keyed by subscription ID
+	nextID      int                       // ID of the next subscription
+	closed      bool                      // Set by Close, later subscribers get a closed channel
+}
+
+// NewBroadcaster creates a new Broadcaster instance
+// @return *Broadcaster - A broadcaster without subscribers
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subscribers: make(map[int]chan domain.Event)}
+}
+
+// Subscribe registers a new subscriber
+// @param buffer int - The number of events buffered for the subscriber
+// @return <-chan domain.Event - The channel receiving the events, closed on unsubscribe
+// @return func() - Unsubscribes; safe to call more than once
+func (b *Broadcaster) Subscribe(buffer int) (<-chan domain.Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	ch := make(chan domain.Event, buffer)
+	if b.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = ch
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[id]; ok { // Close may have closed the channel already
+			delete(b.subscribers, id)
+			close(ch)
+		}
+	}
+}
+
+// Close closes the channel of every subscriber so streams built on them end, e.g. on shutdown
+// Events published afterwards are discarded.
+func (b *Broadcaster) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for id, ch := range b.subscribers {
+		delete(b.subscribers, id)
+		close(ch)
+	}
+}
+
+// Publish hands the event to every subscriber with room in its buffer
+// @param event domain.Event - The event to publish
+// @return error - Always nil
+func (b *Broadcaster) Publish(event domain.Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for id, ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("Subscriber is too slow, dropping event", "component", "event", "subscriber_id", id, "event_type", event.Type, "interview_id", event.Interview.ID)
+		}
+	}
+	return nil
+}
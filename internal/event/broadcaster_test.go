@@ -0,0 +1,62 @@
+package event
+
+import (
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroadcaster_DeliversToSubscribers(t *testing.T) {
+	// Setup
+	broadcaster := NewBroadcaster()
+	first, unsubscribeFirst := broadcaster.Subscribe(1)
+	second, unsubscribeSecond := broadcaster.Subscribe(1)
+	defer unsubscribeSecond()
+
+	// Execute
+	err := broadcaster.Publish(domain.Event{ID: "evt-1"})
+	unsubscribeFirst()
+	unsubscribeFirst()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "evt-1", (<-first).ID)
+	assert.Equal(t, "evt-1", (<-second).ID)
+	_, open := <-first
+	assert.False(t, open)
+}
+
+func TestBroadcaster_DropsEventsForSlowSubscribers(t *testing.T) {
+	// Setup
+	broadcaster := NewBroadcaster()
+	events, unsubscribe := broadcaster.Subscribe(1)
+	defer unsubscribe()
+
+	// Execute
+	assert.NoError(t, broadcaster.Publish(domain.Event{ID: "evt-1"}))
+	assert.NoError(t, broadcaster.Publish(domain.Event{ID: "evt-2"}))
+
+	// Assertions
+	assert.Equal(t, "evt-1", (<-events).ID)
+	assert.Len(t, events, 0)
+}
+
+func TestBroadcaster_Close(t *testing.T) {
+	// Setup
+	broadcaster := NewBroadcaster()
+	events, unsubscribe := broadcaster.Subscribe(1)
+
+	// Execute
+	broadcaster.Close()
+	unsubscribe()
+	late, _ := broadcaster.Subscribe(1)
+	err := broadcaster.Publish(domain.Event{ID: "evt-1"})
+
+	// Assertions
+	assert.NoError(t, err)
+	_, open := <-events
+	assert.False(t, open)
+	_, open = <-late
+	assert.False(t, open)
+}
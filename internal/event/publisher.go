@@ -0,0 +1,121 @@
+package event
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/notification"
+)
+
+// Publisher defines methods for publishing interview events
+// This interface abstracts the consumers interested in changes to interviews, such as
+// email notifications and outbound webhooks.
+type Publisher interface {
+	// Publish hands an event to the consumer
+	// @param event domain.Event - The event to publish
+	// @return error - An error if the consumer could not accept the event
+	Publish(event domain.Event) error
+}
+
+// PublisherFunc adapts an ordinary function to the Publisher interface
+type PublisherFunc func(event domain.Event) error
+
+// Publish calls f(event)
+func (f PublisherFunc) Publish(event domain.Event) error {
+	return f(event)
+}
+
+// maxPartialEvents bounds the partially failed events a Fanout remembers
+// Events that keep failing are eventually abandoned by the relay, so without a bound their entries would pile up.
+const maxPartialEvents = 10000
+
+// Fanout is a Publisher that publishes every event to several publishers
+// All publishers are called even if some of them fail. The publishers that accepted an event are
+// remembered by event ID until every publisher has accepted it, so when the relay retries a
+// partially failed event only the publishers that failed receive it again. At most
+// maxPartialEvents events are remembered; once full the oldest one is forgotten, and a retry of
+// it reaches every publisher again, which at-least-once delivery already allows.
+type Fanout struct {
+	publishers []Publisher              // Destinations of every event, in order
+	mu         sync.Mutex               // Guards accepted and order
+	accepted   map[string]*list.Element // Entries of partially failed events in order, by event ID
+	order      *list.List               // Partially failed events as *partialEvent, oldest first
+}
+
+// partialEvent records the publishers that accepted an event some other publisher failed
+type partialEvent struct {
+	id       string // ID of the event
+	accepted []bool // Whether each publisher accepted the event, in the order of Fanout.publishers
+}
+
+// NewFanout creates a new Fanout instance
+// @param publishers ...Publisher - The publishers receiving every event
+// @return *Fanout - A publisher forwarding every event to all of them
+func NewFanout(publishers ...Publisher) *Fanout {
+	return &Fanout{publishers: publishers, accepted: make(map[string]*list.Element), order: list.New()}
+}
+
+// Publish hands the event to every publisher that has not accepted it yet
+// Events without an ID cannot be told apart and are handed to every publisher.
+// @param event domain.Event - The event to publish
+// @return error - The joined errors of the publishers that failed, nil if all succeeded
+func (f *Fanout) Publish(event domain.Event) error {
+	accepted := make([]bool, len(f.publishers))
+	f.mu.Lock()
+	if e, ok := f.accepted[event.ID]; ok {
+		copy(accepted, e.Value.(*partialEvent).accepted)
+	}
+	f.mu.Unlock()
+
+	var errs []error
+	for i, p := range f.publishers {
+		if accepted[i] {
+			continue
+		}
+		if err := p.Publish(event); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		accepted[i] = true
+	}
+
+	if event.ID != "" {
+		f.mu.Lock()
+		f.remember(event.ID, accepted, len(errs) == 0)
+		f.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// remember records the publishers that accepted an event, forgetting it once all of them have; the caller holds mu
+// @param id string - The ID of the event
+// @param accepted []bool - Whether each publisher accepted the event
+// @param done bool - Whether every publisher has accepted the event
+func (f *Fanout) remember(id string, accepted []bool, done bool) {
+	if e, ok := f.accepted[id]; ok {
+		if done {
+			f.order.Remove(e)
+			delete(f.accepted, id)
+			return
+		}
+		e.Value.(*partialEvent).accepted = accepted
+		return
+	}
+	if done {
+		return
+	}
+	if f.order.Len() >= maxPartialEvents {
+		oldest := f.order.Remove(f.order.Front()).(*partialEvent)
+		delete(f.accepted, oldest.id)
+	}
+	f.accepted[id] = f.order.PushBack(&partialEvent{id: id, accepted: accepted})
+}
+
+// NotifierPublisher adapts a notification.Notifier to the Publisher interface
+// @param notifier notification.Notifier - The notifier receiving the events
+// @return Publisher - A publisher forwarding every event to the notifier
+func NotifierPublisher(notifier notification.Notifier) Publisher {
+	return PublisherFunc(notifier.Notify)
+}
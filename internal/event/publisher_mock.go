@@ -0,0 +1,19 @@
+package event
+
+import (
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPublisher is a mock implementation of Publisher for testing
+type MockPublisher struct {
+	mock.Mock
+}
+
+// Publish mocks the Publish method
+// @param event domain.Event - The event to publish
+// @return error - An error if the operation fails
+func (m *MockPublisher) Publish(event domain.Event) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
@@ -0,0 +1,79 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFanout_RetriesOnlyFailedPublishers(t *testing.T) {
+	// Setup
+	notifier := new(MockPublisher)
+	webhooks := new(MockPublisher)
+	fanout := NewFanout(notifier, webhooks)
+	event := domain.Event{ID: "evt-1", Type: domain.EventInterviewCreated}
+
+	// Mock behavior
+	notifier.On("Publish", event).Return(nil).Once()
+	webhooks.On("Publish", event).Return(errors.New("webhooks unavailable")).Once()
+	webhooks.On("Publish", event).Return(nil).Once()
+
+	// Execute
+	first := fanout.Publish(event)
+	retry := fanout.Publish(event)
+
+	// Assertions
+	assert.EqualError(t, first, "webhooks unavailable")
+	assert.NoError(t, retry)
+	notifier.AssertNumberOfCalls(t, "Publish", 1)
+	webhooks.AssertNumberOfCalls(t, "Publish", 2)
+	assert.Empty(t, fanout.accepted)
+}
+
+func TestFanout_EventsWithoutIDGoToEveryPublisher(t *testing.T) {
+	// Setup
+	notifier := new(MockPublisher)
+	webhooks := new(MockPublisher)
+	fanout := NewFanout(notifier, webhooks)
+	event := domain.Event{Type: domain.EventInterviewCreated}
+
+	// Mock behavior
+	notifier.On("Publish", event).Return(nil)
+	webhooks.On("Publish", event).Return(errors.New("webhooks unavailable"))
+
+	// Execute
+	_ = fanout.Publish(event)
+	err := fanout.Publish(event)
+
+	// Assertions
+	assert.Error(t, err)
+	notifier.AssertNumberOfCalls(t, "Publish", 2)
+	assert.Empty(t, fanout.accepted)
+}
+
+func TestFanout_ForgetsOldestPartialFailures(t *testing.T) {
+	// Setup
+	notifier := new(MockPublisher)
+	webhooks := new(MockPublisher)
+	fanout := NewFanout(notifier, webhooks)
+	oldest := domain.Event{ID: "evt-0", Type: domain.EventInterviewCreated}
+
+	// Mock behavior
+	notifier.On("Publish", mock.Anything).Return(nil)
+	webhooks.On("Publish", mock.Anything).Return(errors.New("webhooks unavailable"))
+
+	// Execute
+	for i := 0; i <= maxPartialEvents; i++ {
+		_ = fanout.Publish(domain.Event{ID: fmt.Sprintf("evt-%d", i), Type: domain.EventInterviewCreated})
+	}
+	_ = fanout.Publish(oldest)
+
+	// Assertions
+	assert.Len(t, fanout.accepted, maxPartialEvents)
+	assert.Equal(t, maxPartialEvents, fanout.order.Len())
+	notifier.AssertNumberOfCalls(t, "Publish", maxPartialEvents+2) // The oldest event was forgotten and sent again
+}
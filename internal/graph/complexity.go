@@ -0,0 +1,143 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Complexity scores a query by the number of fields it may resolve
+// Each field costs 1 plus the cost of its selections; the selections of a list field are
+// counted once per item, using its first argument or defaultListSize when it has none.
+// Introspection fields are free.
+// @param schema *ast.Schema - The schema the query is validated against
+// @param query string - The query document
+// @param operationName string - The operation to score, empty when the document has a single operation
+// @param variables map[string]interface{} - Values of the operation's variables
+// @param defaultListSize int - Number of items assumed for list fields without a first argument
+// @return int - The complexity score
+// @return error - An error if the query is invalid or the operation cannot be found
+func Complexity(schema *ast.Schema, query, operationName string, variables map[string]interface{}, defaultListSize int) (int, error) {
+	doc, gqlErrs := gqlparser.LoadQuery(schema, query)
+	if len(gqlErrs) > 0 {
+		return 0, gqlErrs
+	}
+
+	var op *ast.OperationDefinition
+	switch {
+	case operationName != "":
+		op = doc.Operations.ForName(operationName)
+	case len(doc.Operations) == 1:
+		op = doc.Operations[0]
+	}
+	if op == nil {
+		return 0, errors.New("the operation to run could not be determined")
+	}
+	return selectionComplexity(op.SelectionSet, variables, defaultListSize), nil
+}
+
+// selectionComplexity scores a selection set, following fragments
+// @param set ast.SelectionSet - The selections to score
+// @param variables map[string]interface{} - Values of the operation's variables
+// @param defaultListSize int - Number of items assumed for list fields without a first argument
+// @return int - The complexity score of the selections
+func selectionComplexity(set ast.SelectionSet, variables map[string]interface{}, defaultListSize int) int {
+	total := 0
+	for _, selection := range set {
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			total += 1 + listSize(s, variables, defaultListSize)*selectionComplexity(s.SelectionSet, variables, defaultListSize)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				total += selectionComplexity(s.Definition.SelectionSet, variables, defaultListSize)
+			}
+		case *ast.InlineFragment:
+			total += selectionComplexity(s.SelectionSet, variables, defaultListSize)
+		}
+	}
+	return total
+}
+
+// listSize returns the number of times the selections of a field are counted
+// The first argument is read from the query or from its variable, falling back to the variable's default and
+// then to the argument's default in the schema. Variables decoded from JSON are float64 or json.Number, so
+// every numeric type is accepted.
+// @param field *ast.Field - The field
+// @param variables map[string]interface{} - Values of the operation's variables
+// @param defaultListSize int - Number of items assumed for list fields without a first argument
+// @return int - 1 for non-list fields, otherwise the expected number of items
+func listSize(field *ast.Field, variables map[string]interface{}, defaultListSize int) int {
+	if field.Definition == nil || field.Definition.Type.Elem == nil {
+		return 1
+	}
+	first, ok := argumentValue(field, "first", variables)
+	if !ok {
+		return defaultListSize
+	}
+	n, ok := toInt(first)
+	switch {
+	case !ok:
+		return defaultListSize
+	case n < 0:
+		return 0
+	}
+	return n
+}
+
+// argumentValue returns the value of an argument of a field
+// @param field *ast.Field - The field
+// @param name string - The name of the argument
+// @param variables map[string]interface{} - Values of the operation's variables
+// @return interface{} - The value given in the query, by a variable or by a default
+// @return bool - False if the argument has no value at all
+func argumentValue(field *ast.Field, name string, variables map[string]interface{}) (interface{}, bool) {
+	if arg := field.Arguments.ForName(name); arg != nil && arg.Value != nil {
+		if arg.Value.Kind != ast.Variable {
+			value, err := arg.Value.Value(variables)
+			return value, err == nil
+		}
+		if value, ok := variables[arg.Value.Raw]; ok {
+			return value, true
+		}
+		if def := arg.Value.VariableDefinition; def != nil && def.DefaultValue != nil {
+			value, err := def.DefaultValue.Value(nil)
+			return value, err == nil
+		}
+	}
+	if def := field.Definition.Arguments.ForName(name); def != nil && def.DefaultValue != nil {
+		value, err := def.DefaultValue.Value(nil)
+		return value, err == nil
+	}
+	return nil, false
+}
+
+// toInt converts a numeric argument value to an int
+// @param value interface{} - The value, as parsed from the query or decoded from JSON variables
+// @return int - The value
+// @return bool - False if the value is not a number
+func toInt(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(math.Ceil(math.Max(math.Min(v, math.MaxInt32), math.MinInt32))), true
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		return toInt(f)
+	}
+	return 0, false
+}
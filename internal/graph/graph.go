@@ -0,0 +1,101 @@
+package graph
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Config holds the limits applied to incoming queries
+type Config struct {
+	MaxComplexity   int // Highest complexity score a query may have, see Complexity
+	MaxDepth        int // Deepest field nesting a query may have
+	DefaultListSize int // Number of items assumed for list fields without a first argument
+}
+
+// DefaultConfig returns the limits used by the service
+// @return Config - A complexity limit of 5000 with lists of 5 items, and a depth limit of 10
+func DefaultConfig() Config {
+	return Config{MaxComplexity: 5000, MaxDepth: 10, DefaultListSize: 5}
+}
+
+// Handler serves GraphQL queries over HTTP
+// Every request gets its own loaders, so batched lookups never leak between requests.
+type Handler struct {
+	schema   *graphql.Schema          // Executable schema backed by the resolvers
+	analysis *ast.Schema              // Same schema, used to score queries before running them
+	service  service.InterviewService // Business logic shared with the REST and gRPC APIs
+	cfg      Config                   // Query limits
+}
+
+// NewHandler creates a new Handler instance
+// @param service service.InterviewService - The service the resolvers and mutations go through
+// @param cfg Config - The query limits
+// @return *Handler - The handler, to be mounted on POST /graphql
+// @return error - An error if the schema cannot be parsed
+func NewHandler(service service.InterviewService, cfg Config) (*Handler, error) {
+	schema, err := graphql.ParseSchema(schemaSDL, &resolver{service: service}, graphql.MaxDepth(cfg.MaxDepth))
+	if err != nil {
+		return nil, err
+	}
+	analysis, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSDL})
+	if gqlErr != nil {
+		return nil, gqlErr
+	}
+	return &Handler{schema: schema, analysis: analysis, service: service, cfg: cfg}, nil
+}
+
+// request is the body of a GraphQL request
+type request struct {
+	Query         string                 `json:"query"`         // The query document
+	OperationName string                 `json:"operationName"` // The operation to run when the document has several
+	Variables     map[string]interface{} `json:"variables"`     // Values of the operation's variables
+}
+
+// ServeHTTP runs a GraphQL query
+// Queries scoring above the complexity limit are rejected with a 400 before any resolver runs.
+// @param w http.ResponseWriter - The response writer
+// @param r *http.Request - The request carrying the query as JSON
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrors(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+		return
+	}
+
+	complexity, err := Complexity(h.analysis, req.Query, req.OperationName, req.Variables, h.cfg.DefaultListSize)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if complexity > h.cfg.MaxComplexity {
+		writeErrors(w, http.StatusBadRequest, fmt.Sprintf("query complexity %d exceeds the limit of %d", complexity, h.cfg.MaxComplexity))
+		return
+	}
+
+	ctx := withLoaders(r.Context(), h.service)
+	response := h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+// writeErrors writes a GraphQL response carrying a single error and no data
+// @param w http.ResponseWriter - The response writer
+// @param status int - The HTTP status code
+// @param message string - The error message
+func writeErrors(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"message": message}},
+	})
+}
@@ -0,0 +1,236 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestQueryInterviews_NestedPanelAndScorecards(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	handler := newTestHandler(t, mockService)
+
+	// Mock data
+	submittedAt := time.Date(2024, time.December, 30, 17, 0, 0, 0, time.UTC)
+	interviews := []*domain.Interview{
+		{ID: 1, CandidateID: 101, JobID: 201, Stage: "onsite", Status: domain.StatusScheduled,
+			InterviewDate: time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC),
+			Panel: []domain.Panelist{
+				{ID: 1, InterviewID: 1, Name: "Alice", Email: "alice@example.com", Feedback: "Strong hire", FeedbackSubmittedAt: &submittedAt},
+				{ID: 2, InterviewID: 1, Name: "Bob", Email: "bob@example.com"},
+			}},
+	}
+
+	// Mock behavior
+	mockService.On("FindInterviews", mock.Anything, domain.InterviewFilter{CandidateID: 101, Limit: 50}).Return(interviews, nil)
+
+	// Execute
+	data, errs := execute(t, handler, `{
+		interviews(candidateId: 101) {
+			id stage interviewDate
+			panel { name interview { id } }
+			scorecards { feedback submittedAt panelist { email } }
+		}
+	}`, nil)
+
+	// Assertions
+	assert.Empty(t, errs)
+	assert.JSONEq(t, `{"interviews": [{
+		"id": "1", "stage": "onsite", "interviewDate": "2024-12-30T15:00:00Z",
+		"panel": [{"name": "Alice", "interview": {"id": "1"}}, {"name": "Bob", "interview": {"id": "1"}}],
+		"scorecards": [{"feedback": "Strong hire", "submittedAt": "2024-12-30T17:00:00Z", "panelist": {"email": "alice@example.com"}}]
+	}]}`, data)
+	mockService.AssertNotCalled(t, "GetInterviewsByIDs", mock.Anything, mock.Anything)
+}
+
+func TestQueryInterview_BatchesLookups(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	handler := newTestHandler(t, mockService)
+
+	// Mock behavior
+	mockService.On("GetInterviewsByIDs", mock.Anything, mock.MatchedBy(func(ids []int) bool {
+		return assert.ElementsMatch(t, []int{1, 2, 3}, ids)
+	})).Return([]*domain.Interview{{ID: 2, JobID: 202}, {ID: 1, JobID: 201}}, nil).Once()
+
+	// Execute
+	data, errs := execute(t, handler, `{
+		a: interview(id: "1") { jobId }
+		b: interview(id: "2") { jobId }
+		c: interview(id: "3") { jobId }
+	}`, nil)
+
+	// Assertions
+	assert.Empty(t, errs)
+	assert.JSONEq(t, `{"a": {"jobId": 201}, "b": {"jobId": 202}, "c": null}`, data)
+	mockService.AssertExpectations(t)
+}
+
+func TestMutationCancelInterview(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	handler := newTestHandler(t, mockService)
+
+	// Mock behavior
+	mockService.On("CancelInterview", mock.Anything, 7, "Position filled").
+		Return(&domain.Interview{ID: 7, Status: domain.StatusCancelled, CancellationReason: "Position filled"}, nil)
+	mockService.On("CancelInterview", mock.Anything, 8, "Position filled").Return(nil, repository.ErrNotScheduled)
+
+	// Execute
+	data, errs := execute(t, handler, `mutation($id: ID!) {
+		cancelInterview(id: $id, reason: "Position filled") { status cancellationReason }
+	}`, map[string]interface{}{"id": "7"})
+	_, notScheduledErrs := execute(t, handler, `mutation {
+		cancelInterview(id: "8", reason: "Position filled") { status }
+	}`, nil)
+
+	// Assertions
+	assert.Empty(t, errs)
+	assert.JSONEq(t, `{"cancelInterview": {"status": "cancelled", "cancellationReason": "Position filled"}}`, data)
+	require.Len(t, notScheduledErrs, 1)
+	assert.Equal(t, "FAILED_PRECONDITION", notScheduledErrs[0].Extensions["code"])
+	mockService.AssertExpectations(t)
+}
+
+func TestMutationUpdateInterview_OnlyChangesSetFields(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	handler := newTestHandler(t, mockService)
+
+	// Mock data
+	date := time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC)
+	moved := date.Add(48 * time.Hour)
+
+	// Mock behavior
+	mockService.On("GetInterviewByID", mock.Anything, 7).Return(&domain.Interview{ID: 7, InterviewDate: date, Stage: "onsite"}, nil)
+	mockService.On("UpdateInterview", mock.Anything, mock.MatchedBy(func(i *domain.Interview) bool {
+		return i.ID == 7 && i.InterviewDate.Equal(moved) && i.Stage == "onsite"
+	})).Return(nil)
+
+	// Execute
+	data, errs := execute(t, handler, `mutation {
+		updateInterview(id: "7", input: {interviewDate: "2025-01-01T15:00:00Z"}) { interviewDate stage }
+	}`, nil)
+
+	// Assertions
+	assert.Empty(t, errs)
+	assert.JSONEq(t, `{"updateInterview": {"interviewDate": "2025-01-01T15:00:00Z", "stage": "onsite"}}`, data)
+	mockService.AssertExpectations(t)
+}
+
+func TestServeHTTP_RejectsComplexQueries(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	cfg := DefaultConfig()
+	cfg.MaxComplexity = 100
+	handler, err := NewHandler(mockService, cfg)
+	require.NoError(t, err)
+
+	// Execute
+	body, _ := json.Marshal(request{Query: `{ interviews(first: 100) { id panel { name } } }`})
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	body = []byte(`{"query": "query($n: Int) { interviews(first: $n) { id panel { name } } }", "variables": {"n": 100}}`)
+	viaVariable := httptest.NewRecorder()
+	handler.ServeHTTP(viaVariable, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+
+	// Assertions
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "query complexity 701 exceeds the limit of 100")
+	assert.Equal(t, http.StatusBadRequest, viaVariable.Code)
+	assert.Contains(t, viaVariable.Body.String(), "query complexity 701 exceeds the limit of 100")
+	mockService.AssertNotCalled(t, "FindInterviews", mock.Anything, mock.Anything)
+}
+
+func TestComplexity(t *testing.T) {
+	// Setup
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSDL})
+	require.NoError(t, gqlErr)
+
+	// Execute and assert
+	for query, expected := range map[string]int{
+		`{ interview(id: "1") { id stage } }`:                               3,
+		`{ interviews { id } }`:                                             51,
+		`{ interviews(first: 2) { id panel { name email } } }`:              1 + 2*(1+1+5*2),
+		`{ interviews(first: 2) { ...f } } fragment f on Interview { id }`:  3,
+		`{ __schema { types { name } } interview(id: "1") { __typename } }`: 1,
+	} {
+		complexity, err := Complexity(schema, query, "", nil, 5)
+		assert.NoError(t, err, query)
+		assert.Equal(t, expected, complexity, query)
+	}
+
+	_, err := Complexity(schema, `{ unknownField }`, "", nil, 5)
+	assert.Error(t, err)
+}
+
+func TestComplexity_Variables(t *testing.T) {
+	// Setup
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSDL})
+	require.NoError(t, gqlErr)
+	query := `query($n: Int) { interviews(first: $n) { id } }`
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"n": 1000000}`), &decoded))
+
+	// Execute and assert
+	for name, tt := range map[string]struct {
+		query     string
+		variables map[string]interface{}
+		expected  int
+	}{
+		"decoded from JSON":     {query, decoded, 1 + 1000000},
+		"json.Number":           {query, map[string]interface{}{"n": json.Number("70")}, 1 + 70},
+		"missing":               {query, nil, 1 + 50}, // The default of first in the schema
+		"variable default":      {`query($n: Int = 20) { interviews(first: $n) { id } }`, nil, 1 + 20},
+		"overrides the default": {`query($n: Int = 20) { interviews(first: $n) { id } }`, map[string]interface{}{"n": float64(3)}, 1 + 3},
+	} {
+		complexity, err := Complexity(schema, tt.query, "", tt.variables, 5)
+		assert.NoError(t, err, name)
+		assert.Equal(t, tt.expected, complexity, name)
+	}
+}
+
+// responseError is an error of a GraphQL response
+type responseError struct {
+	Message    string                 `json:"message"`
+	Extensions map[string]interface{} `json:"extensions"`
+}
+
+// newTestHandler builds a handler with the default limits
+func newTestHandler(t *testing.T, interviewService service.InterviewService) *Handler {
+	t.Helper()
+	handler, err := NewHandler(interviewService, DefaultConfig())
+	require.NoError(t, err)
+	return handler
+}
+
+// execute posts a query to the handler and returns the data as JSON and the errors
+func execute(t *testing.T, handler *Handler, query string, variables map[string]interface{}) (string, []responseError) {
+	t.Helper()
+	body, err := json.Marshal(request{Query: query, Variables: variables})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []responseError `json:"errors"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return string(response.Data), response.Errors
+}
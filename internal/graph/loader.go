@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/service"
+)
+
+// loadersKey is the context key under which the loaders of a request are stored
+type loadersKey struct{}
+
+// loaders batches the lookups made while resolving a single request
+type loaders struct {
+	interviews *dataloader.Loader[int, *domain.Interview] // Interviews by ID, fetched with one call per batch
+}
+
+// withLoaders returns a context carrying fresh loaders for a request
+// @param ctx context.Context - The request context
+// @param svc service.InterviewService - The service the loaders fetch from
+// @return context.Context - The context to execute the request with
+func withLoaders(ctx context.Context, svc service.InterviewService) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		interviews: dataloader.NewBatchedLoader(batchInterviews(svc)),
+	})
+}
+
+// loadersFrom returns the loaders of the request
+// @param ctx context.Context - The context given to resolvers
+// @return *loaders - The loaders stored by withLoaders
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// batchInterviews fetches every interview requested during a batch window in a single call
+// @param svc service.InterviewService - The service the interviews are fetched from
+// @return dataloader.BatchFunc[int, *domain.Interview] - Returns one result per key, in key order,
+// with repository.ErrNotFound for unknown IDs
+func batchInterviews(svc service.InterviewService) dataloader.BatchFunc[int, *domain.Interview] {
+	return func(ctx context.Context, ids []int) []*dataloader.Result[*domain.Interview] {
+		results := make([]*dataloader.Result[*domain.Interview], len(ids))
+		interviews, err := svc.GetInterviewsByIDs(ctx, ids)
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result[*domain.Interview]{Error: err}
+			}
+			return results
+		}
+
+		byID := make(map[int]*domain.Interview, len(interviews))
+		for _, interview := range interviews {
+			byID[interview.ID] = interview
+		}
+		for i, id := range ids {
+			if interview, ok := byID[id]; ok {
+				results[i] = &dataloader.Result[*domain.Interview]{Data: interview}
+			} else {
+				results[i] = &dataloader.Result[*domain.Interview]{Error: repository.ErrNotFound}
+			}
+		}
+		return results
+	}
+}
+
+// loadInterview resolves an interview through the request's loader
+// @param ctx context.Context - The context given to resolvers
+// @param id int - The ID of the interview
+// @return *domain.Interview - The interview
+// @return error - repository.ErrNotFound, or an error if the batch could not be fetched
+func loadInterview(ctx context.Context, id int) (*domain.Interview, error) {
+	return loadersFrom(ctx).interviews.Load(ctx, id)()
+}
+
+// primeInterviews records interviews already fetched so later lookups by ID do not query again
+// @param ctx context.Context - The context given to resolvers
+// @param interviews []*domain.Interview - The interviews to record
+func primeInterviews(ctx context.Context, interviews ...*domain.Interview) {
+	l := loadersFrom(ctx).interviews
+	for _, interview := range interviews {
+		l.Clear(ctx, interview.ID).Prime(ctx, interview.ID, interview)
+	}
+}
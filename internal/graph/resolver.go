@@ -0,0 +1,364 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+)
+
+// resolver is the root resolver of the schema, serving both queries and mutations
+type resolver struct {
+	service service.InterviewService // Every read and write goes through the service layer
+}
+
+// Interviews resolves Query.interviews
+// @param ctx context.Context - The request context
+// @param args - The optional filters and the maximum number of interviews
+// @return []*interviewResolver - The matching interviews
+// @return error - An error if the interviews could not be retrieved
+func (r *resolver) Interviews(ctx context.Context, args struct {
+	Status      *string
+	CandidateID *int32
+	JobID       *int32
+	First       int32
+}) ([]*interviewResolver, error) {
+	if args.First <= 0 {
+		return []*interviewResolver{}, nil
+	}
+	filter := domain.InterviewFilter{Limit: int(args.First)}
+	if args.Status != nil {
+		filter.Status = domain.InterviewStatus(*args.Status)
+	}
+	if args.CandidateID != nil {
+		filter.CandidateID = int(*args.CandidateID)
+	}
+	if args.JobID != nil {
+		filter.JobID = int(*args.JobID)
+	}
+
+	interviews, err := r.service.FindInterviews(ctx, filter)
+	if err != nil {
+		return nil, resolverError(ctx, err, "failed to fetch interviews")
+	}
+	primeInterviews(ctx, interviews...)
+	return interviewResolvers(interviews), nil
+}
+
+// Interview resolves Query.interview
+// Lookups made in the same request are batched into a single call to the service.
+// @param ctx context.Context - The request context
+// @param args - The ID of the interview
+// @return *interviewResolver - The interview, nil if it does not exist
+// @return error - An error if the interview could not be retrieved
+func (r *resolver) Interview(ctx context.Context, args struct{ ID graphql.ID }) (*interviewResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	interview, err := loadInterview(ctx, id)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, resolverError(ctx, err, "failed to fetch interview")
+	}
+	return &interviewResolver{interview}, nil
+}
+
+// InterviewsNeedingAttention resolves Query.interviewsNeedingAttention
+// @param ctx context.Context - The request context
+// @return []*interviewResolver - The flagged interviews
+// @return error - An error if the interviews could not be retrieved
+func (r *resolver) InterviewsNeedingAttention(ctx context.Context) ([]*interviewResolver, error) {
+	interviews, err := r.service.GetInterviewsNeedingAttention(ctx)
+	if err != nil {
+		return nil, resolverError(ctx, err, "failed to fetch interviews")
+	}
+	primeInterviews(ctx, interviews...)
+	return interviewResolvers(interviews), nil
+}
+
+// createInterviewInput is the CreateInterviewInput input type
+type createInterviewInput struct {
+	CandidateID    int32
+	JobID          int32
+	InterviewDate  graphql.Time
+	Feedback       *string
+	Stage          *string
+	CandidateEmail *string
+	Panel          *[]struct {
+		Name  string
+		Email string
+	}
+}
+
+// CreateInterview resolves Mutation.createInterview
+// @param ctx context.Context - The request context
+// @param args - The interview to schedule
+// @return *interviewResolver - The created interview
+// @return error - An error if the interview could not be created
+func (r *resolver) CreateInterview(ctx context.Context, args struct{ Input createInterviewInput }) (*interviewResolver, error) {
+	in := args.Input
+	interview := &domain.Interview{
+		CandidateID:   int(in.CandidateID),
+		JobID:         int(in.JobID),
+		InterviewDate: in.InterviewDate.Time,
+	}
+	if in.Feedback != nil {
+		interview.Feedback = *in.Feedback
+	}
+	if in.Stage != nil {
+		interview.Stage = *in.Stage
+	}
+	if in.CandidateEmail != nil {
+		interview.CandidateEmail = *in.CandidateEmail
+	}
+	if in.Panel != nil {
+		for _, panelist := range *in.Panel {
+			interview.Panel = append(interview.Panel, domain.Panelist{Name: panelist.Name, Email: panelist.Email})
+		}
+	}
+
+	if err := r.service.AddInterview(ctx, interview); err != nil {
+		return nil, resolverError(ctx, err, "failed to create interview")
+	}
+	primeInterviews(ctx, interview)
+	return &interviewResolver{interview}, nil
+}
+
+// updateInterviewInput is the UpdateInterviewInput input type
+type updateInterviewInput struct {
+	InterviewDate  *graphql.Time
+	Feedback       *string
+	Stage          *string
+	CandidateEmail *string
+}
+
+// UpdateInterview resolves Mutation.updateInterview, changing only the fields that are set
+// @param ctx context.Context - The request context
+// @param args - The ID of the interview and its new values
+// @return *interviewResolver - The updated interview
+// @return error - An error if the interview could not be updated
+func (r *resolver) UpdateInterview(ctx context.Context, args struct {
+	ID    graphql.ID
+	Input updateInterviewInput
+}) (*interviewResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	interview, err := r.service.GetInterviewByID(ctx, id)
+	if err != nil {
+		return nil, resolverError(ctx, err, "failed to fetch interview")
+	}
+
+	in := args.Input
+	if in.InterviewDate != nil {
+		interview.InterviewDate = in.InterviewDate.Time
+	}
+	if in.Feedback != nil {
+		interview.Feedback = *in.Feedback
+	}
+	if in.Stage != nil {
+		interview.Stage = *in.Stage
+	}
+	if in.CandidateEmail != nil {
+		interview.CandidateEmail = *in.CandidateEmail
+	}
+
+	if err := r.service.UpdateInterview(ctx, interview); err != nil {
+		return nil, resolverError(ctx, err, "failed to update interview")
+	}
+	primeInterviews(ctx, interview)
+	return &interviewResolver{interview}, nil
+}
+
+// CancelInterview resolves Mutation.cancelInterview
+// @param ctx context.Context - The request context
+// @param args - The ID of the interview and the reason for the cancellation
+// @return *interviewResolver - The cancelled interview
+// @return error - An error if the interview could not be cancelled
+func (r *resolver) CancelInterview(ctx context.Context, args struct {
+	ID     graphql.ID
+	Reason string
+}) (*interviewResolver, error) {
+	id, err := parseID(args.ID)
+	if err != nil {
+		return nil, err
+	}
+	interview, err := r.service.CancelInterview(ctx, id, args.Reason)
+	if err != nil {
+		return nil, resolverError(ctx, err, "failed to cancel interview")
+	}
+	primeInterviews(ctx, interview)
+	return &interviewResolver{interview}, nil
+}
+
+// ResolveAttention resolves Mutation.resolveAttention
+// @param args - The IDs of the interviews and the outcome to record
+// @return int32 - The number of interviews updated
+// @return error - An error if the interviews could not be updated
+func (r *resolver) ResolveAttention(ctx context.Context, args struct {
+	IDs    []graphql.ID
+	Status string
+}) (int32, error) {
+	resolution := domain.AttentionResolution{Status: domain.InterviewStatus(args.Status)}
+	for _, rawID := range args.IDs {
+		id, err := parseID(rawID)
+		if err != nil {
+			return 0, err
+		}
+		resolution.InterviewIDs = append(resolution.InterviewIDs, id)
+	}
+
+	updated, err := r.service.ResolveAttention(ctx, resolution)
+	if err != nil {
+		return 0, resolverError(ctx, err, "failed to resolve interviews")
+	}
+	return int32(updated), nil
+}
+
+// interviewResolver resolves the Interview type
+type interviewResolver struct {
+	i *domain.Interview // The interview being resolved
+}
+
+// interviewResolvers wraps interviews in resolvers
+// @param interviews []*domain.Interview - The interviews
+// @return []*interviewResolver - One resolver per interview
+func interviewResolvers(interviews []*domain.Interview) []*interviewResolver {
+	resolvers := make([]*interviewResolver, 0, len(interviews))
+	for _, interview := range interviews {
+		resolvers = append(resolvers, &interviewResolver{interview})
+	}
+	return resolvers
+}
+
+func (r *interviewResolver) ID() graphql.ID     { return formatID(r.i.ID) }
+func (r *interviewResolver) CandidateID() int32 { return int32(r.i.CandidateID) }
+func (r *interviewResolver) JobID() int32       { return int32(r.i.JobID) }
+func (r *interviewResolver) InterviewDate() graphql.Time {
+	return graphql.Time{Time: r.i.InterviewDate}
+}
+func (r *interviewResolver) Feedback() string           { return r.i.Feedback }
+func (r *interviewResolver) Stage() string              { return r.i.Stage }
+func (r *interviewResolver) CandidateEmail() string     { return r.i.CandidateEmail }
+func (r *interviewResolver) Status() string             { return string(r.i.Status) }
+func (r *interviewResolver) CancellationReason() string { return r.i.CancellationReason }
+
+// Panel resolves Interview.panel from the panel loaded with the interview
+// @return []*panelistResolver - The interviewers taking part
+func (r *interviewResolver) Panel() []*panelistResolver {
+	panel := make([]*panelistResolver, 0, len(r.i.Panel))
+	for idx := range r.i.Panel {
+		panel = append(panel, &panelistResolver{&r.i.Panel[idx]})
+	}
+	return panel
+}
+
+// Scorecards resolves Interview.scorecards from the feedback submitted by the panel
+// @return []*scorecardResolver - One scorecard per interviewer who submitted feedback
+func (r *interviewResolver) Scorecards() []*scorecardResolver {
+	scorecards := []*scorecardResolver{}
+	for idx := range r.i.Panel {
+		if r.i.Panel[idx].FeedbackSubmittedAt != nil {
+			scorecards = append(scorecards, &scorecardResolver{&r.i.Panel[idx]})
+		}
+	}
+	return scorecards
+}
+
+// panelistResolver resolves the Panelist type
+type panelistResolver struct {
+	p *domain.Panelist // The panelist being resolved
+}
+
+func (r *panelistResolver) ID() graphql.ID { return formatID(r.p.ID) }
+func (r *panelistResolver) Name() string   { return r.p.Name }
+func (r *panelistResolver) Email() string  { return r.p.Email }
+
+// Interview resolves Panelist.interview through the request's loader
+// @param ctx context.Context - The request context
+// @return *interviewResolver - The interview the panelist takes part in
+// @return error - An error if the interview could not be retrieved
+func (r *panelistResolver) Interview(ctx context.Context) (*interviewResolver, error) {
+	interview, err := loadInterview(ctx, r.p.InterviewID)
+	if err != nil {
+		return nil, resolverError(ctx, err, "failed to fetch interview")
+	}
+	return &interviewResolver{interview}, nil
+}
+
+// scorecardResolver resolves the Scorecard type
+type scorecardResolver struct {
+	p *domain.Panelist // The panelist who submitted the feedback
+}
+
+func (r *scorecardResolver) Panelist() *panelistResolver { return &panelistResolver{r.p} }
+func (r *scorecardResolver) Feedback() string            { return r.p.Feedback }
+func (r *scorecardResolver) SubmittedAt() graphql.Time {
+	return graphql.Time{Time: *r.p.FeedbackSubmittedAt}
+}
+
+// Error is a resolver error carrying a machine-readable code in its extensions
+type Error struct {
+	Code    string // One of NOT_FOUND, BAD_USER_INPUT, FAILED_PRECONDITION, UNAVAILABLE or INTERNAL
+	Message string // Human-readable description
+}
+
+// Error returns the message of the error
+// @return string - The error message
+func (e *Error) Error() string { return e.Message }
+
+// Extensions exposes the code of the error in the GraphQL response
+// @return map[string]interface{} - The error extensions
+func (e *Error) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.Code}
+}
+
+// resolverError maps service and repository errors to GraphQL errors
+// Unexpected errors are logged and reported to the client as message.
+// @param ctx context.Context - The context of the request, carrying its logger
+// @param err error - The error returned by the service
+// @param message string - The message reported for unexpected errors
+// @return error - The error returned to the client
+func resolverError(ctx context.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return &Error{Code: "NOT_FOUND", Message: "interview not found"}
+	case errors.Is(err, repository.ErrNotScheduled):
+		return &Error{Code: "FAILED_PRECONDITION", Message: err.Error()}
+	case errors.Is(err, service.ErrInvalidReference), errors.Is(err, service.ErrInvalidResolution):
+		return &Error{Code: "BAD_USER_INPUT", Message: err.Error()}
+	case errors.Is(err, client.ErrUnavailable):
+		return &Error{Code: "UNAVAILABLE", Message: err.Error()}
+	}
+	logger.FromContext(ctx).Error("GraphQL request failed", "message", message, "error", err)
+	return &Error{Code: "INTERNAL", Message: message}
+}
+
+// parseID converts a GraphQL ID to a numeric identifier
+// @param id graphql.ID - The ID received from the client
+// @return int - The numeric identifier
+// @return error - A BAD_USER_INPUT error if the ID is not a positive integer
+func parseID(id graphql.ID) (int, error) {
+	n, err := strconv.Atoi(string(id))
+	if err != nil || n <= 0 {
+		return 0, &Error{Code: "BAD_USER_INPUT", Message: "invalid id " + strconv.Quote(string(id))}
+	}
+	return n, nil
+}
+
+// formatID converts a numeric identifier to a GraphQL ID
+// @param id int - The numeric identifier
+// @return graphql.ID - The ID sent to the client
+func formatID(id int) graphql.ID {
+	return graphql.ID(strconv.Itoa(id))
+}
@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Statuses of a check and of a report
+const (
+	StatusOK       = "ok"       // Every check passed
+	StatusDegraded = "degraded" // Only optional checks failed; the service keeps taking traffic
+	StatusFail     = "fail"     // A required check failed, or the service is shutting down
+)
+
+// errShuttingDown is reported once MarkShuttingDown has been called
+var errShuttingDown = errors.New("service is shutting down")
+
+// Check reports whether a dependency is usable
+// It returns an error describing the problem when it is not, and must give up once ctx is done.
+type Check func(ctx context.Context) error
+
+// Config holds the settings of a Registry
+type Config struct {
+	Timeout  time.Duration // Maximum duration of a single check, after which it fails
+	CacheTTL time.Duration // How long results are reused, so frequent probes do not hammer dependencies
+}
+
+// DefaultConfig returns the settings used when none are configured
+// @return Config - The default settings
+func DefaultConfig() Config {
+	return Config{Timeout: 2 * time.Second, CacheTTL: 5 * time.Second}
+}
+
+// Result is the outcome of one check
+type Result struct {
+	Name      string    `json:"name"`            // Name the check was registered with, e.g. database
+	Status    string    `json:"status"`          // StatusOK or StatusFail
+	Required  bool      `json:"required"`        // Whether a failure makes the service unready
+	LatencyMs float64   `json:"latency_ms"`      // How long the check took, in milliseconds
+	Error     string    `json:"error,omitempty"` // Why the check failed
+	CheckedAt time.Time `json:"checked_at"`      // When the check ran, older than the request when cached
+}
+
+// Report is the outcome of every check
+type Report struct {
+	Status string   `json:"status"` // StatusOK, StatusDegraded or StatusFail
+	Checks []Result `json:"checks"` // Result of each check in registration order
+}
+
+// Ready reports whether the service should receive traffic
+// @return bool - False when a required check failed
+func (r Report) Ready() bool {
+	return r.Status != StatusFail
+}
+
+// registration is a check added to a Registry
+type registration struct {
+	name     string // Name reported in results
+	check    Check  // The check
+	required bool   // Whether a failure makes the service unready
+}
+
+// Registry runs the readiness checks of the service
+// Results are cached for CacheTTL and concurrent callers share a single run, so readiness probes from
+// several load balancers cost one round of checks.
+type Registry struct {
+	cfg          Config           // Registry settings
+	mu           sync.Mutex       // Guards every field below; held while checks run
+	checks       []registration   // Registered checks in registration order
+	cached       *Report          // Last report, nil before the first run
+	cachedAt     time.Time        // When cached was computed
+	shuttingDown bool             // Set by MarkShuttingDown
+	now          func() time.Time // Clock, replaced in tests
+}
+
+// NewRegistry creates a new Registry instance
+// @param cfg Config - The registry settings
+// @return *Registry - A registry without checks
+func NewRegistry(cfg Config) *Registry {
+	return &Registry{cfg: cfg, now: time.Now}
+}
+
+// Register adds a check that makes the service unready when it fails, such as the database
+// @param name string - The name reported in results
+// @param check Check - The check
+func (r *Registry) Register(name string, check Check) {
+	r.add(registration{name: name, check: check, required: true})
+}
+
+// RegisterOptional adds a check that is reported without making the service unready, such as a downstream service
+// Failing optional checks turn the report degraded; taking every replica out of rotation would not bring the
+// dependency back.
+// @param name string - The name reported in results
+// @param check Check - The check
+func (r *Registry) RegisterOptional(name string, check Check) {
+	r.add(registration{name: name, check: check})
+}
+
+// add registers a check and drops the cached report so it is included right away
+func (r *Registry) add(reg registration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks = append(r.checks, reg)
+	r.cached = nil
+}
+
+// MarkShuttingDown makes every later report fail, so load balancers stop routing requests during shutdown
+func (r *Registry) MarkShuttingDown() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.shuttingDown = true
+}
+
+// Check runs the registered checks, or returns the cached report when it is recent enough
+// Checks run concurrently, each bounded by the configured timeout.
+// @param ctx context.Context - Cancels the checks
+// @return Report - The outcome of every check
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if r.shuttingDown {
+		return Report{Status: StatusFail, Checks: []Result{
+			{Name: "shutdown", Status: StatusFail, Required: true, Error: errShuttingDown.Error(), CheckedAt: now},
+		}}
+	}
+	if r.cached != nil && now.Sub(r.cachedAt) < r.cfg.CacheTTL {
+		return *r.cached
+	}
+
+	results := make([]Result, len(r.checks))
+	var wg sync.WaitGroup
+	for i, reg := range r.checks {
+		wg.Add(1)
+		go func(i int, reg registration) {
+			defer wg.Done()
+			results[i] = r.run(ctx, reg)
+		}(i, reg)
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusOK, Checks: results}
+	for _, result := range results {
+		switch {
+		case result.Status == StatusOK:
+		case result.Required:
+			report.Status = StatusFail
+		case report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+	r.cached, r.cachedAt = &report, now
+	return report
+}
+
+// run runs a single check within the configured timeout
+func (r *Registry) run(ctx context.Context, reg registration) Result {
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	started := r.now()
+	err := reg.check(ctx)
+	if err == nil && ctx.Err() != nil {
+		err = ctx.Err() // The check ignored its context and overran
+	}
+	result := Result{
+		Name:      reg.name,
+		Status:    StatusOK,
+		Required:  reg.required,
+		LatencyMs: float64(r.now().Sub(started).Microseconds()) / 1000,
+		CheckedAt: started,
+	}
+	if err != nil {
+		result.Status, result.Error = StatusFail, err.Error()
+	}
+	return result
+}
@@ -0,0 +1,119 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// passing and failing are checks with a fixed outcome
+var (
+	passing Check = func(ctx context.Context) error { return nil }
+	failing Check = func(ctx context.Context) error { return errors.New("connection refused") }
+)
+
+func TestRegistry_Check(t *testing.T) {
+	tests := []struct {
+		name     string
+		required Check
+		optional Check
+		status   string
+	}{
+		{name: "all passing", required: passing, optional: passing, status: StatusOK},
+		{name: "optional failing", required: passing, optional: failing, status: StatusDegraded},
+		{name: "required failing", required: failing, optional: passing, status: StatusFail},
+		{name: "all failing", required: failing, optional: failing, status: StatusFail},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			registry := NewRegistry(DefaultConfig())
+			registry.Register("database", tt.required)
+			registry.RegisterOptional("jobs-service", tt.optional)
+
+			// Execute
+			report := registry.Check(context.Background())
+
+			// Assertions
+			assert.Equal(t, tt.status, report.Status)
+			assert.Equal(t, tt.status != StatusFail, report.Ready())
+			require.Len(t, report.Checks, 2)
+			assert.Equal(t, "database", report.Checks[0].Name)
+			assert.True(t, report.Checks[0].Required)
+			assert.Equal(t, "jobs-service", report.Checks[1].Name)
+			assert.False(t, report.Checks[1].Required)
+		})
+	}
+}
+
+func TestRegistry_Check_Timeout(t *testing.T) {
+	// Setup
+	registry := NewRegistry(Config{Timeout: 10 * time.Millisecond})
+	registry.Register("database", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	registry.Register("stuck", func(ctx context.Context) error {
+		time.Sleep(20 * time.Millisecond) // Ignores its context
+		return nil
+	})
+
+	// Execute
+	report := registry.Check(context.Background())
+
+	// Assertions
+	assert.Equal(t, StatusFail, report.Status)
+	for _, result := range report.Checks {
+		assert.Equal(t, StatusFail, result.Status, result.Name)
+		assert.Equal(t, context.DeadlineExceeded.Error(), result.Error, result.Name)
+		assert.GreaterOrEqual(t, result.LatencyMs, 10.0, result.Name)
+	}
+}
+
+func TestRegistry_Check_Cache(t *testing.T) {
+	// Setup
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	registry := NewRegistry(Config{Timeout: time.Second, CacheTTL: 5 * time.Second})
+	registry.now = func() time.Time { return now }
+	calls := 0
+	registry.Register("database", func(ctx context.Context) error {
+		calls++
+		return nil
+	})
+	ctx := context.Background()
+
+	// Execute
+	first := registry.Check(ctx)
+	now = now.Add(4 * time.Second)
+	cached := registry.Check(ctx)
+	now = now.Add(time.Second)
+	refreshed := registry.Check(ctx)
+
+	// Assertions
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, first, cached)
+	assert.Equal(t, now, refreshed.Checks[0].CheckedAt)
+}
+
+func TestRegistry_MarkShuttingDown(t *testing.T) {
+	// Setup
+	registry := NewRegistry(DefaultConfig())
+	registry.Register("database", passing)
+	ctx := context.Background()
+	require.True(t, registry.Check(ctx).Ready()) // Cached, must not hide the shutdown
+
+	// Execute
+	registry.MarkShuttingDown()
+	report := registry.Check(ctx)
+
+	// Assertions
+	assert.False(t, report.Ready())
+	require.Len(t, report.Checks, 1)
+	assert.Equal(t, "shutdown", report.Checks[0].Name)
+	assert.Equal(t, "service is shutting down", report.Checks[0].Error)
+}
@@ -0,0 +1,177 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/poolcamacho/interviews-service/pkg/db"
+)
+
+// Lock defines methods for electing a single leader among replicas
+// Background jobs that must run on one instance only call Acquire before every run and
+// skip the run when it reports false.
+type Lock interface {
+	// Acquire takes the lock if it is free, or confirms it is still held by this instance
+	// @return bool - True if this instance is the leader
+	// @return error - An error if the lock could not be checked
+	Acquire() (bool, error)
+
+	// Release gives up the lock so another instance can take over
+	// @return error - An error if the lock could not be released
+	Release() error
+}
+
+// MySQLLock is a Lock backed by a MySQL named lock (GET_LOCK)
+// MySQL ties named locks to a connection, so the lock keeps a dedicated connection open while
+// it is held. If that connection drops, MySQL frees the lock and another replica can take it.
+// A connection that may still hold the lock is closed for good rather than returned to the pool,
+// where its session would keep the lock with no one to release it.
+type MySQLLock struct {
+	db   *sql.DB    // Pool the dedicated connection is taken from
+	name string     // Name of the MySQL lock
+	mu   sync.Mutex // Guards conn
+	conn *sql.Conn  // Connection holding the lock, nil when not held
+}
+
+// NewMySQLLock creates a new MySQLLock instance
+// @param db *sql.DB - The database connection pool
+// @param name string - The name of the lock, shared by every replica competing for it
+// @return *MySQLLock - A lock that is not yet held
+func NewMySQLLock(db *sql.DB, name string) *MySQLLock {
+	return &MySQLLock{db: db, name: name}
+}
+
+// Acquire takes the lock without waiting, or confirms the held lock is still ours
+// @return bool - True if this instance holds the lock
+// @return error - An error if the database could not be reached
+func (l *MySQLLock) Acquire() (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ctx := context.Background()
+
+	if l.conn != nil {
+		var held sql.NullBool
+		err := l.conn.QueryRowContext(ctx, `SELECT IS_USED_LOCK(?) = CONNECTION_ID()`, l.name).Scan(&held)
+		if err == nil && held.Valid && held.Bool {
+			return true, nil
+		}
+		// The connection dropped or the lock was lost; try to take it again on a fresh connection
+		db.EndSession(l.conn)
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, `SELECT GET_LOCK(?, 0)`, l.name).Scan(&acquired); err != nil {
+		db.EndSession(conn) // The lock may have been taken before the error
+		return false, err
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		conn.Close() // Held by another instance
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Release frees the lock and its dedicated connection
+// @return error - An error if the lock could not be released; the session is then ended, which frees it
+func (l *MySQLLock) Release() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(context.Background(), `DO RELEASE_LOCK(?)`, l.name)
+	if err != nil {
+		db.EndSession(l.conn) // Ending the session frees the lock the statement could not release
+	} else {
+		l.conn.Close()
+	}
+	l.conn = nil
+	return err
+}
+
+// PostgresLock is a Lock backed by a PostgreSQL session advisory lock (pg_try_advisory_lock)
+// Like MySQL named locks, advisory locks belong to a session, so the lock keeps a dedicated
+// connection open while it is held and is freed by PostgreSQL if that connection drops. As with
+// MySQLLock, a connection that may still hold the lock is never returned to the pool.
+type PostgresLock struct {
+	db   *sql.DB    // Pool the dedicated connection is taken from
+	name string     // Name of the lock, hashed into the advisory lock key
+	mu   sync.Mutex // Guards conn
+	conn *sql.Conn  // Connection holding the lock, nil when not held
+}
+
+// NewPostgresLock creates a new PostgresLock instance
+// @param db *sql.DB - The database connection pool
+// @param name string - The name of the lock, shared by every replica competing for it
+// @return *PostgresLock - A lock that is not yet held
+func NewPostgresLock(db *sql.DB, name string) *PostgresLock {
+	return &PostgresLock{db: db, name: name}
+}
+
+// Acquire takes the lock without waiting, or confirms the held lock is still ours
+// @return bool - True if this instance holds the lock
+// @return error - An error if the database could not be reached
+func (l *PostgresLock) Acquire() (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ctx := context.Background()
+
+	if l.conn != nil {
+		var held bool
+		// A bigint advisory key is shown split into its high (classid) and low (objid) 32 bits
+		err := l.conn.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_locks, (SELECT hashtext($1)::bigint AS key) k
+			WHERE locktype = 'advisory' AND pid = pg_backend_pid() AND granted AND objsubid = 1
+			AND classid::bigint = (k.key >> 32) & 4294967295 AND objid::bigint = k.key & 4294967295)`, l.name).Scan(&held)
+		if err == nil && held {
+			return true, nil
+		}
+		// The connection dropped or the lock was lost; try to take it again on a fresh connection
+		db.EndSession(l.conn)
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, l.name).Scan(&acquired); err != nil {
+		db.EndSession(conn) // The lock may have been taken before the error
+		return false, err
+	}
+	if !acquired {
+		conn.Close() // Held by another instance
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+// Release frees the lock and its dedicated connection
+// @return error - An error if the lock could not be released; the session is then ended, which frees it
+func (l *PostgresLock) Release() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+
+	_, err := l.conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(hashtext($1))`, l.name)
+	if err != nil {
+		db.EndSession(l.conn) // Ending the session frees the lock the statement could not release
+	} else {
+		l.conn.Close()
+	}
+	l.conn = nil
+	return err
+}
@@ -0,0 +1,20 @@
+package leader
+
+import "github.com/stretchr/testify/mock"
+
+// MockLock is a mock implementation of Lock for testing
+type MockLock struct {
+	mock.Mock
+}
+
+// Acquire mocks the Acquire method
+func (m *MockLock) Acquire() (bool, error) {
+	args := m.Called()
+	return args.Bool(0), args.Error(1)
+}
+
+// Release mocks the Release method
+func (m *MockLock) Release() error {
+	args := m.Called()
+	return args.Error(0)
+}
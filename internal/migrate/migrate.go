@@ -0,0 +1,430 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/pkg/db"
+)
+
+//go:embed migrations/*/*.sql
+var migrationFS embed.FS
+
+// lockName is the MySQL named lock, or the key of the PostgreSQL advisory lock, serializing migrations across replicas
+const lockName = "interviews-service.migrations"
+
+// lockTimeout is how long a replica waits for another one to finish migrating, in seconds
+const lockTimeout = 60
+
+// fileName matches migration files such as 0001_create_interviews.up.sql
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// statements holds the bookkeeping statements of the migrator, which differ between database engines
+type statements struct {
+	createTable string // Creates the schema_migrations table when it does not exist
+	record      string // Records an applied version, its name and the time it was applied
+	forget      string // Removes a reverted version
+}
+
+// dialectStatements lists the bookkeeping statements of every supported database engine
+var dialectStatements = map[db.Dialect]statements{
+	db.MySQL: {
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INT          NOT NULL PRIMARY KEY,
+		name       VARCHAR(255) NOT NULL,
+		applied_at DATETIME     NOT NULL
+	)`,
+		record: `INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+		forget: `DELETE FROM schema_migrations WHERE version = ?`,
+	},
+	db.Postgres: {
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INT          NOT NULL PRIMARY KEY,
+		name       VARCHAR(255) NOT NULL,
+		applied_at TIMESTAMPTZ  NOT NULL
+	)`,
+		record: `INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)`,
+		forget: `DELETE FROM schema_migrations WHERE version = $1`,
+	},
+	db.SQLite: {
+		createTable: `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    INTEGER      NOT NULL PRIMARY KEY,
+		name       VARCHAR(255) NOT NULL,
+		applied_at DATETIME     NOT NULL
+	)`,
+		record: `INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+		forget: `DELETE FROM schema_migrations WHERE version = ?`,
+	},
+}
+
+// Migration is a versioned schema change
+// Each migration is a pair of files, NNNN_name.up.sql and NNNN_name.down.sql, in the directory of its
+// database engine, e.g. migrations/postgres.
+type Migration struct {
+	Version int    // Sequence number from the file name, e.g. 1 for 0001_create_interviews
+	Name    string // Description from the file name, e.g. create_interviews
+	Up      string // Statements applying the change
+	Down    string // Statements reverting the change
+}
+
+// Status is a migration and whether it has been applied
+type Status struct {
+	Migration
+	AppliedAt *time.Time // When the migration was applied, nil while pending
+}
+
+// Load reads the embedded migrations of a database engine
+// Each engine has its own migrations, so their versions are numbered independently.
+// @param dialect db.Dialect - The database engine the migrations are written for
+// @return []Migration - The migrations, oldest first
+// @return error - An error if a file is misnamed or lacks its up or down counterpart
+func Load(dialect db.Dialect) ([]Migration, error) {
+	return load(migrationFS, path.Join("migrations", string(dialect)))
+}
+
+// load reads the migrations stored in a directory
+// @param fsys fs.FS - The file system holding the migrations
+// @param dir string - The directory of the migration files
+// @return []Migration - The migrations, oldest first
+// @return error - An error if a file is misnamed or lacks its up or down counterpart
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("migration %s: name must look like 0001_description.up.sql", entry.Name())
+		}
+		version, _ := strconv.Atoi(match[1])
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration %d: named both %s and %s", version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s: both the up and the down file are required", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies and reverts migrations, recording applied versions in the schema_migrations table
+// Replicas starting together take turns through a MySQL named lock or a PostgreSQL advisory lock, and
+// processes sharing a SQLite file through its write lock, so a migration never runs twice.
+type Migrator struct {
+	db         *sql.DB     // Database connection instance
+	dialect    db.Dialect  // Database engine behind db
+	migrations []Migration // Known migrations, oldest first
+}
+
+// NewMigrator creates a new Migrator instance
+// @param conn *sql.DB - The database connection the migrations run on
+// @param dialect db.Dialect - The database engine behind conn
+// @param migrations []Migration - The migrations to manage, usually those returned by Load for the same dialect
+// @return *Migrator - The migrator
+func NewMigrator(conn *sql.DB, dialect db.Dialect, migrations []Migration) *Migrator {
+	return &Migrator{db: conn, dialect: dialect, migrations: migrations}
+}
+
+// Up applies every pending migration, oldest first
+// Statements run one at a time outside of a transaction since MySQL commits DDL statements implicitly, so a
+// migration failing halfway is not rolled back; it is left unrecorded and the error names it so it can be
+// fixed by hand. On SQLite they run inside the transaction holding the lock, which commits the statements
+// that succeeded the same way.
+// @param ctx context.Context - Cancels the migration and carries its deadline
+// @return []Migration - The migrations applied by this call
+// @return error - An error if a migration fails; the migrations before it stay applied
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			if _, ok := done[migration.Version]; ok {
+				continue
+			}
+			if err := run(ctx, conn, migration, migration.Up); err != nil {
+				return err
+			}
+			if _, err := conn.ExecContext(ctx, dialectStatements[m.dialect].record,
+				migration.Version, migration.Name, time.Now().UTC()); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the last n applied migrations, newest first
+// @param ctx context.Context - Cancels the migration and carries its deadline
+// @param n int - The number of migrations to revert
+// @return []Migration - The migrations reverted by this call
+// @return error - An error if a migration fails or an applied version is unknown to this binary
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		versions := make([]int, 0, len(done))
+		for version := range done {
+			versions = append(versions, version)
+		}
+		sort.Sort(sort.Reverse(sort.IntSlice(versions)))
+
+		for _, version := range versions {
+			if len(reverted) == n {
+				break
+			}
+			migration, ok := m.find(version)
+			if !ok {
+				return fmt.Errorf("migration %d is applied but unknown to this version of the service", version)
+			}
+			if err := run(ctx, conn, migration, migration.Down); err != nil {
+				return err
+			}
+			if _, err := conn.ExecContext(ctx, dialectStatements[m.dialect].forget, version); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status reports which migrations have been applied
+// @param ctx context.Context - Cancels the query and carries its deadline
+// @return []Status - Every known migration, oldest first
+// @return error - An error if the schema_migrations table could not be read
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		for _, migration := range m.migrations {
+			status := Status{Migration: migration}
+			if appliedAt, ok := done[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Pending lists the migrations that have not been applied yet
+// Unlike Status it neither takes the migrations lock nor creates the schema_migrations table, so it is cheap
+// enough for readiness checks and never waits behind a running migration.
+// @param ctx context.Context - Cancels the query and carries its deadline
+// @return []Migration - The pending migrations, oldest first
+// @return error - An error if the schema_migrations table could not be read, e.g. before the first migration
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	done, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := done[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// find looks up a migration by version
+// @param version int - The version of the migration
+// @return Migration - The migration
+// @return bool - False if no migration has that version
+func (m *Migrator) find(version int) (Migration, bool) {
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return migration, true
+		}
+	}
+	return Migration{}, false
+}
+
+// withLock runs fn on a dedicated connection holding the migrations lock
+// Creates the schema_migrations table when it does not exist yet.
+// @param ctx context.Context - Cancels the wait for the lock and the work
+// @param fn func(conn *sql.Conn) error - The work to run while holding the lock
+// @return error - An error if the lock could not be taken in time, or the error returned by fn
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	dialect, ok := dialectStatements[m.dialect]
+	if !ok {
+		return fmt.Errorf("migrations are not supported on %q databases", m.dialect)
+	}
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	release, err := m.lock(ctx, conn)
+	if err != nil {
+		db.EndSession(conn) // A cancelled wait may still have taken the lock
+		return err
+	}
+	defer release() // Frees the lock, then hands the connection back to the pool
+
+	if _, err := conn.ExecContext(ctx, dialect.createTable); err != nil {
+		return err
+	}
+	return fn(conn)
+}
+
+// lock takes the migrations lock on a connection, waiting up to lockTimeout for another instance to release it
+// @param ctx context.Context - Cancels the wait for the lock
+// @param conn *sql.Conn - The connection the lock is held by
+// @return func() - Releases the lock and the connection
+// @return error - An error if the lock could not be taken in time
+func (m *Migrator) lock(ctx context.Context, conn *sql.Conn) (func(), error) {
+	switch m.dialect {
+	case db.SQLite:
+		// SQLite has no named locks; the write lock of a transaction spanning the work serializes processes
+		// sharing the file, waiting for each other up to the busy timeout of the connection
+		if _, err := conn.ExecContext(ctx, `BEGIN IMMEDIATE`); err != nil {
+			return nil, err
+		}
+		return unlocker(conn, `COMMIT`), nil
+	case db.Postgres:
+		// pg_advisory_lock waits without a limit, so the wait is bounded by cancelling the query
+		lockCtx, cancel := context.WithTimeout(ctx, lockTimeout*time.Second)
+		defer cancel()
+		if _, err := conn.ExecContext(lockCtx, `SELECT pg_advisory_lock(hashtext($1))`, lockName); err != nil {
+			if lockCtx.Err() != nil && ctx.Err() == nil {
+				return nil, errors.New("timed out waiting for another instance to finish migrating")
+			}
+			return nil, err
+		}
+		return unlocker(conn, `SELECT pg_advisory_unlock(hashtext($1))`, lockName), nil
+	}
+
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, `SELECT GET_LOCK(?, ?)`, lockName, lockTimeout).Scan(&acquired); err != nil {
+		return nil, err
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		return nil, errors.New("timed out waiting for another instance to finish migrating")
+	}
+	return unlocker(conn, `DO RELEASE_LOCK(?)`, lockName), nil
+}
+
+// unlocker returns a func that runs the statement releasing a lock and then closes the connection
+// Locks belong to the database session and a closed sql.Conn keeps its session in the pool, so the session is
+// ended instead when the statement fails and the lock may still be held.
+// @param conn *sql.Conn - The connection the lock is held by
+// @param query string - The statement releasing the lock
+// @param args ...interface{} - The arguments of the statement
+// @return func() - Releases the lock and the connection
+func unlocker(conn *sql.Conn, query string, args ...interface{}) func() {
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), query, args...); err != nil {
+			db.EndSession(conn)
+			return
+		}
+		conn.Close()
+	}
+}
+
+// appliedVersions reads the schema_migrations table
+// @param ctx context.Context - Cancels the query and carries its deadline
+// @param conn *sql.Conn - The connection holding the migrations lock
+// @return map[int]time.Time - When each applied version was applied
+// @return error - An error if the query fails
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+		applied[version] = appliedAt
+	}
+	return applied, rows.Err()
+}
+
+// run executes the statements of one direction of a migration, one at a time
+// @param ctx context.Context - Cancels the statements and carries their deadline
+// @param conn *sql.Conn - The connection holding the migrations lock
+// @param migration Migration - The migration, used to name it in errors
+// @param script string - The migration's up or down statements
+// @return error - An error naming the migration if a statement fails
+func run(ctx context.Context, conn *sql.Conn, migration Migration, script string) error {
+	for _, statement := range splitStatements(script) {
+		if _, err := conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return nil
+}
+
+// splitStatements splits a script into statements
+// The driver runs one statement per call, so scripts are split on semicolons ending a line; migrations
+// must not use semicolons at the end of a line inside string literals or comments.
+// @param script string - The SQL script
+// @return []string - The statements, without their terminating semicolon
+func splitStatements(script string) []string {
+	var statements []string
+	for _, part := range strings.Split(script, ";\n") {
+		statement := strings.TrimSuffix(strings.TrimSpace(part), ";")
+		if statement != "" {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
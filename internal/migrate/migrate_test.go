@@ -0,0 +1,118 @@
+package migrate
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/poolcamacho/interviews-service/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_Embedded(t *testing.T) {
+	for _, dialect := range []db.Dialect{db.MySQL, db.Postgres, db.SQLite} {
+		t.Run(string(dialect), func(t *testing.T) {
+			// Execute
+			migrations, err := Load(dialect)
+
+			// Assertions
+			require.NoError(t, err)
+			require.NotEmpty(t, migrations)
+			for i, migration := range migrations {
+				assert.Equal(t, i+1, migration.Version, "versions must be contiguous")
+				assert.NotEmpty(t, splitStatements(migration.Up))
+				assert.NotEmpty(t, splitStatements(migration.Down))
+			}
+			assert.Equal(t, "create_interviews", migrations[0].Name)
+			assert.Equal(t, "create_outbox", migrations[1].Name)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name  string
+		files fstest.MapFS
+		err   string
+	}{
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"m/0010_add_index.up.sql":      {Data: []byte("CREATE INDEX i ON t (c);")},
+				"m/0010_add_index.down.sql":    {Data: []byte("DROP INDEX i ON t;")},
+				"m/0002_create_table.up.sql":   {Data: []byte("CREATE TABLE t (c INT);")},
+				"m/0002_create_table.down.sql": {Data: []byte("DROP TABLE t;")},
+			},
+		},
+		{
+			name:  "missing down",
+			files: fstest.MapFS{"m/0001_create_table.up.sql": {Data: []byte("CREATE TABLE t (c INT);")}},
+			err:   "migration 0001_create_table: both the up and the down file are required",
+		},
+		{
+			name:  "misnamed",
+			files: fstest.MapFS{"m/create_table.sql": {Data: []byte("CREATE TABLE t (c INT);")}},
+			err:   "migration create_table.sql: name must look like 0001_description.up.sql",
+		},
+		{
+			name: "conflicting names",
+			files: fstest.MapFS{
+				"m/0001_create_table.up.sql":   {Data: []byte("CREATE TABLE t (c INT);")},
+				"m/0001_create_other.down.sql": {Data: []byte("DROP TABLE t;")},
+			},
+			err: "migration 1: named both create_other and create_table",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			migrations, err := load(tt.files, "m")
+
+			// Assertions
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, migrations, 2)
+			assert.Equal(t, Migration{Version: 2, Name: "create_table", Up: "CREATE TABLE t (c INT);", Down: "DROP TABLE t;"}, migrations[0])
+			assert.Equal(t, 10, migrations[1].Version)
+		})
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	// Setup
+	script := "CREATE TABLE a (\n    id INT\n);\n\nCREATE TABLE b (id INT);\nDROP TABLE c;"
+
+	// Execute
+	statements := splitStatements(script)
+
+	// Assertions
+	assert.Equal(t, []string{"CREATE TABLE a (\n    id INT\n)", "CREATE TABLE b (id INT)", "DROP TABLE c"}, statements)
+	assert.Empty(t, splitStatements("\n  \n"))
+}
+
+func TestMigrator_Pending(t *testing.T) {
+	// Setup
+	conn := db.Connect("sqlite://" + filepath.Join(t.TempDir(), "interviews.db"))
+	t.Cleanup(func() { conn.Close() })
+	migrations, err := Load(db.SQLite)
+	require.NoError(t, err)
+	ctx := context.Background()
+	migrator := NewMigrator(conn, db.SQLite, migrations)
+
+	// Execute
+	_, missingTableErr := migrator.Pending(ctx)
+	_, err = NewMigrator(conn, db.SQLite, migrations[:1]).Up(ctx)
+	require.NoError(t, err)
+	pending, err := migrator.Pending(ctx)
+
+	// Assertions
+	assert.Error(t, missingTableErr)
+	require.NoError(t, err)
+	assert.Equal(t, migrations[1:], pending)
+}
@@ -0,0 +1,20 @@
+-- Tables adopted from the hand-made schema were not created by this migration and are kept
+SET @drop = IF(EXISTS(SELECT 1 FROM schema_adopted_tables WHERE table_name = 'interview_panelists'),
+    'DO 0', 'DROP TABLE IF EXISTS interview_panelists');
+
+PREPARE drop_table FROM @drop;
+
+EXECUTE drop_table;
+
+DEALLOCATE PREPARE drop_table;
+
+SET @drop = IF(EXISTS(SELECT 1 FROM schema_adopted_tables WHERE table_name = 'interviews'),
+    'DO 0', 'DROP TABLE IF EXISTS interviews');
+
+PREPARE drop_table FROM @drop;
+
+EXECUTE drop_table;
+
+DEALLOCATE PREPARE drop_table;
+
+DROP TABLE IF EXISTS schema_adopted_tables;
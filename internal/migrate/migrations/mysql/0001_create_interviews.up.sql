@@ -0,0 +1,84 @@
+-- Tables of the hand-made schema that predates migrations are adopted instead of created. They are recorded
+-- here so reverting the migration that would have created one keeps it
+CREATE TABLE IF NOT EXISTS schema_adopted_tables (
+    table_name VARCHAR(64) NOT NULL PRIMARY KEY
+);
+
+INSERT IGNORE INTO schema_adopted_tables (table_name)
+SELECT table_name FROM information_schema.tables
+WHERE table_schema = DATABASE() AND table_name IN ('interviews', 'interview_panelists', 'outbox',
+    'webhook_subscriptions', 'webhook_deliveries', 'interview_reminders', 'feedback_nudges');
+
+CREATE TABLE IF NOT EXISTS interviews (
+    id                  INT AUTO_INCREMENT PRIMARY KEY,
+    candidate_id        INT          NOT NULL,
+    job_id              INT          NOT NULL,
+    interview_date      DATETIME     NOT NULL,
+    feedback            TEXT         NOT NULL,
+    candidate_email     VARCHAR(255) NOT NULL DEFAULT '',
+    status              VARCHAR(32)  NOT NULL DEFAULT 'scheduled',
+    cancellation_reason VARCHAR(255) NOT NULL DEFAULT '',
+    stage               VARCHAR(64)  NOT NULL DEFAULT '',
+    INDEX idx_interviews_candidate (candidate_id, status, interview_date),
+    INDEX idx_interviews_job (job_id, status, interview_date),
+    INDEX idx_interviews_status (status, interview_date)
+);
+
+CREATE TABLE IF NOT EXISTS interview_panelists (
+    id                    INT AUTO_INCREMENT PRIMARY KEY,
+    interview_id          INT          NOT NULL,
+    name                  VARCHAR(255) NOT NULL,
+    email                 VARCHAR(255) NOT NULL,
+    feedback              TEXT         NULL,
+    feedback_submitted_at DATETIME     NULL,
+    INDEX idx_interview_panelists_interview (interview_id)
+);
+
+-- An adopted interviews table may lack the columns and indexes documented after it was created
+SET @adopt = CONCAT_WS(', ',
+    IF(EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND column_name = 'candidate_email'),
+        NULL, 'ADD COLUMN candidate_email VARCHAR(255) NOT NULL DEFAULT '''''),
+    IF(EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND column_name = 'status'),
+        NULL, 'ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT ''scheduled'''),
+    IF(EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND column_name = 'cancellation_reason'),
+        NULL, 'ADD COLUMN cancellation_reason VARCHAR(255) NOT NULL DEFAULT '''''),
+    IF(EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND column_name = 'stage'),
+        NULL, 'ADD COLUMN stage VARCHAR(64) NOT NULL DEFAULT '''''),
+    IF(EXISTS(SELECT 1 FROM information_schema.statistics WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND index_name = 'idx_interviews_candidate'),
+        NULL, 'ADD INDEX idx_interviews_candidate (candidate_id, status, interview_date)'),
+    IF(EXISTS(SELECT 1 FROM information_schema.statistics WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND index_name = 'idx_interviews_job'),
+        NULL, 'ADD INDEX idx_interviews_job (job_id, status, interview_date)'),
+    IF(EXISTS(SELECT 1 FROM information_schema.statistics WHERE table_schema = DATABASE()
+        AND table_name = 'interviews' AND index_name = 'idx_interviews_status'),
+        NULL, 'ADD INDEX idx_interviews_status (status, interview_date)'));
+
+SET @adopt = IF(@adopt = '', 'DO 0', CONCAT('ALTER TABLE interviews ', @adopt));
+
+PREPARE adopt FROM @adopt;
+
+EXECUTE adopt;
+
+DEALLOCATE PREPARE adopt;
+
+-- Likewise for the feedback columns of an adopted interview_panelists table
+SET @adopt = CONCAT_WS(', ',
+    IF(EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE()
+        AND table_name = 'interview_panelists' AND column_name = 'feedback'),
+        NULL, 'ADD COLUMN feedback TEXT NULL'),
+    IF(EXISTS(SELECT 1 FROM information_schema.columns WHERE table_schema = DATABASE()
+        AND table_name = 'interview_panelists' AND column_name = 'feedback_submitted_at'),
+        NULL, 'ADD COLUMN feedback_submitted_at DATETIME NULL'));
+
+SET @adopt = IF(@adopt = '', 'DO 0', CONCAT('ALTER TABLE interview_panelists ', @adopt));
+
+PREPARE adopt FROM @adopt;
+
+EXECUTE adopt;
+
+DEALLOCATE PREPARE adopt;
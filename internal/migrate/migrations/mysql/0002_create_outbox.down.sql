@@ -0,0 +1,9 @@
+-- Tables adopted from the hand-made schema were not created by this migration and are kept
+SET @drop = IF(EXISTS(SELECT 1 FROM schema_adopted_tables WHERE table_name = 'outbox'),
+    'DO 0', 'DROP TABLE IF EXISTS outbox');
+
+PREPARE drop_table FROM @drop;
+
+EXECUTE drop_table;
+
+DEALLOCATE PREPARE drop_table;
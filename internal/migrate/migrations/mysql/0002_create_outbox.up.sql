@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id           BIGINT AUTO_INCREMENT PRIMARY KEY,
+    event_id     VARCHAR(64) NOT NULL,
+    interview_id INT         NOT NULL,
+    event_type   VARCHAR(64) NOT NULL,
+    payload      JSON        NOT NULL,
+    attempts     INT         NOT NULL DEFAULT 0,
+    last_error   TEXT        NOT NULL,
+    created_at   DATETIME    NOT NULL,
+    published_at DATETIME    NULL,
+    INDEX idx_outbox_pending (published_at, id)
+);
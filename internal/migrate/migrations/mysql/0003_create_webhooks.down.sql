@@ -0,0 +1,18 @@
+-- Tables adopted from the hand-made schema were not created by this migration and are kept
+SET @drop = IF(EXISTS(SELECT 1 FROM schema_adopted_tables WHERE table_name = 'webhook_deliveries'),
+    'DO 0', 'DROP TABLE IF EXISTS webhook_deliveries');
+
+PREPARE drop_table FROM @drop;
+
+EXECUTE drop_table;
+
+DEALLOCATE PREPARE drop_table;
+
+SET @drop = IF(EXISTS(SELECT 1 FROM schema_adopted_tables WHERE table_name = 'webhook_subscriptions'),
+    'DO 0', 'DROP TABLE IF EXISTS webhook_subscriptions');
+
+PREPARE drop_table FROM @drop;
+
+EXECUTE drop_table;
+
+DEALLOCATE PREPARE drop_table;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id          INT AUTO_INCREMENT PRIMARY KEY,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(255)  NOT NULL,
+    event_types VARCHAR(255)  NOT NULL,
+    created_at  DATETIME      NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              INT AUTO_INCREMENT PRIMARY KEY,
+    subscription_id INT          NOT NULL,
+    event_id        VARCHAR(64)  NOT NULL,
+    event_type      VARCHAR(64)  NOT NULL,
+    payload         JSON         NOT NULL,
+    status          VARCHAR(16)  NOT NULL,
+    attempts        INT          NOT NULL DEFAULT 0,
+    response_code   INT          NOT NULL DEFAULT 0,
+    last_error      TEXT         NOT NULL,
+    next_attempt_at DATETIME     NOT NULL,
+    created_at      DATETIME     NOT NULL,
+    updated_at      DATETIME     NOT NULL,
+    INDEX idx_webhook_deliveries_due (status, next_attempt_at),
+    INDEX idx_webhook_deliveries_subscription (subscription_id)
+);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS interview_reminders (
+    interview_id   INT      NOT NULL,
+    offset_minutes INT      NOT NULL,
+    interview_date DATETIME NOT NULL,
+    sent_at        DATETIME NOT NULL,
+    PRIMARY KEY (interview_id, offset_minutes, interview_date)
+);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS feedback_nudges (
+    panelist_id INT      NOT NULL,
+    level       INT      NOT NULL,
+    sent_at     DATETIME NOT NULL,
+    PRIMARY KEY (panelist_id, level)
+);
@@ -0,0 +1 @@
+ALTER TABLE webhook_deliveries DROP INDEX uq_webhook_deliveries_event;
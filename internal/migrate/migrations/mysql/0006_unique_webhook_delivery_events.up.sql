@@ -0,0 +1,5 @@
+-- Deliveries recorded twice for the same event before the index existed: keep the first one of each
+DELETE d FROM webhook_deliveries d
+JOIN webhook_deliveries kept ON kept.subscription_id = d.subscription_id AND kept.event_id = d.event_id AND kept.id < d.id;
+
+ALTER TABLE webhook_deliveries ADD UNIQUE INDEX uq_webhook_deliveries_event (subscription_id, event_id);
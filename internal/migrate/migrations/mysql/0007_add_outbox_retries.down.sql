@@ -0,0 +1,4 @@
+ALTER TABLE outbox
+    DROP INDEX idx_outbox_interview,
+    DROP COLUMN next_attempt_at,
+    DROP COLUMN abandoned_at;
@@ -0,0 +1,4 @@
+ALTER TABLE outbox
+    ADD COLUMN next_attempt_at DATETIME NULL AFTER last_error,
+    ADD COLUMN abandoned_at    DATETIME NULL AFTER published_at,
+    ADD INDEX idx_outbox_interview (interview_id, id);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id           BIGSERIAL   PRIMARY KEY,
+    event_id     VARCHAR(64) NOT NULL,
+    interview_id INT         NOT NULL,
+    event_type   VARCHAR(64) NOT NULL,
+    payload      JSONB       NOT NULL,
+    attempts     INT         NOT NULL DEFAULT 0,
+    last_error   TEXT        NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL,
+    published_at TIMESTAMPTZ NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (id) WHERE published_at IS NULL;
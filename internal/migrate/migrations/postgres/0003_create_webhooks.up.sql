@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id          SERIAL        PRIMARY KEY,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(255)  NOT NULL,
+    event_types VARCHAR(255)  NOT NULL,
+    created_at  TIMESTAMPTZ   NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              SERIAL       PRIMARY KEY,
+    subscription_id INT          NOT NULL,
+    event_id        VARCHAR(64)  NOT NULL,
+    event_type      VARCHAR(64)  NOT NULL,
+    payload         JSONB        NOT NULL,
+    status          VARCHAR(16)  NOT NULL,
+    attempts        INT          NOT NULL DEFAULT 0,
+    response_code   INT          NOT NULL DEFAULT 0,
+    last_error      TEXT         NOT NULL,
+    next_attempt_at TIMESTAMPTZ  NOT NULL,
+    created_at      TIMESTAMPTZ  NOT NULL,
+    updated_at      TIMESTAMPTZ  NOT NULL,
+    CONSTRAINT uq_webhook_deliveries_event UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_outbox_interview;
+
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS abandoned_at;
@@ -0,0 +1,5 @@
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ NULL,
+    ADD COLUMN IF NOT EXISTS abandoned_at    TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_interview ON outbox (interview_id, id) WHERE published_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS interview_panelists;
+DROP TABLE IF EXISTS interviews;
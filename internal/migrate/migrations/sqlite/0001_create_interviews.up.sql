@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS interviews (
+    id                  INTEGER      PRIMARY KEY AUTOINCREMENT,
+    candidate_id        INTEGER      NOT NULL,
+    job_id              INTEGER      NOT NULL,
+    interview_date      DATETIME     NOT NULL,
+    feedback            TEXT         NOT NULL,
+    candidate_email     VARCHAR(255) NOT NULL DEFAULT '',
+    status              VARCHAR(32)  NOT NULL DEFAULT 'scheduled',
+    cancellation_reason VARCHAR(255) NOT NULL DEFAULT '',
+    stage               VARCHAR(64)  NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS idx_interviews_candidate ON interviews (candidate_id, status, interview_date);
+
+CREATE INDEX IF NOT EXISTS idx_interviews_job ON interviews (job_id, status, interview_date);
+
+CREATE INDEX IF NOT EXISTS idx_interviews_status ON interviews (status, interview_date);
+
+CREATE TABLE IF NOT EXISTS interview_panelists (
+    id                    INTEGER      PRIMARY KEY AUTOINCREMENT,
+    interview_id          INTEGER      NOT NULL,
+    name                  VARCHAR(255) NOT NULL,
+    email                 VARCHAR(255) NOT NULL,
+    feedback              TEXT         NULL,
+    feedback_submitted_at DATETIME     NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_interview_panelists_interview ON interview_panelists (interview_id);
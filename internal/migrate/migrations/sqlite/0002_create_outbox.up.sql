@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS outbox (
+    id           INTEGER     PRIMARY KEY AUTOINCREMENT,
+    event_id     VARCHAR(64) NOT NULL,
+    interview_id INTEGER     NOT NULL,
+    event_type   VARCHAR(64) NOT NULL,
+    payload      TEXT        NOT NULL,
+    attempts     INTEGER     NOT NULL DEFAULT 0,
+    last_error   TEXT        NOT NULL,
+    created_at   DATETIME    NOT NULL,
+    published_at DATETIME    NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox (id) WHERE published_at IS NULL;
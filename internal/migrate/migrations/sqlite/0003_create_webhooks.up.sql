@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id          INTEGER       PRIMARY KEY AUTOINCREMENT,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(255)  NOT NULL,
+    event_types VARCHAR(255)  NOT NULL,
+    created_at  DATETIME      NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              INTEGER      PRIMARY KEY AUTOINCREMENT,
+    subscription_id INTEGER      NOT NULL,
+    event_id        VARCHAR(64)  NOT NULL,
+    event_type      VARCHAR(64)  NOT NULL,
+    payload         TEXT         NOT NULL,
+    status          VARCHAR(16)  NOT NULL,
+    attempts        INTEGER      NOT NULL DEFAULT 0,
+    response_code   INTEGER      NOT NULL DEFAULT 0,
+    last_error      TEXT         NOT NULL,
+    next_attempt_at DATETIME     NOT NULL,
+    created_at      DATETIME     NOT NULL,
+    updated_at      DATETIME     NOT NULL,
+    UNIQUE (subscription_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS feedback_nudges (
+    panelist_id INTEGER  NOT NULL,
+    level       INTEGER  NOT NULL,
+    sent_at     DATETIME NOT NULL,
+    PRIMARY KEY (panelist_id, level)
+);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_outbox_interview;
+
+ALTER TABLE outbox DROP COLUMN next_attempt_at;
+
+ALTER TABLE outbox DROP COLUMN abandoned_at;
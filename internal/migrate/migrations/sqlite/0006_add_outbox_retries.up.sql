@@ -0,0 +1,5 @@
+ALTER TABLE outbox ADD COLUMN next_attempt_at DATETIME NULL;
+
+ALTER TABLE outbox ADD COLUMN abandoned_at DATETIME NULL;
+
+CREATE INDEX IF NOT EXISTS idx_outbox_interview ON outbox (interview_id, id) WHERE published_at IS NULL;
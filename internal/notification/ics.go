@@ -0,0 +1,94 @@
+package notification
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+const icsTimeFormat = "20060102T150405Z"
+
+// BuildInvite renders an iCalendar (RFC 5545) invite for an interview event
+// Cancellations produce a METHOD:CANCEL calendar so clients remove the event; every
+// other event produces a METHOD:REQUEST that creates or updates it.
+// @param event domain.Event - The event the invite describes
+// @param organizer string - The address the invite is sent from
+// @return []byte - The encoded calendar
+func BuildInvite(event domain.Event, organizer string) []byte {
+	interview := event.Interview
+	start := interview.InterviewDate.UTC()
+	end := start.Add(domain.DefaultInterviewDuration)
+
+	method, status := "REQUEST", "CONFIRMED"
+	if event.Type == domain.EventInterviewCancelled {
+		method, status = "CANCEL", "CANCELLED"
+	}
+
+	var b strings.Builder
+	line := func(format string, args ...interface{}) {
+		b.WriteString(foldLine(fmt.Sprintf(format, args...)))
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//poolcamacho//interviews-service//EN")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:%s", method)
+	line("BEGIN:VEVENT")
+	line("UID:interview-%d@interviews-service", interview.ID)
+	line("DTSTAMP:%s", event.OccurredAt.UTC().Format(icsTimeFormat))
+	// Later events must carry a higher sequence so calendars apply them over earlier ones
+	line("SEQUENCE:%d", event.OccurredAt.Unix())
+	line("DTSTART:%s", start.Format(icsTimeFormat))
+	line("DTEND:%s", end.Format(icsTimeFormat))
+	line("SUMMARY:%s", escapeText(fmt.Sprintf("Interview for job #%d", interview.JobID)))
+	line("STATUS:%s", status)
+	if organizer != "" {
+		line("ORGANIZER:mailto:%s", organizer)
+	}
+	if interview.CandidateEmail != "" {
+		line("ATTENDEE;ROLE=REQ-PARTICIPANT;RSVP=TRUE:mailto:%s", interview.CandidateEmail)
+	}
+	for _, p := range interview.Panel {
+		line("ATTENDEE;CN=%s;ROLE=REQ-PARTICIPANT;RSVP=TRUE:mailto:%s", quoteParam(p.Name), p.Email)
+	}
+	line("END:VEVENT")
+	line("END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// escapeText escapes a TEXT value as required by RFC 5545 section 3.3.11
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// quoteParam quotes a parameter value, dropping characters that are not allowed inside quotes
+func quoteParam(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "") + `"`
+}
+
+// foldLine terminates a content line with CRLF, folding it at 75 octets
+func foldLine(s string) string {
+	const limit = 75
+	var b strings.Builder
+	for len(s) > limit {
+		cut := limit
+		// Avoid splitting a multi-byte UTF-8 sequence
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+// formatDate renders an interview date for message bodies
+func formatDate(t time.Time) string {
+	return t.UTC().Format("Monday, 02 January 2006 15:04 MST")
+}
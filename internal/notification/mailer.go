@@ -0,0 +1,151 @@
+package notification
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Attachment represents a file attached to an email message
+type Attachment struct {
+	Filename    string // Name of the file as shown to the recipient
+	ContentType string // MIME type of the file, e.g. text/calendar
+	Data        []byte // Raw file contents
+}
+
+// Message represents an email message ready to be delivered
+type Message struct {
+	From        string       // Sender address
+	To          []string     // Recipient addresses
+	Subject     string       // Subject line
+	Body        string       // Plain-text body
+	Attachments []Attachment // Files attached to the message
+}
+
+// Mailer defines methods for delivering email messages
+// This interface abstracts the transport used to send notifications.
+type Mailer interface {
+	// Send delivers a message to all of its recipients
+	// @param msg Message - The message to deliver
+	// @return error - An error if the message could not be delivered
+	Send(msg Message) error
+}
+
+// SMTPMailer delivers messages through an SMTP server
+// Works with any standard relay as well as local sinks such as MailHog.
+type SMTPMailer struct {
+	addr     string    // host:port of the SMTP server
+	auth     smtp.Auth // Authentication mechanism, nil when no credentials are configured
+	sendMail sendFunc  // Function used to talk to the server, replaced in tests
+}
+
+type sendFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// NewSMTPMailer creates a new SMTPMailer instance
+// Uses PLAIN authentication when a username is provided.
+// @param host string - The SMTP server host
+// @param port string - The SMTP server port
+// @param username string - The username used for authentication, empty to disable authentication
+// @param password string - The password used for authentication
+// @return *SMTPMailer - A mailer bound to the given server
+func NewSMTPMailer(host, port, username, password string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPMailer{addr: net.JoinHostPort(host, port), auth: auth, sendMail: smtp.SendMail}
+}
+
+// Send delivers a message through the SMTP server
+// Encodes the message as MIME multipart/mixed when it carries attachments.
+// @param msg Message - The message to deliver
+// @return error - An error if the message could not be encoded or delivered
+func (m *SMTPMailer) Send(msg Message) error {
+	if len(msg.To) == 0 {
+		return nil // Nothing to deliver
+	}
+	raw, err := encodeMessage(msg)
+	if err != nil {
+		return err
+	}
+	return m.sendMail(m.addr, m.auth, msg.From, msg.To, raw)
+}
+
+// encodeMessage renders a message in RFC 5322 format
+// @param msg Message - The message to encode
+// @return []byte - The encoded message
+// @return error - An error if a boundary could not be generated
+func encodeMessage(msg Message) ([]byte, error) {
+	var buf bytes.Buffer
+	writeHeader(&buf, "From", msg.From)
+	writeHeader(&buf, "To", strings.Join(msg.To, ", "))
+	writeHeader(&buf, "Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	writeHeader(&buf, "Date", time.Now().Format(time.RFC1123Z))
+	writeHeader(&buf, "MIME-Version", "1.0")
+
+	if len(msg.Attachments) == 0 {
+		writeHeader(&buf, "Content-Type", "text/plain; charset=utf-8")
+		writeHeader(&buf, "Content-Transfer-Encoding", "8bit")
+		buf.WriteString("\r\n")
+		buf.WriteString(msg.Body)
+		return buf.Bytes(), nil
+	}
+
+	boundary, err := randomBoundary()
+	if err != nil {
+		return nil, err
+	}
+	writeHeader(&buf, "Content-Type", fmt.Sprintf("multipart/mixed; boundary=%q", boundary))
+	buf.WriteString("\r\n")
+
+	fmt.Fprintf(&buf, "--%s\r\n", boundary)
+	writeHeader(&buf, "Content-Type", "text/plain; charset=utf-8")
+	writeHeader(&buf, "Content-Transfer-Encoding", "8bit")
+	buf.WriteString("\r\n")
+	buf.WriteString(msg.Body)
+	buf.WriteString("\r\n")
+
+	for _, a := range msg.Attachments {
+		fmt.Fprintf(&buf, "--%s\r\n", boundary)
+		writeHeader(&buf, "Content-Type", fmt.Sprintf("%s; charset=utf-8; name=%q", a.ContentType, a.Filename))
+		writeHeader(&buf, "Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.Filename))
+		writeHeader(&buf, "Content-Transfer-Encoding", "base64")
+		buf.WriteString("\r\n")
+		writeBase64(&buf, a.Data)
+	}
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes(), nil
+}
+
+// writeHeader writes a single header line
+func writeHeader(buf *bytes.Buffer, key, value string) {
+	fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+}
+
+// writeBase64 writes data as base64 wrapped at 76 characters per line
+func writeBase64(buf *bytes.Buffer, data []byte) {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76])
+		buf.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded)
+	buf.WriteString("\r\n")
+}
+
+// randomBoundary generates a random MIME boundary
+func randomBoundary() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "interviews-" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,59 @@
+package notification
+
+import (
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSMTPMailer_Send(t *testing.T) {
+	// Setup
+	mailer := NewSMTPMailer("localhost", "1025", "", "")
+	var gotAddr, gotFrom string
+	var gotTo []string
+	var gotMsg []byte
+	mailer.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, msg
+		return nil
+	}
+
+	// Execute
+	err := mailer.Send(Message{
+		From:        "recruiting@example.com",
+		To:          []string{"candidate@example.com"},
+		Subject:     "Interview scheduled",
+		Body:        "See you soon",
+		Attachments: []Attachment{{Filename: "invite.ics", ContentType: "text/calendar; method=REQUEST", Data: []byte("BEGIN:VCALENDAR")}},
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost:1025", gotAddr)
+	assert.Equal(t, "recruiting@example.com", gotFrom)
+	assert.Equal(t, []string{"candidate@example.com"}, gotTo)
+	raw := string(gotMsg)
+	assert.Contains(t, raw, "Subject: Interview scheduled\r\n")
+	assert.Contains(t, raw, "Content-Type: multipart/mixed; boundary=")
+	assert.Contains(t, raw, `Content-Disposition: attachment; filename="invite.ics"`)
+	assert.Contains(t, raw, "QkVHSU46VkNBTEVOREFS") // base64 of BEGIN:VCALENDAR
+	assert.True(t, strings.HasSuffix(raw, "--\r\n"))
+}
+
+func TestSMTPMailer_SendWithoutRecipients(t *testing.T) {
+	// Setup
+	mailer := NewSMTPMailer("localhost", "1025", "", "")
+	called := false
+	mailer.sendMail = func(string, smtp.Auth, string, []string, []byte) error {
+		called = true
+		return nil
+	}
+
+	// Execute
+	err := mailer.Send(Message{From: "recruiting@example.com", Subject: "Nobody"})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.False(t, called)
+}
@@ -0,0 +1,179 @@
+package notification
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// ErrQueueFull is returned when an event cannot be queued because too many are waiting for delivery
+var ErrQueueFull = errors.New("notification queue is full")
+
+// ErrNotifierClosed is returned when an event is given to a notifier that has been closed
+var ErrNotifierClosed = errors.New("notifier is closed")
+
+// Notifier defines methods for notifying interview participants
+// This interface abstracts how participants learn about scheduled, rescheduled and cancelled interviews.
+type Notifier interface {
+	// Notify informs the participants of an interview about an event
+	// Implementations must not block the caller on mail delivery.
+	// @param event domain.Event - The event to notify
+	// @return error - An error if the event was not accepted for delivery, so the caller can retry it
+	Notify(event domain.Event) error
+}
+
+// NopNotifier is a Notifier that discards every event
+// Used when no mail server is configured.
+type NopNotifier struct{}
+
+// Notify discards the event
+func (NopNotifier) Notify(domain.Event) error { return nil }
+
+// AsyncNotifier sends notification emails from a pool of background workers
+// Events are queued in memory so callers never wait on the mail server.
+type AsyncNotifier struct {
+	mailer    Mailer            // Transport used to deliver messages
+	templates *Templates        // Templates used to render messages
+	from      string            // Sender address
+	queue     chan domain.Event // Pending events
+	mu        sync.RWMutex      // Guards closed against concurrent Notify and Close
+	closed    bool              // Whether Close has been called
+	wg        sync.WaitGroup    // Tracks running workers
+}
+
+// NewAsyncNotifier creates a new AsyncNotifier instance and starts its workers
+// @param mailer Mailer - The transport used to deliver messages
+// @param templates *Templates - The templates used to render messages
+// @param from string - The sender address
+// @param queueSize int - The number of events that can wait for delivery before new ones are dropped
+// @param workers int - The number of concurrent deliveries
+// @return *AsyncNotifier - A running notifier
+func NewAsyncNotifier(mailer Mailer, templates *Templates, from string, queueSize, workers int) *AsyncNotifier {
+	if workers < 1 {
+		workers = 1
+	}
+	n := &AsyncNotifier{
+		mailer:    mailer,
+		templates: templates,
+		from:      from,
+		queue:     make(chan domain.Event, queueSize),
+	}
+	for w := 0; w < workers; w++ {
+		n.wg.Add(1)
+		go n.run()
+	}
+	return n
+}
+
+// Notify queues an event for delivery
+// Events are refused rather than waited on when the queue is full; the outbox relay retries them later.
+// @param event domain.Event - The event to notify
+// @return error - ErrQueueFull when the queue is full, ErrNotifierClosed once Close has been called
+func (n *AsyncNotifier) Notify(event domain.Event) error {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	if n.closed {
+		return ErrNotifierClosed
+	}
+
+	select {
+	case n.queue <- event:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Close stops accepting events and waits until queued events have been delivered
+func (n *AsyncNotifier) Close() {
+	n.mu.Lock()
+	if n.closed {
+		n.mu.Unlock()
+		return
+	}
+	n.closed = true
+	close(n.queue)
+	n.mu.Unlock()
+
+	n.wg.Wait()
+}
+
+// run delivers queued events until the queue is closed
+func (n *AsyncNotifier) run() {
+	defer n.wg.Done()
+	for event := range n.queue {
+		if err := n.deliver(event); err != nil {
+			slog.Error("Failed to send notification", "component", "notification", "event_type", event.Type, "interview_id", event.Interview.ID, "error", err)
+		}
+	}
+}
+
+// deliver renders and sends the message for a single event
+// @param event domain.Event - The event to deliver
+// @return error - An error if rendering or delivery fails
+func (n *AsyncNotifier) deliver(event domain.Event) error {
+	msg, err := NewMessage(n.templates, n.from, event)
+	if err != nil || len(msg.To) == 0 {
+		return err
+	}
+	return n.mailer.Send(msg)
+}
+
+// NewMessage renders the email informing the participants of an interview about an event
+// Every event but reminders carries a calendar invite updating the participants' calendars.
+// @param templates *Templates - The templates used to render the message
+// @param from string - The sender address
+// @param event domain.Event - The event to describe
+// @return Message - The message, without recipients when the interview has no participant addresses
+// @return error - An error if the message could not be rendered
+func NewMessage(templates *Templates, from string, event domain.Event) (Message, error) {
+	to := Recipients(&event.Interview)
+	if len(to) == 0 {
+		return Message{}, nil
+	}
+
+	subject, body, err := templates.Render(event)
+	if err != nil {
+		return Message{}, err
+	}
+
+	msg := Message{From: from, To: to, Subject: subject, Body: body}
+	if event.Type != domain.EventInterviewReminder { // Reminders leave the calendar entry untouched
+		msg.Attachments = []Attachment{{
+			Filename:    "invite.ics",
+			ContentType: "text/calendar; method=" + inviteMethod(event),
+			Data:        BuildInvite(event, from),
+		}}
+	}
+	return msg, nil
+}
+
+// Recipients lists the unique addresses of everyone taking part in an interview
+// @param interview *domain.Interview - The interview whose participants are returned
+// @return []string - The candidate address followed by the panelist addresses
+func Recipients(interview *domain.Interview) []string {
+	seen := make(map[string]bool)
+	var to []string
+	add := func(addr string) {
+		if addr != "" && !seen[addr] {
+			seen[addr] = true
+			to = append(to, addr)
+		}
+	}
+
+	add(interview.CandidateEmail)
+	for _, p := range interview.Panel {
+		add(p.Email)
+	}
+	return to
+}
+
+// inviteMethod returns the iTIP method used for an event's calendar attachment
+func inviteMethod(event domain.Event) string {
+	if event.Type == domain.EventInterviewCancelled {
+		return "CANCEL"
+	}
+	return "REQUEST"
+}
@@ -0,0 +1,32 @@
+package notification
+
+import (
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockNotifier is a mock implementation of Notifier for testing
+type MockNotifier struct {
+	mock.Mock
+}
+
+// Notify mocks the Notify method
+// @param event domain.Event - The event to notify
+// @return error - An error if the operation fails
+func (m *MockNotifier) Notify(event domain.Event) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+// MockMailer is a mock implementation of Mailer for testing
+type MockMailer struct {
+	mock.Mock
+}
+
+// Send mocks the Send method
+// @param msg Message - The message to deliver
+// @return error - An error if the operation fails
+func (m *MockMailer) Send(msg Message) error {
+	args := m.Called(msg)
+	return args.Error(0)
+}
@@ -0,0 +1,196 @@
+package notification
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAsyncNotifier_SendsInviteToParticipants(t *testing.T) {
+	// Setup
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+	mockMailer := new(MockMailer)
+	notifier := NewAsyncNotifier(mockMailer, templates, "recruiting@example.com", 10, 1)
+
+	// Mock behavior
+	var sent Message
+	mockMailer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(0).(Message)
+	}).Return(nil)
+
+	// Execute
+	require.NoError(t, notifier.Notify(mockEvent(domain.EventInterviewCreated)))
+	notifier.Close()
+
+	// Assertions
+	mockMailer.AssertNumberOfCalls(t, "Send", 1)
+	assert.Equal(t, "recruiting@example.com", sent.From)
+	assert.Equal(t, []string{"candidate@example.com", "alice@example.com"}, sent.To)
+	assert.Equal(t, "Interview scheduled for job #201", sent.Subject)
+	assert.Contains(t, sent.Body, "Monday, 30 December 2024 15:00 UTC")
+	assert.Contains(t, sent.Body, "Alice <alice@example.com>")
+	require.Len(t, sent.Attachments, 1)
+	assert.Equal(t, "invite.ics", sent.Attachments[0].Filename)
+	assert.Contains(t, string(sent.Attachments[0].Data), "METHOD:REQUEST")
+}
+
+func TestAsyncNotifier_SendsReminderWithoutInvite(t *testing.T) {
+	// Setup
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+	mockMailer := new(MockMailer)
+	notifier := NewAsyncNotifier(mockMailer, templates, "recruiting@example.com", 10, 1)
+
+	// Mock data
+	event := mockEvent(domain.EventInterviewReminder)
+	event.OccurredAt = event.Interview.InterviewDate.Add(-24 * time.Hour)
+
+	// Mock behavior
+	var sent Message
+	mockMailer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(0).(Message)
+	}).Return(nil)
+
+	// Execute
+	require.NoError(t, notifier.Notify(event))
+	notifier.Close()
+
+	// Assertions
+	mockMailer.AssertNumberOfCalls(t, "Send", 1)
+	assert.Equal(t, "Reminder: interview for job #201 in 24 hours", sent.Subject)
+	assert.Equal(t, []string{"candidate@example.com", "alice@example.com"}, sent.To)
+	assert.Empty(t, sent.Attachments)
+}
+
+func TestAsyncNotifier_MailerErrorDoesNotStopWorker(t *testing.T) {
+	// Setup
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+	mockMailer := new(MockMailer)
+	notifier := NewAsyncNotifier(mockMailer, templates, "recruiting@example.com", 10, 1)
+
+	// Mock behavior
+	mockMailer.On("Send", mock.Anything).Return(errors.New("connection refused")).Once()
+	mockMailer.On("Send", mock.Anything).Return(nil).Once()
+
+	// Execute
+	require.NoError(t, notifier.Notify(mockEvent(domain.EventInterviewCreated)))
+	require.NoError(t, notifier.Notify(mockEvent(domain.EventInterviewCancelled)))
+	notifier.Close()
+
+	// Assertions
+	mockMailer.AssertNumberOfCalls(t, "Send", 2)
+}
+
+func TestAsyncNotifier_NotifyAfterClose(t *testing.T) {
+	// Setup
+	mockMailer := new(MockMailer)
+	notifier := NewAsyncNotifier(mockMailer, &Templates{}, "recruiting@example.com", 10, 1)
+	notifier.Close()
+
+	// Execute
+	err := notifier.Notify(mockEvent(domain.EventInterviewCreated))
+
+	// Assertions
+	assert.ErrorIs(t, err, ErrNotifierClosed)
+	mockMailer.AssertNotCalled(t, "Send", mock.Anything)
+}
+
+func TestAsyncNotifier_RefusesEventsWhenQueueIsFull(t *testing.T) {
+	// Setup
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+	mockMailer := new(MockMailer)
+	notifier := NewAsyncNotifier(mockMailer, templates, "recruiting@example.com", 1, 1)
+	sending := make(chan struct{})
+	release := make(chan struct{})
+
+	// Mock behavior
+	mockMailer.On("Send", mock.Anything).Run(func(mock.Arguments) {
+		sending <- struct{}{}
+		<-release
+	}).Return(nil).Once()
+	mockMailer.On("Send", mock.Anything).Return(nil).Once()
+
+	// Execute
+	require.NoError(t, notifier.Notify(mockEvent(domain.EventInterviewCreated)))
+	<-sending // The worker holds the first event, the queue is empty
+	queued := notifier.Notify(mockEvent(domain.EventInterviewRescheduled))
+	refused := notifier.Notify(mockEvent(domain.EventInterviewCancelled))
+	close(release)
+	notifier.Close()
+
+	// Assertions
+	assert.NoError(t, queued)
+	assert.ErrorIs(t, refused, ErrQueueFull)
+	mockMailer.AssertNumberOfCalls(t, "Send", 2)
+}
+
+func TestTemplates_RenderCancelled(t *testing.T) {
+	// Setup
+	templates, err := LoadTemplates()
+	require.NoError(t, err)
+	event := mockEvent(domain.EventInterviewCancelled)
+	event.Reason = "Position filled"
+
+	// Execute
+	subject, body, err := templates.Render(event)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, "Interview for job #201 cancelled", subject)
+	assert.Contains(t, body, "Reason: Position filled")
+}
+
+func TestBuildInvite_Cancelled(t *testing.T) {
+	// Execute
+	invite := string(BuildInvite(mockEvent(domain.EventInterviewCancelled), "recruiting@example.com"))
+
+	// Assertions
+	assert.Contains(t, invite, "METHOD:CANCEL\r\n")
+	assert.Contains(t, invite, "STATUS:CANCELLED\r\n")
+	assert.Contains(t, invite, "UID:interview-7@interviews-service\r\n")
+	assert.Contains(t, invite, "DTSTART:20241230T150000Z\r\n")
+	assert.Contains(t, invite, "DTEND:20241230T160000Z\r\n")
+	assert.Contains(t, invite, "ATTENDEE;CN=\"Alice\";ROLE=REQ-PARTICIPANT;RSVP=TRUE:mailto:alice@example.com\r\n")
+}
+
+func TestRecipients_Deduplicates(t *testing.T) {
+	// Setup
+	interview := &domain.Interview{
+		CandidateEmail: "candidate@example.com",
+		Panel: []domain.Panelist{
+			{Name: "Alice", Email: "alice@example.com"},
+			{Name: "Alice again", Email: "alice@example.com"},
+			{Name: "No email"},
+		},
+	}
+
+	// Execute
+	recipients := Recipients(interview)
+
+	// Assertions
+	assert.Equal(t, []string{"candidate@example.com", "alice@example.com"}, recipients)
+}
+
+// mockEvent provides an interview event for testing
+func mockEvent(eventType domain.EventType) domain.Event {
+	return domain.Event{
+		Type: eventType,
+		Interview: domain.Interview{
+			ID:             7,
+			CandidateID:    101,
+			JobID:          201,
+			InterviewDate:  time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC),
+			CandidateEmail: "candidate@example.com",
+			Panel:          []domain.Panelist{{ID: 1, InterviewID: 7, Name: "Alice", Email: "alice@example.com"}},
+		},
+		OccurredAt: time.Date(2024, time.December, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
@@ -0,0 +1,118 @@
+package notification
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// Template names of messages that are not interview events
+const (
+	TemplateFeedbackOverdue   = "feedback.overdue"   // Nudges an interviewer about missing feedback
+	TemplateFeedbackEscalated = "feedback.escalated" // Escalates missing feedback to the hiring manager
+)
+
+// Templates renders the subject and body of notification emails
+// One template file exists per event type and per other message, each defining a "subject" and a "body" template.
+type Templates struct {
+	byName map[string]*template.Template // Parsed templates keyed by event type or message name
+}
+
+// LoadTemplates parses the embedded message templates
+// @return *Templates - The parsed templates
+// @return error - An error if a template fails to parse
+func LoadTemplates() (*Templates, error) {
+	funcs := template.FuncMap{"date": templateDate, "until": templateUntil}
+	names := []string{
+		string(domain.EventInterviewCreated),
+		string(domain.EventInterviewRescheduled),
+		string(domain.EventInterviewCancelled),
+		string(domain.EventInterviewReminder),
+		TemplateFeedbackOverdue,
+		TemplateFeedbackEscalated,
+	}
+
+	t := &Templates{byName: make(map[string]*template.Template, len(names))}
+	for _, name := range names {
+		file := name + ".tmpl"
+		tmpl, err := template.New(file).Funcs(funcs).ParseFS(templateFS, "templates/"+file)
+		if err != nil {
+			return nil, err
+		}
+		t.byName[name] = tmpl
+	}
+	return t, nil
+}
+
+// Render produces the subject and body for an event
+// @param event domain.Event - The event being notified
+// @return string - The rendered subject line
+// @return string - The rendered plain-text body
+// @return error - An error if there is no template for the event type or rendering fails
+func (t *Templates) Render(event domain.Event) (string, string, error) {
+	return t.RenderNamed(string(event.Type), event)
+}
+
+// RenderNamed produces the subject and body of a message from the template with the given name
+// @param name string - The event type or message name, e.g. TemplateFeedbackOverdue
+// @param data interface{} - The value the template is executed with
+// @return string - The rendered subject line
+// @return string - The rendered plain-text body
+// @return error - An error if there is no template with that name or rendering fails
+func (t *Templates) RenderNamed(name string, data interface{}) (string, string, error) {
+	tmpl, ok := t.byName[name]
+	if !ok {
+		return "", "", fmt.Errorf("no notification template named %q", name)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, "body", data); err != nil {
+		return "", "", err
+	}
+	return strings.TrimSpace(subject.String()), body.String(), nil
+}
+
+// templateDate formats a time or time pointer for use inside templates
+func templateDate(v interface{}) string {
+	switch t := v.(type) {
+	case time.Time:
+		return formatDate(t)
+	case *time.Time:
+		if t != nil {
+			return formatDate(*t)
+		}
+	}
+	return ""
+}
+
+// templateUntil describes the time left between two times, e.g. "24 hours" or "30 minutes"
+func templateUntil(from, to time.Time) string {
+	d := to.Sub(from).Round(time.Minute)
+	switch {
+	case d >= time.Hour && d%time.Hour == 0:
+		return plural(int(d/time.Hour), "hour")
+	case d >= time.Hour:
+		return plural(int(d/time.Hour), "hour") + " " + plural(int(d%time.Hour/time.Minute), "minute")
+	default:
+		return plural(int(d/time.Minute), "minute")
+	}
+}
+
+// plural formats a count followed by a unit, pluralising the unit when needed
+func plural(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
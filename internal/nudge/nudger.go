@@ -0,0 +1,208 @@
+package nudge
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/notification"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/internal/sla"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Escalation levels recorded for nudges
+const (
+	LevelInterviewer   = 1 // The interviewer was reminded
+	LevelHiringManager = 2 // The hiring manager was told
+)
+
+// sentTotal counts nudges sent since start, exported on /metrics
+var sentTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "feedback_nudges_sent_total",
+	Help: "Overdue feedback nudges sent to interviewers and hiring managers since start.",
+})
+
+// Nudger emails escalating reminders about overdue feedback
+// Once feedback is past its deadline the interviewer is nudged; if it is still missing once the
+// escalation time has passed as well, the hiring manager of the job is told. Each level is sent
+// at most once per interviewer and interview and is only recorded after the mail server accepted it.
+type Nudger struct {
+	feedback  service.FeedbackService // Lists overdue feedback and records nudges
+	jobs      client.JobClient        // Looks up hiring managers, nil to skip escalation
+	mailer    notification.Mailer     // Transport used to deliver the nudges
+	templates *notification.Templates // Templates used to render the nudges
+	from      string                  // Sender address
+	mu        sync.RWMutex            // Guards policy against SetPolicy during runs
+	policy    sla.Policy              // Escalation timing
+	lock      leader.Lock             // Elects the sending replica, nil when running a single instance
+	interval  time.Duration           // How often overdue feedback is looked up
+	now       func() time.Time        // Clock, replaced in tests
+	stop      chan struct{}           // Closed to stop the background loop
+	done      chan struct{}           // Closed once the background loop has exited
+	once      sync.Once               // Guards stop against double close
+}
+
+// NewNudger creates a new Nudger instance
+// @param feedback service.FeedbackService - The service listing overdue feedback
+// @param jobs client.JobClient - The client used to find hiring managers, nil to skip escalation
+// @param mailer notification.Mailer - The transport used to deliver the nudges
+// @param templates *notification.Templates - The templates used to render the nudges
+// @param from string - The sender address
+// @param policy sla.Policy - The escalation timing
+// @param lock leader.Lock - The lock electing the sending replica, nil to always send
+// @param interval time.Duration - How often overdue feedback is looked up
+// @return *Nudger - A nudger that is not yet running
+func NewNudger(feedback service.FeedbackService, jobs client.JobClient, mailer notification.Mailer,
+	templates *notification.Templates, from string, policy sla.Policy, lock leader.Lock, interval time.Duration) *Nudger {
+	return &Nudger{
+		feedback:  feedback,
+		jobs:      jobs,
+		mailer:    mailer,
+		templates: templates,
+		from:      from,
+		policy:    policy,
+		lock:      lock,
+		interval:  interval,
+		now:       time.Now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the nudger loop in the background until Stop is called
+func (n *Nudger) Start() {
+	go func() {
+		defer close(n.done)
+		ticker := time.NewTicker(n.interval)
+		defer ticker.Stop()
+		for {
+			if _, err := n.RunOnce(); err != nil {
+				slog.Error("Failed to send feedback nudges", "component", "nudge", "error", err)
+			}
+			select {
+			case <-n.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the nudger loop to exit, waits for the current run and gives up leadership
+func (n *Nudger) Stop() {
+	n.once.Do(func() { close(n.stop) })
+	<-n.done
+	if n.lock != nil {
+		if err := n.lock.Release(); err != nil {
+			slog.Error("Failed to release the leader lock", "component", "nudge", "error", err)
+		}
+	}
+}
+
+// RunOnce sends the nudges that are due, if this replica is the leader
+// @return int - The number of nudges sent
+// @return error - An error if leadership or overdue feedback could not be checked
+func (n *Nudger) RunOnce() (int, error) {
+	if n.lock != nil {
+		leading, err := n.lock.Acquire()
+		if err != nil || !leading {
+			return 0, err
+		}
+	}
+
+	now := n.now().UTC()
+	overdue, err := n.feedback.OverdueFeedback(now)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, a := range overdue {
+		level, to := n.next(a, now)
+		if level == 0 {
+			continue
+		}
+		if len(to) > 0 {
+			if err := n.send(a, level, to); err != nil {
+				slog.Error("Failed to nudge about feedback", "component", "nudge", "panelist_id", a.Panelist.ID, "error", err)
+				continue
+			}
+			sent++
+			sentTotal.Inc()
+		}
+		if err := n.feedback.RecordNudge(a.Panelist.ID, level, now); err != nil {
+			slog.Error("Failed to record feedback nudge", "component", "nudge", "panelist_id", a.Panelist.ID, "error", err)
+		}
+	}
+	return sent, nil
+}
+
+// SetPolicy replaces the escalation timing, e.g. when the configuration is reloaded
+// @param policy sla.Policy - The new timing, applied from the next run
+func (n *Nudger) SetPolicy(policy sla.Policy) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.policy = policy
+}
+
+// escalationDeadline returns the time after which overdue feedback is escalated under the current policy
+func (n *Nudger) escalationDeadline(deadline time.Time) time.Time {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.policy.EscalationDeadline(deadline)
+}
+
+// next decides which nudge, if any, is due for an overdue assignment
+// @return int - The level of the nudge, 0 if none is due
+// @return []string - The recipients, empty when the level should be recorded without sending
+func (n *Nudger) next(a *domain.FeedbackAssignment, now time.Time) (int, []string) {
+	switch {
+	case a.NudgeLevel < LevelInterviewer:
+		return LevelInterviewer, nonEmpty(a.Panelist.Email)
+	case a.NudgeLevel < LevelHiringManager && now.After(n.escalationDeadline(a.Deadline)):
+		if n.jobs == nil {
+			return LevelHiringManager, nil
+		}
+		job, err := n.jobs.GetJob(context.Background(), a.Interview.JobID)
+		if err != nil {
+			slog.Warn("Failed to look up the hiring manager", "component", "nudge", "job_id", a.Interview.JobID, "error", err)
+			return 0, nil // Retried on the next run
+		}
+		if job.HiringManagerEmail == "" {
+			slog.Warn("Job has no hiring manager to escalate to", "component", "nudge", "job_id", a.Interview.JobID)
+			return LevelHiringManager, nil
+		}
+		return LevelHiringManager, nonEmpty(job.HiringManagerEmail, a.Panelist.Email)
+	}
+	return 0, nil
+}
+
+// send renders and delivers a nudge
+func (n *Nudger) send(a *domain.FeedbackAssignment, level int, to []string) error {
+	name := notification.TemplateFeedbackOverdue
+	if level == LevelHiringManager {
+		name = notification.TemplateFeedbackEscalated
+	}
+	subject, body, err := n.templates.RenderNamed(name, a)
+	if err != nil {
+		return err
+	}
+	return n.mailer.Send(notification.Message{From: n.from, To: to, Subject: subject, Body: body})
+}
+
+// nonEmpty returns the non-empty addresses
+func nonEmpty(addrs ...string) []string {
+	var out []string
+	for _, addr := range addrs {
+		if addr != "" {
+			out = append(out, addr)
+		}
+	}
+	return out
+}
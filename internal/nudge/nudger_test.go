@@ -0,0 +1,103 @@
+package nudge
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/notification"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/internal/sla"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunOnce_EscalatesOverdueFeedback(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 20, 12, 0, 0, 0, time.UTC) // Friday
+	templates, err := notification.LoadTemplates()
+	require.NoError(t, err)
+	mockFeedback := new(service.MockFeedbackService)
+	mockMailer := new(notification.MockMailer)
+	mockLock := new(leader.MockLock)
+	jobs := client.NewFakeJobClient(&client.Job{ID: 201, HiringManagerEmail: "manager@example.com"})
+	nudger := NewNudger(mockFeedback, jobs, mockMailer, templates, "recruiting@example.com", sla.DefaultPolicy(), mockLock, time.Minute)
+	nudger.now = func() time.Time { return now }
+
+	// Mock data
+	interview := domain.Interview{ID: 7, JobID: 201, InterviewDate: now.Add(-100 * time.Hour)}
+	fresh := &domain.FeedbackAssignment{ // Just overdue, never nudged
+		Interview: interview,
+		Panelist:  domain.Panelist{ID: 1, Name: "Alice", Email: "alice@example.com"},
+		Deadline:  now.Add(-time.Hour),
+	}
+	stale := &domain.FeedbackAssignment{ // Overdue past the escalation time, interviewer already nudged
+		Interview:  interview,
+		Panelist:   domain.Panelist{ID: 2, Name: "Bob", Email: "bob@example.com"},
+		Deadline:   now.Add(-48 * time.Hour),
+		NudgeLevel: LevelInterviewer,
+	}
+	waiting := &domain.FeedbackAssignment{ // Interviewer nudged, escalation not due yet
+		Interview:  interview,
+		Panelist:   domain.Panelist{ID: 3, Name: "Carol", Email: "carol@example.com"},
+		Deadline:   now.Add(-2 * time.Hour),
+		NudgeLevel: LevelInterviewer,
+	}
+
+	// Mock behavior
+	var messages []notification.Message
+	mockLock.On("Acquire").Return(true, nil)
+	mockFeedback.On("OverdueFeedback", now).Return([]*domain.FeedbackAssignment{fresh, stale, waiting}, nil)
+	mockMailer.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+		messages = append(messages, args.Get(0).(notification.Message))
+	}).Return(nil)
+	mockFeedback.On("RecordNudge", 1, LevelInterviewer, now).Return(nil)
+	mockFeedback.On("RecordNudge", 2, LevelHiringManager, now).Return(nil)
+
+	// Execute
+	sent, err := nudger.RunOnce()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	require.Len(t, messages, 2)
+	assert.Equal(t, []string{"alice@example.com"}, messages[0].To)
+	assert.Equal(t, "Feedback overdue: interview for job #201", messages[0].Subject)
+	assert.Equal(t, []string{"manager@example.com", "bob@example.com"}, messages[1].To)
+	assert.Contains(t, messages[1].Body, "Bob <bob@example.com>")
+	mockFeedback.AssertExpectations(t)
+}
+
+func TestRunOnce_DoesNotRecordFailedNudges(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 20, 12, 0, 0, 0, time.UTC)
+	templates, err := notification.LoadTemplates()
+	require.NoError(t, err)
+	mockFeedback := new(service.MockFeedbackService)
+	mockMailer := new(notification.MockMailer)
+	nudger := NewNudger(mockFeedback, nil, mockMailer, templates, "recruiting@example.com", sla.DefaultPolicy(), nil, time.Minute)
+	nudger.now = func() time.Time { return now }
+
+	// Mock data
+	overdue := &domain.FeedbackAssignment{
+		Interview: domain.Interview{ID: 7, JobID: 201, InterviewDate: now.Add(-100 * time.Hour)},
+		Panelist:  domain.Panelist{ID: 1, Name: "Alice", Email: "alice@example.com"},
+		Deadline:  now.Add(-time.Hour),
+	}
+
+	// Mock behavior
+	mockFeedback.On("OverdueFeedback", now).Return([]*domain.FeedbackAssignment{overdue}, nil)
+	mockMailer.On("Send", mock.Anything).Return(errors.New("connection refused"))
+
+	// Execute
+	sent, err := nudger.RunOnce()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	mockFeedback.AssertNotCalled(t, "RecordNudge", mock.Anything, mock.Anything, mock.Anything)
+}
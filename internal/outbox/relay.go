@@ -0,0 +1,196 @@
+package outbox
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics exported on /metrics
+var (
+	backlogGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_backlog",
+		Help: "Messages waiting to be relayed.",
+	})
+	publishedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_published_total",
+		Help: "Messages relayed since start.",
+	})
+	failedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_failed_total",
+		Help: "Failed relay attempts since start.",
+	})
+	abandonedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_abandoned_total",
+		Help: "Messages given up on after too many failed relay attempts since start.",
+	})
+)
+
+// Config holds the polling and retry settings of a Relay
+type Config struct {
+	PollInterval time.Duration // How often the outbox is drained
+	BatchSize    int           // Maximum number of messages relayed per poll
+	MaxAttempts  int           // Failed attempts after which a message is abandoned
+	RetryDelay   time.Duration // Wait before the first retry, doubled after every further failure
+	MaxDelay     time.Duration // Upper bound of the wait between retries
+}
+
+// DefaultConfig returns the settings used when none are configured
+// With these settings a message is retried for about eight hours before it is abandoned.
+// @return Config - The default settings
+func DefaultConfig() Config {
+	return Config{PollInterval: time.Second, BatchSize: 100, MaxAttempts: 20, RetryDelay: time.Second, MaxDelay: time.Hour}
+}
+
+// retryDelay returns the wait before the next attempt of a message
+// @param attempts int - The number of failed attempts, including the one that just failed
+// @return time.Duration - RetryDelay doubled for every earlier failure, at most MaxDelay
+func (c Config) retryDelay(attempts int) time.Duration {
+	delay := c.RetryDelay
+	for i := 1; i < attempts && delay < c.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, c.MaxDelay)
+}
+
+// Relay drains the outbox table into a publisher
+// Messages are published in the order they were written and only marked as published once
+// the publisher accepts them, so delivery is at-least-once: a crash between publishing and
+// acknowledging publishes the message again. When a message fails, later messages of the
+// same interview are held back until it succeeds, preserving per-interview ordering. A failed
+// message is retried with a growing delay and abandoned after MaxAttempts, which releases the
+// messages of its interview written after it.
+// A single relay must run per database, since several would interleave their batches and publish
+// every message more than once; when a lock is given, only the replica holding it relays.
+type Relay struct {
+	repo      repository.OutboxRepository // Storage of the outbox table
+	publisher event.Publisher             // Destination of the relayed events
+	lock      leader.Lock                 // Elects the relaying replica, nil when running a single instance
+	cfg       Config                      // Polling settings
+	now       func() time.Time            // Clock, replaced in tests
+	stop      chan struct{}               // Closed to stop the background loop
+	done      chan struct{}               // Closed once the background loop has exited
+	once      sync.Once                   // Guards stop against double close
+}
+
+// NewRelay creates a new Relay instance
+// @param repo repository.OutboxRepository - The repository reading the outbox table
+// @param publisher event.Publisher - The publisher receiving the events
+// @param lock leader.Lock - The lock electing the relaying replica, nil to always relay
+// @param cfg Config - The polling settings
+// @return *Relay - A relay that is not yet running
+func NewRelay(repo repository.OutboxRepository, publisher event.Publisher, lock leader.Lock, cfg Config) *Relay {
+	return &Relay{
+		repo:      repo,
+		publisher: publisher,
+		lock:      lock,
+		cfg:       cfg,
+		now:       time.Now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the relay loop in the background until Stop is called
+func (r *Relay) Start() {
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := r.Drain(); err != nil {
+				slog.Error("Failed to drain the outbox", "component", "outbox", "error", err)
+			}
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the relay loop to exit, waits for the current batch to finish and gives up leadership
+func (r *Relay) Stop() {
+	r.once.Do(func() { close(r.stop) })
+	<-r.done
+	if r.lock != nil {
+		if err := r.lock.Release(); err != nil {
+			slog.Error("Failed to release the leader lock", "component", "outbox", "error", err)
+		}
+	}
+}
+
+// Drain relays one batch of pending messages and refreshes the backlog metric, if this replica is the leader
+// @return int - The number of messages published
+// @return error - An error if leadership or the pending messages could not be checked
+func (r *Relay) Drain() (int, error) {
+	if r.lock != nil {
+		leading, err := r.lock.Acquire()
+		if err != nil || !leading {
+			return 0, err
+		}
+	}
+
+	now := r.now().UTC()
+	messages, err := r.repo.FindPending(r.cfg.BatchSize, now)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	blocked := make(map[int]bool) // Interviews with a failed message earlier in this batch
+	for _, m := range messages {
+		if blocked[m.InterviewID] {
+			continue
+		}
+
+		if err := r.publisher.Publish(m.Event); err != nil {
+			blocked[m.InterviewID] = true
+			failedTotal.Inc()
+			slog.Error("Failed to publish outbox message", "component", "outbox", "message_id", m.ID, "event_type", m.Event.Type, "error", err)
+			r.recordFailure(m, err, now)
+			continue
+		}
+
+		if err := r.repo.MarkPublished(m.ID, now); err != nil {
+			// The message stays pending and will be published again
+			blocked[m.InterviewID] = true
+			slog.Error("Failed to acknowledge outbox message", "component", "outbox", "message_id", m.ID, "error", err)
+			continue
+		}
+		published++
+		publishedTotal.Inc()
+	}
+
+	if backlog, err := r.repo.CountPending(); err == nil {
+		backlogGauge.Set(float64(backlog))
+	}
+	return published, nil
+}
+
+// recordFailure schedules the next attempt of a message that failed, or abandons it after MaxAttempts
+// @param m *domain.OutboxMessage - The message that failed
+// @param failure error - The error returned by the publisher
+// @param now time.Time - The time of the attempt
+func (r *Relay) recordFailure(m *domain.OutboxMessage, failure error, now time.Time) {
+	attempts := m.Attempts + 1
+	if attempts >= r.cfg.MaxAttempts {
+		abandonedTotal.Inc()
+		slog.Error("Abandoned outbox message after too many attempts", "component", "outbox", "message_id", m.ID, "event_type", m.Event.Type, "attempts", attempts)
+		if err := r.repo.MarkAbandoned(m.ID, failure.Error(), now); err != nil {
+			slog.Error("Failed to record abandonment of outbox message", "component", "outbox", "message_id", m.ID, "error", err)
+		}
+		return
+	}
+	if err := r.repo.MarkFailed(m.ID, failure.Error(), now.Add(r.cfg.retryDelay(attempts))); err != nil {
+		slog.Error("Failed to record failure of outbox message", "component", "outbox", "message_id", m.ID, "error", err)
+	}
+}
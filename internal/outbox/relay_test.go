@@ -0,0 +1,198 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDrain_PublishesInOrderAndAcknowledges(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	relay := newTestRelay(mockRepo, mockPublisher)
+	messages := []*domain.OutboxMessage{mockMessage(1, 7, "a"), mockMessage(2, 8, "b")}
+
+	// Mock behavior
+	var order []string
+	mockRepo.On("FindPending", 100, testNow).Return(messages, nil)
+	mockPublisher.On("Publish", mock.Anything).Run(func(args mock.Arguments) {
+		order = append(order, args.Get(0).(domain.Event).ID)
+	}).Return(nil)
+	mockRepo.On("MarkPublished", 1, testNow).Return(nil)
+	mockRepo.On("MarkPublished", 2, testNow).Return(nil)
+	mockRepo.On("CountPending").Return(0, nil)
+
+	// Execute
+	published, err := relay.Drain()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Equal(t, []string{"a", "b"}, order)
+	assert.Equal(t, float64(0), testutil.ToFloat64(backlogGauge))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDrain_FailureHoldsBackSameInterview(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	relay := newTestRelay(mockRepo, mockPublisher)
+	messages := []*domain.OutboxMessage{
+		mockMessage(1, 7, "created"),
+		mockMessage(2, 8, "other"),
+		mockMessage(3, 7, "cancelled"),
+	}
+
+	// Mock behavior
+	mockRepo.On("FindPending", 100, testNow).Return(messages, nil)
+	mockPublisher.On("Publish", messages[0].Event).Return(errors.New("webhooks unavailable"))
+	mockPublisher.On("Publish", messages[1].Event).Return(nil)
+	mockRepo.On("MarkFailed", 1, "webhooks unavailable", testNow.Add(time.Second)).Return(nil)
+	mockRepo.On("MarkPublished", 2, testNow).Return(nil)
+	mockRepo.On("CountPending").Return(2, nil)
+
+	// Execute
+	published, err := relay.Drain()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, float64(2), testutil.ToFloat64(backlogGauge))
+	mockPublisher.AssertNotCalled(t, "Publish", messages[2].Event)
+	mockRepo.AssertNotCalled(t, "MarkPublished", 3, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDrain_AbandonsAfterMaxAttempts(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	relay := newTestRelay(mockRepo, mockPublisher)
+	retried, exhausted := mockMessage(1, 7, "a"), mockMessage(2, 8, "b")
+	retried.Attempts, exhausted.Attempts = 3, relay.cfg.MaxAttempts-1
+	before := testutil.ToFloat64(abandonedTotal)
+
+	// Mock behavior
+	mockRepo.On("FindPending", 100, testNow).Return([]*domain.OutboxMessage{retried, exhausted}, nil)
+	mockPublisher.On("Publish", mock.Anything).Return(errors.New("webhooks unavailable"))
+	mockRepo.On("MarkFailed", 1, "webhooks unavailable", testNow.Add(8*time.Second)).Return(nil)
+	mockRepo.On("MarkAbandoned", 2, "webhooks unavailable", testNow).Return(nil)
+	mockRepo.On("CountPending").Return(1, nil)
+
+	// Execute
+	published, err := relay.Drain()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+	assert.Equal(t, before+1, testutil.ToFloat64(abandonedTotal))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestConfig_RetryDelay(t *testing.T) {
+	// Setup
+	cfg := DefaultConfig()
+
+	// Execute
+	var delays []time.Duration
+	for _, attempts := range []int{1, 3, 12, 13, cfg.MaxAttempts} {
+		delays = append(delays, cfg.retryDelay(attempts))
+	}
+
+	// Assertions
+	assert.Equal(t, []time.Duration{time.Second, 4 * time.Second, 2048 * time.Second, time.Hour, time.Hour}, delays)
+}
+
+func TestDrain_FindPendingError(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	relay := newTestRelay(mockRepo, mockPublisher)
+
+	// Mock behavior
+	mockRepo.On("FindPending", 100, testNow).Return(nil, errors.New("database error"))
+
+	// Execute
+	_, err := relay.Drain()
+
+	// Assertions
+	assert.EqualError(t, err, "database error")
+	mockPublisher.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func TestDrain_SkipsWithoutLeadership(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	mockLock := new(leader.MockLock)
+	relay := NewRelay(mockRepo, mockPublisher, mockLock, DefaultConfig())
+
+	// Mock behavior
+	mockLock.On("Acquire").Return(false, nil)
+
+	// Execute
+	published, err := relay.Drain()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Zero(t, published)
+	mockRepo.AssertNotCalled(t, "FindPending", mock.Anything, mock.Anything)
+	mockPublisher.AssertNotCalled(t, "Publish", mock.Anything)
+}
+
+func TestDrain_RelaysAsLeader(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	mockLock := new(leader.MockLock)
+	relay := NewRelay(mockRepo, mockPublisher, mockLock, DefaultConfig())
+	relay.now = func() time.Time { return testNow }
+	message := mockMessage(1, 7, "a")
+
+	// Mock behavior
+	mockLock.On("Acquire").Return(true, nil)
+	mockRepo.On("FindPending", 100, testNow).Return([]*domain.OutboxMessage{message}, nil)
+	mockPublisher.On("Publish", message.Event).Return(nil)
+	mockRepo.On("MarkPublished", 1, testNow).Return(nil)
+	mockRepo.On("CountPending").Return(0, nil)
+
+	// Execute
+	published, err := relay.Drain()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 1, published)
+	mockRepo.AssertExpectations(t)
+}
+
+var testNow = time.Date(2024, time.December, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestRelay provides a relay with a fixed clock for testing
+func newTestRelay(repo repository.OutboxRepository, publisher event.Publisher) *Relay {
+	r := NewRelay(repo, publisher, nil, DefaultConfig())
+	r.now = func() time.Time { return testNow }
+	return r
+}
+
+// mockMessage provides a pending outbox message for testing
+func mockMessage(id, interviewID int, eventID string) *domain.OutboxMessage {
+	return &domain.OutboxMessage{
+		ID:          id,
+		InterviewID: interviewID,
+		Event: domain.Event{
+			ID:        eventID,
+			Type:      domain.EventInterviewCreated,
+			Interview: domain.Interview{ID: interviewID},
+		},
+	}
+}
@@ -0,0 +1,115 @@
+package outbox
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+)
+
+// tailLookback is how many IDs below the latest message seen are read again on every poll
+// IDs are handed out when a transaction inserts its message but become visible when it commits, so a
+// message can show up after one with a higher ID; rereading the last IDs picks it up.
+const tailLookback = 100
+
+// Tail follows the outbox table and publishes every message written from the moment it starts
+// Unlike the Relay, every replica runs a Tail, so events reach subscribers of any replica, such as gRPC
+// watchers, whichever replica relays them. Messages are published as soon as they are committed,
+// whether or not the relay has published them yet, and at most once per Tail; a message committed
+// more than tailLookback IDs late, or while the database cannot be reached, is skipped.
+type Tail struct {
+	repo      repository.OutboxRepository // Storage of the outbox table
+	publisher event.Publisher             // Destination of the messages, e.g. the gRPC broadcaster
+	cfg       Config                      // Polling settings
+	started   bool                        // Whether the position in the table has been set
+	from      int                         // ID of the latest message written before the tail started
+	cursor    int                         // Highest message ID seen
+	seen      map[int]bool                // IDs seen within tailLookback of the cursor
+	stop      chan struct{}               // Closed to stop the background loop
+	done      chan struct{}               // Closed once the background loop has exited
+	once      sync.Once                   // Guards stop against double close
+}
+
+// NewTail creates a new Tail instance
+// @param repo repository.OutboxRepository - The repository reading the outbox table
+// @param publisher event.Publisher - The publisher receiving the events
+// @param cfg Config - The polling settings
+// @return *Tail - A tail that is not yet running
+func NewTail(repo repository.OutboxRepository, publisher event.Publisher, cfg Config) *Tail {
+	return &Tail{
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+		seen:      make(map[int]bool),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start runs the tail loop in the background until Stop is called
+func (t *Tail) Start() {
+	go func() {
+		defer close(t.done)
+		ticker := time.NewTicker(t.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := t.Poll(); err != nil {
+				slog.Error("Failed to follow the outbox", "component", "outbox", "error", err)
+			}
+			select {
+			case <-t.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the tail loop to exit and waits for the current poll to finish
+func (t *Tail) Stop() {
+	t.once.Do(func() { close(t.stop) })
+	<-t.done
+}
+
+// Poll publishes the messages written since the previous poll
+// The first poll only records the end of the table, so messages written before the tail started are skipped.
+// @return int - The number of messages published
+// @return error - An error if the messages could not be loaded
+func (t *Tail) Poll() (int, error) {
+	if !t.started {
+		last, err := t.repo.LastID()
+		if err != nil {
+			return 0, err
+		}
+		t.from, t.cursor, t.started = last, last, true
+		return 0, nil
+	}
+
+	messages, err := t.repo.FindAfter(max(t.cursor-tailLookback, t.from), tailLookback+t.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, m := range messages {
+		if t.seen[m.ID] {
+			continue
+		}
+		t.seen[m.ID] = true
+		t.cursor = max(t.cursor, m.ID)
+		if err := t.publisher.Publish(m.Event); err != nil {
+			slog.Warn("Failed to publish followed outbox message", "component", "outbox", "message_id", m.ID, "error", err)
+			continue
+		}
+		published++
+	}
+
+	for id := range t.seen {
+		if id <= t.cursor-tailLookback {
+			delete(t.seen, id)
+		}
+	}
+	return published, nil
+}
@@ -0,0 +1,91 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTail_PublishesMessagesWrittenAfterStart(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	tail := NewTail(mockRepo, mockPublisher, DefaultConfig())
+	first, second := mockMessage(41, 7, "a"), mockMessage(42, 8, "b")
+
+	// Mock behavior
+	mockRepo.On("LastID").Return(40, nil)
+	mockRepo.On("FindAfter", 40, 200).Return([]*domain.OutboxMessage{first, second}, nil).Once()
+	mockRepo.On("FindAfter", 40, 200).Return([]*domain.OutboxMessage{first, second}, nil).Once()
+	mockPublisher.On("Publish", first.Event).Return(nil).Once()
+	mockPublisher.On("Publish", second.Event).Return(nil).Once()
+
+	// Execute
+	started, startErr := tail.Poll()
+	published, err := tail.Poll()
+	again, againErr := tail.Poll()
+
+	// Assertions
+	assert.NoError(t, startErr)
+	assert.Zero(t, started)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.NoError(t, againErr)
+	assert.Zero(t, again)
+	mockRepo.AssertExpectations(t)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestTail_PublishesMessagesCommittedOutOfOrder(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	tail := NewTail(mockRepo, mockPublisher, DefaultConfig())
+	early, late := mockMessage(300, 7, "early"), mockMessage(299, 8, "late")
+
+	// Mock behavior
+	mockRepo.On("LastID").Return(250, nil)
+	mockRepo.On("FindAfter", 250, 200).Return([]*domain.OutboxMessage{early}, nil).Once()
+	mockRepo.On("FindAfter", 250, 200).Return([]*domain.OutboxMessage{late, early}, nil).Once()
+	mockPublisher.On("Publish", early.Event).Return(nil).Once()
+	mockPublisher.On("Publish", late.Event).Return(nil).Once()
+
+	// Execute
+	_, _ = tail.Poll()
+	first, _ := tail.Poll()
+	second, err := tail.Poll()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 1, second)
+	mockPublisher.AssertExpectations(t)
+}
+
+func TestTail_StartError(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockOutboxRepository)
+	mockPublisher := new(event.MockPublisher)
+	tail := NewTail(mockRepo, mockPublisher, DefaultConfig())
+
+	// Mock behavior
+	mockRepo.On("LastID").Return(0, errors.New("database error")).Once()
+	mockRepo.On("LastID").Return(0, nil).Once()
+	mockRepo.On("FindAfter", 0, 200).Return([]*domain.OutboxMessage{}, nil)
+
+	// Execute
+	_, err := tail.Poll()
+	_, _ = tail.Poll()
+	_, retried := tail.Poll()
+
+	// Assertions
+	assert.EqualError(t, err, "database error")
+	assert.NoError(t, retried)
+	mockPublisher.AssertNotCalled(t, "Publish", mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
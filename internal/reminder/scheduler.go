@@ -0,0 +1,206 @@
+package reminder
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/notification"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// sentTotal counts reminders sent since start, exported on /metrics
+var sentTotal = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "reminders_sent_total",
+	Help: "Interview reminders sent since start.",
+})
+
+// Config holds the settings of a Scheduler
+type Config struct {
+	Offsets      []time.Duration // How long before an interview reminders are sent, e.g. 24h and 1h
+	PollInterval time.Duration   // How often due reminders are looked up
+	BatchSize    int             // Maximum number of interviews reminded per offset and poll
+}
+
+// DefaultConfig returns the settings used when none are configured
+// @return Config - The default settings
+func DefaultConfig() Config {
+	return Config{
+		Offsets:      []time.Duration{24 * time.Hour, time.Hour},
+		PollInterval: time.Minute,
+		BatchSize:    100,
+	}
+}
+
+// ParseOffsets parses a comma-separated list of reminder offsets such as "24h,1h"
+// @param value string - The list of Go durations
+// @return []time.Duration - The offsets, an empty slice for an empty value
+// @return error - An error if an offset is not a positive duration
+func ParseOffsets(value string) ([]time.Duration, error) {
+	var offsets []time.Duration
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		offset, err := time.ParseDuration(part)
+		if err != nil {
+			return nil, err
+		}
+		if offset < time.Minute {
+			return nil, fmt.Errorf("reminder offset %s must be at least one minute", part)
+		}
+		offsets = append(offsets, offset)
+	}
+	return offsets, nil
+}
+
+// Scheduler emails participants ahead of their interviews
+// Offsets are processed from the shortest to the longest. An interview reminded for one offset
+// is also recorded as reminded for every longer offset, so an interview booked at short notice
+// receives a single reminder rather than one per offset. Reminders are sent synchronously and only
+// recorded once the mail server has accepted them, so a failed send is retried on the next poll.
+// When a lock is given, only the replica holding it sends reminders.
+type Scheduler struct {
+	repo      repository.ReminderRepository // Storage of sent reminders
+	mailer    notification.Mailer           // Transport used to deliver the reminders
+	templates *notification.Templates       // Templates used to render the reminders
+	from      string                        // Sender address
+	lock      leader.Lock                   // Elects the sending replica, nil when running a single instance
+	mu        sync.RWMutex                  // Guards cfg.Offsets against SetOffsets during runs
+	cfg       Config                        // Scheduler settings
+	now       func() time.Time              // Clock, replaced in tests
+	stop      chan struct{}                 // Closed to stop the background loop
+	done      chan struct{}                 // Closed once the background loop has exited
+	once      sync.Once                     // Guards stop against double close
+}
+
+// NewScheduler creates a new Scheduler instance
+// @param repo repository.ReminderRepository - The repository tracking sent reminders
+// @param mailer notification.Mailer - The transport used to deliver the reminders
+// @param templates *notification.Templates - The templates used to render the reminders
+// @param from string - The sender address
+// @param lock leader.Lock - The lock electing the sending replica, nil to always send
+// @param cfg Config - The scheduler settings
+// @return *Scheduler - A scheduler that is not yet running
+func NewScheduler(repo repository.ReminderRepository, mailer notification.Mailer, templates *notification.Templates,
+	from string, lock leader.Lock, cfg Config) *Scheduler {
+	cfg.Offsets = sortedOffsets(cfg.Offsets)
+
+	return &Scheduler{
+		repo:      repo,
+		mailer:    mailer,
+		templates: templates,
+		from:      from,
+		lock:      lock,
+		cfg:       cfg,
+		now:       time.Now,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// sortedOffsets returns a copy of offsets sorted from the shortest to the longest
+func sortedOffsets(offsets []time.Duration) []time.Duration {
+	sorted := append([]time.Duration(nil), offsets...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
+
+// SetOffsets replaces the reminder offsets, e.g. when the configuration is reloaded
+// Reminders already recorded for an offset are not sent again; an empty list pauses reminders.
+// @param offsets []time.Duration - The new offsets, applied from the next run
+func (s *Scheduler) SetOffsets(offsets []time.Duration) {
+	sorted := sortedOffsets(offsets)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg.Offsets = sorted
+}
+
+// Start runs the scheduler loop in the background until Stop is called
+func (s *Scheduler) Start() {
+	go func() {
+		defer close(s.done)
+		ticker := time.NewTicker(s.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := s.RunOnce(); err != nil {
+				slog.Error("Failed to send reminders", "component", "reminder", "error", err)
+			}
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the scheduler loop to exit, waits for the current run and gives up leadership
+func (s *Scheduler) Stop() {
+	s.once.Do(func() { close(s.stop) })
+	<-s.done
+	if s.lock != nil {
+		if err := s.lock.Release(); err != nil {
+			slog.Error("Failed to release the leader lock", "component", "reminder", "error", err)
+		}
+	}
+}
+
+// RunOnce sends the reminders that are due, if this replica is the leader
+// @return int - The number of reminders sent
+// @return error - An error if leadership or due reminders could not be checked
+func (s *Scheduler) RunOnce() (int, error) {
+	s.mu.RLock()
+	offsets := s.cfg.Offsets
+	s.mu.RUnlock()
+	if len(offsets) == 0 {
+		return 0, nil // Paused, leadership is left to other replicas
+	}
+	if s.lock != nil {
+		leading, err := s.lock.Acquire()
+		if err != nil || !leading {
+			return 0, err
+		}
+	}
+
+	now := s.now().UTC()
+	sent := 0
+	for i, offset := range offsets {
+		interviews, err := s.repo.FindDue(offset, now, s.cfg.BatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		for _, interview := range interviews {
+			msg, err := notification.NewMessage(s.templates, s.from, domain.Event{
+				Type:       domain.EventInterviewReminder,
+				Interview:  *interview,
+				OccurredAt: now,
+			})
+			if err != nil {
+				slog.Error("Failed to render reminder", "component", "reminder", "interview_id", interview.ID, "error", err)
+				continue
+			}
+			if len(msg.To) > 0 {
+				if err := s.mailer.Send(msg); err != nil {
+					slog.Error("Failed to send reminder", "component", "reminder", "interview_id", interview.ID, "error", err)
+					continue // Not recorded, so retried on the next poll
+				}
+				sent++
+				sentTotal.Inc()
+			}
+			if err := s.repo.MarkSent(interview, offsets[i:], now); err != nil {
+				slog.Error("Failed to record reminder", "component", "reminder", "interview_id", interview.ID, "error", err)
+			}
+		}
+	}
+	return sent, nil
+}
@@ -0,0 +1,145 @@
+package reminder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/notification"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunOnce_SendsDueReminders(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 30, 14, 0, 0, 0, time.UTC)
+	mockRepo := new(repository.MockReminderRepository)
+	mockMailer := new(notification.MockMailer)
+	mockLock := new(leader.MockLock)
+	cfg := DefaultConfig() // 24h and 1h
+	scheduler := newTestScheduler(t, mockRepo, mockMailer, mockLock, cfg)
+	scheduler.now = func() time.Time { return now }
+
+	// Mock data
+	soon := &domain.Interview{ID: 1, JobID: 201, CandidateEmail: "candidate@example.com", InterviewDate: now.Add(45 * time.Minute)}
+	tomorrow := &domain.Interview{ID: 2, JobID: 201, CandidateEmail: "candidate@example.com", InterviewDate: now.Add(20 * time.Hour)}
+	unreachable := &domain.Interview{ID: 3, JobID: 201, InterviewDate: now.Add(20 * time.Hour)} // No addresses
+
+	// Mock behavior
+	mockLock.On("Acquire").Return(true, nil)
+	mockRepo.On("FindDue", time.Hour, now, cfg.BatchSize).Return([]*domain.Interview{soon}, nil)
+	mockRepo.On("FindDue", 24*time.Hour, now, cfg.BatchSize).Return([]*domain.Interview{tomorrow, unreachable}, nil)
+	mockRepo.On("MarkSent", soon, []time.Duration{time.Hour, 24 * time.Hour}, now).Return(nil)
+	mockRepo.On("MarkSent", tomorrow, []time.Duration{24 * time.Hour}, now).Return(nil)
+	mockRepo.On("MarkSent", unreachable, []time.Duration{24 * time.Hour}, now).Return(nil)
+	mockMailer.On("Send", mock.MatchedBy(func(m notification.Message) bool {
+		return m.From == "recruiting@example.com" && len(m.To) == 1 && len(m.Attachments) == 0
+	})).Return(nil)
+
+	// Execute
+	sent, err := scheduler.RunOnce()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, sent)
+	mockRepo.AssertExpectations(t)
+	mockMailer.AssertNumberOfCalls(t, "Send", 2)
+}
+
+func TestRunOnce_SkipsWhenNotLeader(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockReminderRepository)
+	mockMailer := new(notification.MockMailer)
+	mockLock := new(leader.MockLock)
+	scheduler := newTestScheduler(t, mockRepo, mockMailer, mockLock, DefaultConfig())
+
+	// Mock behavior
+	mockLock.On("Acquire").Return(false, nil).Once()
+	mockLock.On("Acquire").Return(false, errors.New("connection refused")).Once()
+
+	// Execute
+	sent, err := scheduler.RunOnce()
+	_, lockErr := scheduler.RunOnce()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	assert.EqualError(t, lockErr, "connection refused")
+	mockRepo.AssertNotCalled(t, "FindDue", mock.Anything, mock.Anything, mock.Anything)
+	mockMailer.AssertNotCalled(t, "Send", mock.Anything)
+}
+
+func TestRunOnce_DoesNotRecordUnsentReminders(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 30, 14, 0, 0, 0, time.UTC)
+	mockRepo := new(repository.MockReminderRepository)
+	mockMailer := new(notification.MockMailer)
+	scheduler := newTestScheduler(t, mockRepo, mockMailer, nil, Config{Offsets: []time.Duration{time.Hour}, BatchSize: 10})
+	scheduler.now = func() time.Time { return now }
+
+	// Mock data
+	interview := &domain.Interview{ID: 1, JobID: 201, CandidateEmail: "candidate@example.com", InterviewDate: now.Add(30 * time.Minute)}
+
+	// Mock behavior
+	mockRepo.On("FindDue", time.Hour, now, 10).Return([]*domain.Interview{interview}, nil)
+	mockMailer.On("Send", mock.Anything).Return(errors.New("connection refused"))
+
+	// Execute
+	sent, err := scheduler.RunOnce()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 0, sent)
+	mockRepo.AssertNotCalled(t, "MarkSent", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetOffsets(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 30, 14, 0, 0, 0, time.UTC)
+	mockRepo := new(repository.MockReminderRepository)
+	mockLock := new(leader.MockLock)
+	scheduler := newTestScheduler(t, mockRepo, new(notification.MockMailer), mockLock, Config{BatchSize: 10})
+	scheduler.now = func() time.Time { return now }
+
+	// Mock behavior
+	mockLock.On("Acquire").Return(true, nil).Once()
+	mockRepo.On("FindDue", 30*time.Minute, now, 10).Return([]*domain.Interview{}, nil)
+	mockRepo.On("FindDue", 2*time.Hour, now, 10).Return([]*domain.Interview{}, nil)
+
+	// Execute
+	paused, err := scheduler.RunOnce()
+	scheduler.SetOffsets([]time.Duration{2 * time.Hour, 30 * time.Minute})
+	_, resumedErr := scheduler.RunOnce()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 0, paused)
+	assert.NoError(t, resumedErr)
+	mockLock.AssertNumberOfCalls(t, "Acquire", 1) // Not taken while paused
+	mockRepo.AssertExpectations(t)
+}
+
+func TestParseOffsets(t *testing.T) {
+	// Execute
+	offsets, err := ParseOffsets("24h, 1h,30m")
+	empty, emptyErr := ParseOffsets("")
+	_, invalidErr := ParseOffsets("10s")
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{24 * time.Hour, time.Hour, 30 * time.Minute}, offsets)
+	assert.NoError(t, emptyErr)
+	assert.Empty(t, empty)
+	assert.Error(t, invalidErr)
+}
+
+// newTestScheduler provides a scheduler rendering the embedded templates for testing
+func newTestScheduler(t *testing.T, repo repository.ReminderRepository, mailer notification.Mailer, lock leader.Lock, cfg Config) *Scheduler {
+	templates, err := notification.LoadTemplates()
+	require.NoError(t, err)
+	return NewScheduler(repo, mailer, templates, "recruiting@example.com", lock, cfg)
+}
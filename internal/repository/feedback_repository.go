@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// FeedbackRepository defines methods for accessing interviewer feedback
+// Feedback is stored per panelist in the interview_panelists table and the nudges sent about
+// missing feedback in the feedback_nudges table.
+type FeedbackRepository interface {
+	// SubmitFeedback stores the feedback of a panelist
+	// The submission time of the first submission is kept when feedback is edited.
+	// @param interviewID int - The ID of the interview
+	// @param panelistID int - The ID of the panelist
+	// @param feedback string - The feedback
+	// @param submittedAt time.Time - The time of the submission
+	// @return error - ErrNotFound if the panelist is not part of the interview
+	SubmitFeedback(interviewID, panelistID int, feedback string, submittedAt time.Time) error
+
+	// FindAssignments retrieves the feedback owed for interviews held in a period
+	// Cancelled and no-show interviews are excluded since no feedback is expected for them.
+	// @param from time.Time - Start of the period, inclusive
+	// @param to time.Time - End of the period, exclusive
+	// @return []*domain.FeedbackAssignment - One assignment per panelist, without deadline
+	// @return error - An error if the query fails
+	FindAssignments(from, to time.Time) ([]*domain.FeedbackAssignment, error)
+
+	// MarkNudged records that a nudge about missing feedback was sent
+	// @param panelistID int - The ID of the panelist
+	// @param level int - The escalation level of the nudge
+	// @param sentAt time.Time - The time at which the nudge was sent
+	// @return error - An error if the query fails
+	MarkNudged(panelistID, level int, sentAt time.Time) error
+}
+
+type feedbackRepositoryImpl struct {
+	db     *sql.DB // Database connection instance
+	sqlite bool    // True on SQLite, which spells INSERT IGNORE as INSERT OR IGNORE
+}
+
+// NewFeedbackRepository creates a new FeedbackRepository instance
+// This constructor initializes the repository with the provided database connection.
+// @param db *sql.DB - The database connection used for executing queries
+// @return FeedbackRepository - An instance of the repository interface implementation
+func NewFeedbackRepository(db *sql.DB) FeedbackRepository {
+	return &feedbackRepositoryImpl{db: db}
+}
+
+// NewSQLiteFeedbackRepository creates a new FeedbackRepository instance backed by SQLite
+// SQLite shares the placeholders of MySQL, so the same queries run on the schema of the sqlite migrations.
+// @param db *sql.DB - The database connection used for executing queries, opened by db.Connect with a sqlite:// URL
+// @return FeedbackRepository - An instance of the repository interface implementation
+func NewSQLiteFeedbackRepository(db *sql.DB) FeedbackRepository {
+	return &feedbackRepositoryImpl{db: db, sqlite: true}
+}
+
+// SubmitFeedback stores the feedback of a panelist
+// Executes an UPDATE on interview_panelists scoped to the interview.
+// @param interviewID int - The ID of the interview
+// @param panelistID int - The ID of the panelist
+// @param feedback string - The feedback
+// @param submittedAt time.Time - The time of the submission
+// @return error - ErrNotFound if the panelist is not part of the interview
+func (r *feedbackRepositoryImpl) SubmitFeedback(interviewID, panelistID int, feedback string, submittedAt time.Time) error {
+	result, err := r.db.Exec(`UPDATE interview_panelists SET feedback = ?, feedback_submitted_at = COALESCE(feedback_submitted_at, ?)
+		WHERE id = ? AND interview_id = ?`, feedback, submittedAt.UTC(), panelistID, interviewID)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected > 0 {
+		return nil
+	}
+
+	// MySQL reports no affected rows when the stored values did not change
+	var count int
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM interview_panelists WHERE id = ? AND interview_id = ?`,
+		panelistID, interviewID).Scan(&count); err != nil {
+		return err
+	}
+	if count == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// FindAssignments retrieves the feedback owed for interviews held in a period
+// Executes a SELECT joining panelists to their interviews, with the highest nudge level sent so far.
+// @param from time.Time - Start of the period, inclusive
+// @param to time.Time - End of the period, exclusive
+// @return []*domain.FeedbackAssignment - One assignment per panelist
+// @return error - An error if the query execution fails
+func (r *feedbackRepositoryImpl) FindAssignments(from, to time.Time) ([]*domain.FeedbackAssignment, error) {
+	query := `SELECT i.` + strings.ReplaceAll(interviewColumns, ", ", ", i.") + `,
+			p.id, p.interview_id, p.name, p.email, COALESCE(p.feedback, ''), p.feedback_submitted_at,
+			(SELECT COALESCE(MAX(n.level), 0) FROM feedback_nudges n WHERE n.panelist_id = p.id)
+		FROM interview_panelists p JOIN interviews i ON i.id = p.interview_id
+		WHERE i.interview_date >= ? AND i.interview_date < ? AND i.status NOT IN (?, ?)
+		ORDER BY i.interview_date, p.id`
+	rows, err := r.db.Query(query, from.UTC(), to.UTC(), string(domain.StatusCancelled), string(domain.StatusNoShow))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*domain.FeedbackAssignment
+	for rows.Next() {
+		var a domain.FeedbackAssignment
+		var submittedAt sql.NullTime
+		dest := append(interviewDest(&a.Interview), &a.Panelist.ID, &a.Panelist.InterviewID, &a.Panelist.Name,
+			&a.Panelist.Email, &a.Panelist.Feedback, &submittedAt, &a.NudgeLevel)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		a.Interview.InterviewDate = a.Interview.InterviewDate.UTC() // SQLite returns dates in the local zone when its offset is UTC's
+		if submittedAt.Valid {
+			submitted := submittedAt.Time.UTC()
+			a.Panelist.FeedbackSubmittedAt = &submitted
+		}
+		assignments = append(assignments, &a)
+	}
+	return assignments, rows.Err()
+}
+
+// MarkNudged records that a nudge about missing feedback was sent
+// Executes an INSERT IGNORE, or INSERT OR IGNORE on SQLite, so recording the same nudge twice is harmless.
+// @param panelistID int - The ID of the panelist
+// @param level int - The escalation level of the nudge
+// @param sentAt time.Time - The time at which the nudge was sent
+// @return error - An error if the query execution fails
+func (r *feedbackRepositoryImpl) MarkNudged(panelistID, level int, sentAt time.Time) error {
+	_, err := r.db.Exec(insertIgnore(r.sqlite)+` INTO feedback_nudges (panelist_id, level, sent_at) VALUES (?, ?, ?)`,
+		panelistID, level, sentAt.UTC())
+	return err
+}
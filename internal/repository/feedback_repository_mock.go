@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFeedbackRepository is a mock implementation of FeedbackRepository for testing
+type MockFeedbackRepository struct {
+	mock.Mock
+}
+
+// SubmitFeedback mocks the SubmitFeedback method
+func (m *MockFeedbackRepository) SubmitFeedback(interviewID, panelistID int, feedback string, submittedAt time.Time) error {
+	args := m.Called(interviewID, panelistID, feedback, submittedAt)
+	return args.Error(0)
+}
+
+// FindAssignments mocks the FindAssignments method
+func (m *MockFeedbackRepository) FindAssignments(from, to time.Time) ([]*domain.FeedbackAssignment, error) {
+	args := m.Called(from, to)
+	if assignments, ok := args.Get(0).([]*domain.FeedbackAssignment); ok {
+		return assignments, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// MarkNudged mocks the MarkNudged method
+func (m *MockFeedbackRepository) MarkNudged(panelistID, level int, sentAt time.Time) error {
+	args := m.Called(panelistID, level, sentAt)
+	return args.Error(0)
+}
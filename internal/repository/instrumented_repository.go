@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// queryDuration times repository calls, exported on /metrics
+var queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "repository_query_duration_seconds",
+	Help:    "Duration of repository calls, including failed ones, by repository and operation.",
+	Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+}, []string{"repository", "operation"})
+
+// tracer starts the spans of repository calls
+var tracer = otel.Tracer("github.com/poolcamacho/interviews-service/internal/repository")
+
+// instrument starts the span of a repository call
+// ErrNotFound and ErrNotScheduled describe the data rather than a failure, so they do not mark the span as failed.
+// @param ctx context.Context - The context of the call, carrying the span of the caller
+// @param repository string - The repository, e.g. interviews
+// @param operation string - The method called, e.g. find_by_id
+// @return context.Context - The context to make the call with
+// @return func(err error) - Ends the span and records the duration, given the error returned by the call
+func instrument(ctx context.Context, repository, operation string) (context.Context, func(err error)) {
+	started := time.Now()
+	ctx, span := tracer.Start(ctx, repository+"."+operation, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.operation.name", operation)))
+	return ctx, func(err error) {
+		queryDuration.WithLabelValues(repository, operation).Observe(time.Since(started).Seconds())
+		if err != nil && !errors.Is(err, ErrNotFound) && !errors.Is(err, ErrNotScheduled) {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
+}
+
+type instrumentedInterviewRepository struct {
+	next InterviewRepository // Repository the calls are forwarded to
+}
+
+// InstrumentInterviewRepository traces the calls of an InterviewRepository and times them in repository_query_duration_seconds
+// @param next InterviewRepository - The repository the calls are forwarded to
+// @return InterviewRepository - A repository recording a span and the duration of every call
+func InstrumentInterviewRepository(next InterviewRepository) InterviewRepository {
+	return &instrumentedInterviewRepository{next: next}
+}
+
+// FindAll traces and times FindAll on the wrapped repository
+func (r *instrumentedInterviewRepository) FindAll(ctx context.Context) (interviews []*domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_all")
+	defer func() { end(err) }()
+	return r.next.FindAll(ctx)
+}
+
+// FindMatching traces and times FindMatching on the wrapped repository
+func (r *instrumentedInterviewRepository) FindMatching(ctx context.Context, filter domain.InterviewFilter) (interviews []*domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_matching")
+	defer func() { end(err) }()
+	return r.next.FindMatching(ctx, filter)
+}
+
+// Create traces and times Create on the wrapped repository
+func (r *instrumentedInterviewRepository) Create(ctx context.Context, interview *domain.Interview) (err error) {
+	ctx, end := instrument(ctx, "interviews", "create")
+	defer func() { end(err) }()
+	return r.next.Create(ctx, interview)
+}
+
+// FindByID traces and times FindByID on the wrapped repository
+func (r *instrumentedInterviewRepository) FindByID(ctx context.Context, id int) (interview *domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_by_id")
+	defer func() { end(err) }()
+	return r.next.FindByID(ctx, id)
+}
+
+// FindByIDs traces and times FindByIDs on the wrapped repository
+func (r *instrumentedInterviewRepository) FindByIDs(ctx context.Context, ids []int) (interviews []*domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_by_ids")
+	defer func() { end(err) }()
+	return r.next.FindByIDs(ctx, ids)
+}
+
+// Update traces and times Update on the wrapped repository
+func (r *instrumentedInterviewRepository) Update(ctx context.Context, interview *domain.Interview) (err error) {
+	ctx, end := instrument(ctx, "interviews", "update")
+	defer func() { end(err) }()
+	return r.next.Update(ctx, interview)
+}
+
+// FindUpcomingByCandidate traces and times FindUpcomingByCandidate on the wrapped repository
+func (r *instrumentedInterviewRepository) FindUpcomingByCandidate(ctx context.Context, candidateID int, from time.Time) (interviews []*domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_upcoming_by_candidate")
+	defer func() { end(err) }()
+	return r.next.FindUpcomingByCandidate(ctx, candidateID, from)
+}
+
+// FindUpcomingByJob traces and times FindUpcomingByJob on the wrapped repository
+func (r *instrumentedInterviewRepository) FindUpcomingByJob(ctx context.Context, jobID int, from time.Time) (interviews []*domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_upcoming_by_job")
+	defer func() { end(err) }()
+	return r.next.FindUpcomingByJob(ctx, jobID, from)
+}
+
+// Cancel traces and times Cancel on the wrapped repository
+func (r *instrumentedInterviewRepository) Cancel(ctx context.Context, interview *domain.Interview, reason string) (err error) {
+	ctx, end := instrument(ctx, "interviews", "cancel")
+	defer func() { end(err) }()
+	return r.next.Cancel(ctx, interview, reason)
+}
+
+// FlagOverdue traces and times FlagOverdue on the wrapped repository
+func (r *instrumentedInterviewRepository) FlagOverdue(ctx context.Context, endedBefore time.Time) (n int, err error) {
+	ctx, end := instrument(ctx, "interviews", "flag_overdue")
+	defer func() { end(err) }()
+	return r.next.FlagOverdue(ctx, endedBefore)
+}
+
+// FindByStatus traces and times FindByStatus on the wrapped repository
+func (r *instrumentedInterviewRepository) FindByStatus(ctx context.Context, status domain.InterviewStatus) (interviews []*domain.Interview, err error) {
+	ctx, end := instrument(ctx, "interviews", "find_by_status")
+	defer func() { end(err) }()
+	return r.next.FindByStatus(ctx, status)
+}
+
+// Resolve traces and times Resolve on the wrapped repository
+func (r *instrumentedInterviewRepository) Resolve(ctx context.Context, ids []int, status domain.InterviewStatus) (n int, err error) {
+	ctx, end := instrument(ctx, "interviews", "resolve")
+	defer func() { end(err) }()
+	return r.next.Resolve(ctx, ids, status)
+}
+
+type instrumentedTxManager struct {
+	next TxManager // Manager the units of work are forwarded to
+}
+
+// InstrumentTxManager traces and times units of work and the repository calls made inside them
+// Whole transactions are recorded as the transaction operation of the tx repository, retries included; the
+// spans of the calls made inside are children of the transaction span.
+// @param next TxManager - The manager the units of work are forwarded to
+// @return TxManager - A manager handing instrumented repositories to units of work
+func InstrumentTxManager(next TxManager) TxManager {
+	return &instrumentedTxManager{next: next}
+}
+
+// WithinTx traces and times the unit of work and hands it instrumented repositories
+func (m *instrumentedTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) (err error) {
+	ctx, end := instrument(ctx, "tx", "transaction")
+	defer func() { end(err) }()
+	return m.next.WithinTx(ctx, func(ctx context.Context, repos Repositories) error {
+		repos.Interviews = InstrumentInterviewRepository(repos.Interviews)
+		return fn(ctx, repos)
+	})
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentTxManager(t *testing.T) {
+	// Setup
+	mockRepo := new(MockInterviewRepository)
+	mockTx := &MockTxManager{Repositories: Repositories{Interviews: mockRepo}}
+	txManager := InstrumentTxManager(mockTx)
+	interview := &domain.Interview{ID: 1, Status: domain.StatusScheduled}
+	transactions := observations(t, "tx", "transaction")
+	cancels := observations(t, "interviews", "cancel")
+
+	// Mock behavior
+	mockTx.On("WithinTx", mock.Anything).Return(nil)
+	mockRepo.On("Cancel", mock.Anything, interview, "withdrawn").Return(ErrNotFound)
+
+	// Execute
+	err := txManager.WithinTx(context.Background(), func(ctx context.Context, repos Repositories) error {
+		return repos.Interviews.Cancel(ctx, interview, "withdrawn")
+	})
+
+	// Assertions
+	assert.ErrorIs(t, err, ErrNotFound) // Errors are passed through untouched
+	assert.Equal(t, transactions+1, observations(t, "tx", "transaction"))
+	assert.Equal(t, cancels+1, observations(t, "interviews", "cancel")) // Failed calls are timed as well
+	mockRepo.AssertExpectations(t)
+}
+
+// observations returns how many durations were recorded for a repository operation
+func observations(t *testing.T, repository, operation string) uint64 {
+	t.Helper()
+	var metric dto.Metric
+	require.NoError(t, queryDuration.WithLabelValues(repository, operation).(prometheus.Histogram).Write(&metric))
+	return metric.GetHistogram().GetSampleCount()
+}
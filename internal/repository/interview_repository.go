@@ -1,45 +1,483 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
 	"github.com/poolcamacho/interviews-service/internal/domain"
 )
 
+// ErrNotScheduled is returned when an interview can no longer be changed because it is not scheduled
+var ErrNotScheduled = errors.New("interview is not scheduled")
+
 // InterviewRepository defines methods for accessing the interviews table
 // This interface abstracts database operations for the interviews table.
 type InterviewRepository interface {
 	// FindAll retrieves all interviews from the database
 	// Executes a query to fetch all records from the interviews table.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
 	// @return []*domain.Interview - A slice containing all interviews
 	// @return error - An error if the query fails
-	FindAll() ([]*domain.Interview, error)
+	FindAll(ctx context.Context) ([]*domain.Interview, error)
+
+	// FindMatching retrieves the interviews matching a filter
+	// The filter and limit are part of the query, so only the interviews returned are read.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param filter domain.InterviewFilter - The status, candidate, job and maximum number of interviews
+	// @return []*domain.Interview - The matching interviews, ordered by ID
+	// @return error - An error if the query fails
+	FindMatching(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error)
 
 	// Create inserts a new interview record into the database
-	// Executes an INSERT query to save a new interview in the interviews table.
+	// Executes an INSERT query to save a new interview in the interviews table and records
+	// an interview.created event in the outbox within the same transaction.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
 	// @param interview *domain.Interview - The interview data to be saved
 	// @return error - An error if the query fails
-	Create(interview *domain.Interview) error
+	Create(ctx context.Context, interview *domain.Interview) error
+
+	// FindByID retrieves an interview with its panel
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param id int - The ID of the interview
+	// @return *domain.Interview - The interview
+	// @return error - ErrNotFound if the interview does not exist
+	FindByID(ctx context.Context, id int) (*domain.Interview, error)
+
+	// FindByIDs retrieves several interviews with their panels in a single round trip
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param ids []int - The IDs of the interviews
+	// @return []*domain.Interview - The interviews found, in no particular order; unknown IDs are skipped
+	// @return error - An error if the query fails
+	FindByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error)
+
+	// Update saves the date, feedback, stage and candidate email of an interview
+	// Moving the date records an interview.rescheduled event in the outbox within the same transaction.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param interview *domain.Interview - The interview with its new values
+	// @return error - ErrNotFound if the interview does not exist, ErrNotScheduled when moving an interview that is not scheduled
+	Update(ctx context.Context, interview *domain.Interview) error
+
+	// FindUpcomingByCandidate retrieves the scheduled interviews of a candidate that have not started yet
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param candidateID int - The ID of the candidate
+	// @param from time.Time - Interviews dated after this time are returned
+	// @return []*domain.Interview - The upcoming interviews, soonest first
+	// @return error - An error if the query fails
+	FindUpcomingByCandidate(ctx context.Context, candidateID int, from time.Time) ([]*domain.Interview, error)
+
+	// FindUpcomingByJob retrieves the scheduled interviews for a job that have not started yet
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param jobID int - The ID of the job
+	// @param from time.Time - Interviews dated after this time are returned
+	// @return []*domain.Interview - The upcoming interviews, soonest first
+	// @return error - An error if the query fails
+	FindUpcomingByJob(ctx context.Context, jobID int, from time.Time) ([]*domain.Interview, error)
+
+	// Cancel marks a scheduled interview as cancelled
+	// Records an interview.cancelled event in the outbox within the same transaction.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param interview *domain.Interview - The interview to cancel, updated in place
+	// @param reason string - The reason given for the cancellation
+	// @return error - ErrNotFound if the interview does not exist or is no longer scheduled
+	Cancel(ctx context.Context, interview *domain.Interview, reason string) error
+
+	// FlagOverdue moves scheduled interviews that ended without feedback to needs_attention
+	// Feedback counts as received once the interview has feedback or a panelist has submitted theirs.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param endedBefore time.Time - Interviews dated at or before this time are considered over
+	// @return int - The number of interviews flagged
+	// @return error - An error if the query fails
+	FlagOverdue(ctx context.Context, endedBefore time.Time) (int, error)
+
+	// FindByStatus retrieves the interviews in a given status
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param status domain.InterviewStatus - The status to filter by
+	// @return []*domain.Interview - The matching interviews, oldest first
+	// @return error - An error if the query fails
+	FindByStatus(ctx context.Context, status domain.InterviewStatus) ([]*domain.Interview, error)
+
+	// Resolve records the outcome of interviews flagged as needing attention
+	// Interviews that are not in needs_attention are left untouched.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param ids []int - The IDs of the interviews
+	// @param status domain.InterviewStatus - The outcome to record
+	// @return int - The number of interviews updated
+	// @return error - An error if the query fails
+	Resolve(ctx context.Context, ids []int, status domain.InterviewStatus) (int, error)
 }
 
 type interviewRepositoryImpl struct {
-	db *sql.DB // Database connection instance
+	db           *sql.DB       // Database connection instance, nil when bound to a transaction
+	tx           *sql.Tx       // Transaction of the unit of work the repository belongs to, nil outside of TxManager.WithinTx
+	queryTimeout time.Duration // Deadline of each call, including its transaction; zero for none
+	sqlite       bool          // True on SQLite, whose transactions lock the whole database instead of rows
 }
 
 // NewInterviewRepository creates a new InterviewRepository instance
 // This constructor initializes the repository with the provided database connection.
 // @param db *sql.DB - The database connection used for executing queries
+// @param queryTimeout time.Duration - How long a call may run before it is cancelled, zero to only rely on the caller's context
+// @return InterviewRepository - An instance of the repository interface implementation
+func NewInterviewRepository(db *sql.DB, queryTimeout time.Duration) InterviewRepository {
+	return &interviewRepositoryImpl{db: db, queryTimeout: queryTimeout}
+}
+
+// NewSQLiteInterviewRepository creates a new InterviewRepository instance backed by SQLite
+// SQLite shares the placeholders and LastInsertId of MySQL, so the same queries run on the schema of the
+// sqlite migrations; row locks are left out since the connection starts immediate transactions holding the
+// database's write lock.
+// @param db *sql.DB - The database connection used for executing queries, opened by db.Connect with a sqlite:// URL
+// @param queryTimeout time.Duration - How long a call may run before it is cancelled, zero to only rely on the caller's context
 // @return InterviewRepository - An instance of the repository interface implementation
-func NewInterviewRepository(db *sql.DB) InterviewRepository {
-	return &interviewRepositoryImpl{db: db}
+func NewSQLiteInterviewRepository(db *sql.DB, queryTimeout time.Duration) InterviewRepository {
+	return &interviewRepositoryImpl{db: db, queryTimeout: queryTimeout, sqlite: true}
+}
+
+// conn returns what the queries of a call run on
+// @return dbtx - The transaction the repository is bound to, or the database connection
+func (r *interviewRepositoryImpl) conn() dbtx {
+	if r.tx != nil {
+		return r.tx
+	}
+	return r.db
+}
+
+// withinTx runs the writes of a call atomically
+// Reuses the transaction the repository is bound to, otherwise starts one bounded by the query timeout.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param fn func(ctx context.Context, tx *sql.Tx) error - The writes to run on the transaction
+// @return error - The error returned by fn, or an error if the transaction could not be started or committed
+func (r *interviewRepositoryImpl) withinTx(ctx context.Context, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	if r.tx != nil {
+		return fn(ctx, r.tx)
+	}
+	return runInTx(ctx, r.db, r.queryTimeout, fn)
+}
+
+// interviewColumns lists the interviews columns in the order expected by interviewDest
+const interviewColumns = `id, candidate_id, job_id, interview_date, feedback, candidate_email, status, cancellation_reason, stage`
+
+// interviewDest returns the scan destinations matching interviewColumns
+// @param i *domain.Interview - The interview the columns are scanned into
+// @return []interface{} - The destinations to pass to Scan
+func interviewDest(i *domain.Interview) []interface{} {
+	return []interface{}{&i.ID, &i.CandidateID, &i.JobID, &i.InterviewDate, &i.Feedback, &i.CandidateEmail,
+		&i.Status, &i.CancellationReason, &i.Stage}
 }
 
 // FindAll retrieves all interviews from the database
 // Executes a SELECT query on the interviews table and maps the results to a slice of Interview structs.
+// @param ctx context.Context - Cancels the operation and carries its deadline
 // @return []*domain.Interview - A slice containing all interviews
 // @return error - An error if the query execution fails
-func (r *interviewRepositoryImpl) FindAll() ([]*domain.Interview, error) {
-	query := `SELECT id, candidate_id, job_id, interview_date, feedback FROM interviews`
-	rows, err := r.db.Query(query)
+func (r *interviewRepositoryImpl) FindAll(ctx context.Context) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews`)
+}
+
+// FindMatching retrieves the interviews matching a filter
+// Executes a SELECT query with a condition per filter, ordered by ID and limited in the database.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param filter domain.InterviewFilter - The status, candidate, job and maximum number of interviews
+// @return []*domain.Interview - The matching interviews
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) FindMatching(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error) {
+	columns, args := filterConditions(filter)
+	query := `SELECT ` + interviewColumns + ` FROM interviews WHERE 1 = 1`
+	for _, column := range columns {
+		query += ` AND ` + column + ` = ?`
+	}
+	query += ` ORDER BY id`
+	if filter.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, filter.Limit)
+	}
+	return r.queryInterviews(ctx, query, args...)
+}
+
+// filterConditions lists the columns a filter constrains with the values they must equal
+// @param filter domain.InterviewFilter - The filter, whose zero fields are left out
+// @return []string - The constrained columns
+// @return []interface{} - The value of each column, in the same order
+func filterConditions(filter domain.InterviewFilter) ([]string, []interface{}) {
+	var columns []string
+	var args []interface{}
+	if filter.Status != "" {
+		columns = append(columns, "status")
+		args = append(args, string(filter.Status))
+	}
+	if filter.CandidateID != 0 {
+		columns = append(columns, "candidate_id")
+		args = append(args, filter.CandidateID)
+	}
+	if filter.JobID != 0 {
+		columns = append(columns, "job_id")
+		args = append(args, filter.JobID)
+	}
+	return columns, args
+}
+
+// Create inserts a new interview record into the database
+// Executes an INSERT query for the interview, its panelists and its interview.created outbox
+// event inside a single transaction and stores the generated identifier on the interview.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param interview *domain.Interview - The interview data to be saved
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) Create(ctx context.Context, interview *domain.Interview) error {
+	return r.withinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		interview.Status = domain.StatusScheduled
+		query := `INSERT INTO interviews (candidate_id, job_id, interview_date, feedback, candidate_email, status, cancellation_reason, stage) VALUES (?, ?, ?, ?, ?, ?, '', ?)`
+		result, err := tx.ExecContext(ctx, query, interview.CandidateID, interview.JobID, interview.InterviewDate.UTC(), interview.Feedback,
+			interview.CandidateEmail, string(interview.Status), interview.Stage)
+		if err != nil {
+			return err // Return error if the query fails
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		interview.ID = int(id)
+
+		for idx := range interview.Panel {
+			panelist := &interview.Panel[idx]
+			panelist.InterviewID = interview.ID
+			result, err := tx.ExecContext(ctx, `INSERT INTO interview_panelists (interview_id, name, email) VALUES (?, ?, ?)`,
+				panelist.InterviewID, panelist.Name, panelist.Email)
+			if err != nil {
+				return err
+			}
+			panelistID, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			panelist.ID = int(panelistID)
+		}
+
+		return enqueueEvent(ctx, tx, &domain.Event{
+			Type:       domain.EventInterviewCreated,
+			Interview:  *interview,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+}
+
+// FindByID retrieves an interview with its panel
+// Executes a SELECT query filtered by primary key.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param id int - The ID of the interview
+// @return *domain.Interview - The interview
+// @return error - ErrNotFound if the interview does not exist
+func (r *interviewRepositoryImpl) FindByID(ctx context.Context, id int) (*domain.Interview, error) {
+	interviews, err := r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews WHERE id = ?`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(interviews) == 0 {
+		return nil, ErrNotFound
+	}
+	return interviews[0], nil
+}
+
+// FindByIDs retrieves several interviews with their panels in a single round trip
+// Executes a SELECT query filtered by a list of primary keys.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param ids []int - The IDs of the interviews
+// @return []*domain.Interview - The interviews found
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) FindByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews WHERE id IN (`+
+		strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")+`)`, args...)
+}
+
+// Update saves the date, feedback, stage and candidate email of an interview
+// Locks the row to read the previous date and status, executes the UPDATE and, when the date
+// moved, records the interview.rescheduled outbox event inside the same transaction.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param interview *domain.Interview - The interview with its new values
+// @return error - ErrNotFound if the interview does not exist, ErrNotScheduled when moving an interview that is not scheduled
+func (r *interviewRepositoryImpl) Update(ctx context.Context, interview *domain.Interview) error {
+	return r.withinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var previousDate time.Time
+		var status domain.InterviewStatus
+		query := `SELECT interview_date, status FROM interviews WHERE id = ?`
+		if !r.sqlite {
+			query += ` FOR UPDATE`
+		}
+		err := tx.QueryRowContext(ctx, query, interview.ID).Scan(&previousDate, &status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		previousDate = previousDate.UTC()
+
+		rescheduled := !previousDate.Equal(interview.InterviewDate)
+		if rescheduled && status != domain.StatusScheduled {
+			return ErrNotScheduled
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE interviews SET interview_date = ?, feedback = ?, stage = ?, candidate_email = ? WHERE id = ?`,
+			interview.InterviewDate.UTC(), interview.Feedback, interview.Stage, interview.CandidateEmail, interview.ID); err != nil {
+			return err
+		}
+		interview.Status = status
+
+		if rescheduled {
+			if err := enqueueEvent(ctx, tx, &domain.Event{
+				Type:         domain.EventInterviewRescheduled,
+				Interview:    *interview,
+				PreviousDate: &previousDate,
+				OccurredAt:   time.Now().UTC(),
+			}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FindUpcomingByCandidate retrieves the scheduled interviews of a candidate dated after from
+// Executes a SELECT query filtered by candidate, status and date, soonest first.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param candidateID int - The ID of the candidate
+// @param from time.Time - Interviews dated after this time are returned
+// @return []*domain.Interview - The upcoming interviews
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) FindUpcomingByCandidate(ctx context.Context, candidateID int, from time.Time) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews
+		WHERE candidate_id = ? AND status = ? AND interview_date > ? ORDER BY interview_date`,
+		candidateID, string(domain.StatusScheduled), from.UTC())
+}
+
+// FindUpcomingByJob retrieves the scheduled interviews for a job dated after from
+// Executes a SELECT query filtered by job, status and date, soonest first.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param jobID int - The ID of the job
+// @param from time.Time - Interviews dated after this time are returned
+// @return []*domain.Interview - The upcoming interviews
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) FindUpcomingByJob(ctx context.Context, jobID int, from time.Time) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews
+		WHERE job_id = ? AND status = ? AND interview_date > ? ORDER BY interview_date`,
+		jobID, string(domain.StatusScheduled), from.UTC())
+}
+
+// Cancel marks a scheduled interview as cancelled
+// Executes an UPDATE guarded on the scheduled status, so an interview is only cancelled once,
+// and records the interview.cancelled outbox event inside the same transaction.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param interview *domain.Interview - The interview to cancel, updated in place
+// @param reason string - The reason given for the cancellation
+// @return error - ErrNotFound if the interview does not exist or is no longer scheduled
+func (r *interviewRepositoryImpl) Cancel(ctx context.Context, interview *domain.Interview, reason string) error {
+	return r.withinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `UPDATE interviews SET status = ?, cancellation_reason = ? WHERE id = ? AND status = ?`,
+			string(domain.StatusCancelled), reason, interview.ID, string(domain.StatusScheduled))
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrNotFound
+		}
+
+		interview.Status = domain.StatusCancelled
+		interview.CancellationReason = reason
+		return enqueueEvent(ctx, tx, &domain.Event{
+			Type:       domain.EventInterviewCancelled,
+			Interview:  *interview,
+			Reason:     reason,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+}
+
+// FlagOverdue moves overdue scheduled interviews to needs_attention
+// Executes a single UPDATE on scheduled interviews dated at or before endedBefore with empty feedback and
+// no feedback submitted by their panel, so running it concurrently on several replicas is harmless.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param endedBefore time.Time - Interviews dated at or before this time are considered over
+// @return int - The number of interviews flagged
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) FlagOverdue(ctx context.Context, endedBefore time.Time) (int, error) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	result, err := r.conn().ExecContext(ctx, `UPDATE interviews SET status = ? WHERE status = ? AND interview_date <= ? AND feedback = ''
+		AND NOT EXISTS (`+submittedFeedback+`)`,
+		string(domain.StatusNeedsAttention), string(domain.StatusScheduled), endedBefore.UTC())
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// submittedFeedback selects the panelists of the interviews row who submitted feedback, shared by every dialect
+const submittedFeedback = `SELECT 1 FROM interview_panelists p WHERE p.interview_id = interviews.id AND p.feedback_submitted_at IS NOT NULL`
+
+// FindByStatus retrieves the interviews in a given status
+// Executes a SELECT query filtered by status, oldest first.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param status domain.InterviewStatus - The status to filter by
+// @return []*domain.Interview - The matching interviews
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) FindByStatus(ctx context.Context, status domain.InterviewStatus) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews WHERE status = ? ORDER BY interview_date`,
+		string(status))
+}
+
+// Resolve records the outcome of interviews flagged as needing attention
+// Executes a single UPDATE guarded on the needs_attention status.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param ids []int - The IDs of the interviews
+// @param status domain.InterviewStatus - The outcome to record
+// @return int - The number of interviews updated
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) Resolve(ctx context.Context, ids []int, status domain.InterviewStatus) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	args := []interface{}{string(status), string(domain.StatusNeedsAttention)}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	query := `UPDATE interviews SET status = ? WHERE status = ? AND id IN (` +
+		strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",") + `)`
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	result, err := r.conn().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// queryInterviews runs a query returning interviews rows and loads their panels
+// Both queries share a single query timeout.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param query string - A SELECT query returning interviewColumns
+// @param args ...interface{} - The query arguments
+// @return []*domain.Interview - The matching interviews
+// @return error - An error if the query execution fails
+func (r *interviewRepositoryImpl) queryInterviews(ctx context.Context, query string, args ...interface{}) ([]*domain.Interview, error) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	rows, err := r.conn().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err // Return error if the query fails
 	}
@@ -49,20 +487,60 @@ func (r *interviewRepositoryImpl) FindAll() ([]*domain.Interview, error) {
 	for rows.Next() {
 		var i domain.Interview
 		// Map each row to the Interview struct
-		if err := rows.Scan(&i.ID, &i.CandidateID, &i.JobID, &i.InterviewDate, &i.Feedback); err != nil {
+		if err := rows.Scan(interviewDest(&i)...); err != nil {
 			return nil, err // Return error if scanning fails
 		}
+		i.InterviewDate = i.InterviewDate.UTC() // SQLite returns dates in the local zone when its offset is UTC's
 		interviews = append(interviews, &i)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadPanels(ctx, interviews); err != nil {
+		return nil, err
+	}
 	return interviews, nil
 }
 
-// Create inserts a new interview record into the database
-// Executes an INSERT query to add a new record to the interviews table.
-// @param interview *domain.Interview - The interview data to be saved
+// loadPanels attaches panelists to the given interviews
+// Fetches the panelists of every interview with a single query to avoid one round trip per interview.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param interviews []*domain.Interview - The interviews whose panels should be loaded
 // @return error - An error if the query execution fails
-func (r *interviewRepositoryImpl) Create(interview *domain.Interview) error {
-	query := `INSERT INTO interviews (candidate_id, job_id, interview_date, feedback) VALUES (?, ?, ?, ?)`
-	_, err := r.db.Exec(query, interview.CandidateID, interview.JobID, interview.InterviewDate, interview.Feedback)
-	return err // Return error if the query fails
+func (r *interviewRepositoryImpl) loadPanels(ctx context.Context, interviews []*domain.Interview) error {
+	if len(interviews) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*domain.Interview, len(interviews))
+	args := make([]interface{}, 0, len(interviews))
+	for _, i := range interviews {
+		byID[i.ID] = i
+		args = append(args, i.ID)
+	}
+
+	query := `SELECT id, interview_id, name, email, COALESCE(feedback, ''), feedback_submitted_at FROM interview_panelists WHERE interview_id IN (` +
+		strings.TrimSuffix(strings.Repeat("?,", len(args)), ",") + `) ORDER BY id`
+	rows, err := r.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p domain.Panelist
+		var submittedAt sql.NullTime
+		if err := rows.Scan(&p.ID, &p.InterviewID, &p.Name, &p.Email, &p.Feedback, &submittedAt); err != nil {
+			return err
+		}
+		if submittedAt.Valid {
+			submitted := submittedAt.Time.UTC()
+			p.FeedbackSubmittedAt = &submitted
+		}
+		if i, ok := byID[p.InterviewID]; ok {
+			i.Panel = append(i.Panel, p)
+		}
+	}
+	return rows.Err()
 }
@@ -0,0 +1,800 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/migrate"
+	"github.com/poolcamacho/interviews-service/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conformanceStore is an implementation of the interview storage under test
+type conformanceStore struct {
+	Interviews InterviewRepository // Repository under test
+	Tx         TxManager           // Transaction manager sharing the repository's storage
+	Outbox     OutboxRepository    // Outbox the repository records events in
+	Webhooks   WebhookRepository   // Webhook storage of the same database, nil when the storage has none
+	Feedback   FeedbackRepository  // Feedback storage of the same database, nil when the storage has none
+	Reminders  ReminderRepository  // Reminder storage of the same database, nil when the storage has none
+
+	SubmitFeedback func(panelistID int, submittedAt time.Time) error // Records the feedback of a panelist in the storage
+	CorruptOutbox  func(messageID int) error                         // Replaces the payload of an outbox message with one that is no event, nil when the storage cannot
+}
+
+// conformanceTime is the reference time of the suite; whole seconds in UTC so every backend stores it exactly
+var conformanceTime = time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)
+
+func TestMemoryInterviewRepository_Conformance(t *testing.T) {
+	runInterviewRepositoryConformance(t, func(t *testing.T) conformanceStore {
+		store := NewMemoryStore()
+		return conformanceStore{Interviews: store.Interviews(), Tx: store.TxManager(), Outbox: store.Outbox(),
+			SubmitFeedback: func(panelistID int, submittedAt time.Time) error {
+				store.mu.Lock()
+				defer store.mu.Unlock()
+				for id, stored := range store.interviews {
+					updated := cloneInterview(stored)
+					for i := range updated.Panel {
+						if updated.Panel[i].ID == panelistID {
+							updated.Panel[i].Feedback = "Hire"
+							updated.Panel[i].FeedbackSubmittedAt = &submittedAt
+							store.interviews[id] = updated
+							return nil
+						}
+					}
+				}
+				return ErrNotFound
+			}}
+	})
+}
+
+// TestMySQLInterviewRepository_Conformance runs the suite against the database in TEST_MYSQL_DSN
+// The DSN is a MySQL DSN such as root:root@tcp(localhost:3306)/interviews_test. Migrations are applied and the
+// interview tables emptied before every test.
+func TestMySQLInterviewRepository_Conformance(t *testing.T) {
+	conn := openConformanceDB(t, "TEST_MYSQL_DSN", db.MySQL)
+	runInterviewRepositoryConformance(t, func(t *testing.T) conformanceStore {
+		for _, table := range []string{"interviews", "interview_panelists", "outbox", "webhook_subscriptions",
+			"webhook_deliveries", "interview_reminders", "feedback_nudges"} {
+			_, err := conn.Exec("TRUNCATE TABLE " + table)
+			require.NoError(t, err)
+		}
+		return conformanceStore{
+			Interviews: NewInterviewRepository(conn, 5*time.Second),
+			Tx:         NewTxManager(conn, DefaultTxConfig()),
+			Outbox:     NewOutboxRepository(conn),
+			Webhooks:   NewWebhookRepository(conn),
+			Feedback:   NewFeedbackRepository(conn),
+			Reminders:  NewReminderRepository(conn),
+			SubmitFeedback: func(panelistID int, submittedAt time.Time) error {
+				_, err := conn.Exec(`UPDATE interview_panelists SET feedback = 'Hire', feedback_submitted_at = ? WHERE id = ?`, submittedAt, panelistID)
+				return err
+			},
+			CorruptOutbox: func(messageID int) error {
+				_, err := conn.Exec(`UPDATE outbox SET payload = '[]' WHERE id = ?`, messageID)
+				return err
+			},
+		}
+	})
+}
+
+// TestPostgresInterviewRepository_Conformance runs the suite against the database in TEST_POSTGRES_DSN
+// The DSN is a postgres:// URL. Migrations are applied and the interview tables emptied before every test.
+func TestPostgresInterviewRepository_Conformance(t *testing.T) {
+	conn := openConformanceDB(t, "TEST_POSTGRES_DSN", db.Postgres)
+	runInterviewRepositoryConformance(t, func(t *testing.T) conformanceStore {
+		_, err := conn.Exec(`TRUNCATE TABLE interviews, interview_panelists, outbox, webhook_subscriptions,
+			webhook_deliveries, interview_reminders, feedback_nudges RESTART IDENTITY`)
+		require.NoError(t, err)
+		return conformanceStore{
+			Interviews: NewPostgresInterviewRepository(conn, 5*time.Second),
+			Tx:         NewPostgresTxManager(conn, DefaultTxConfig()),
+			Outbox:     NewPostgresOutboxRepository(conn),
+			Webhooks:   NewPostgresWebhookRepository(conn),
+			Feedback:   NewPostgresFeedbackRepository(conn),
+			Reminders:  NewPostgresReminderRepository(conn),
+			SubmitFeedback: func(panelistID int, submittedAt time.Time) error {
+				_, err := conn.Exec(`UPDATE interview_panelists SET feedback = 'Hire', feedback_submitted_at = $1 WHERE id = $2`, submittedAt, panelistID)
+				return err
+			},
+			CorruptOutbox: func(messageID int) error {
+				_, err := conn.Exec(`UPDATE outbox SET payload = '[]' WHERE id = $1`, messageID)
+				return err
+			},
+		}
+	})
+}
+
+func TestSQLiteInterviewRepository_Conformance(t *testing.T) {
+	runInterviewRepositoryConformance(t, func(t *testing.T) conformanceStore {
+		conn := db.Connect("sqlite://:memory:")
+		conn.SetMaxOpenConns(1) // Every connection to :memory: opens a database of its own
+		t.Cleanup(func() { conn.Close() })
+		migrations, err := migrate.Load(db.SQLite)
+		require.NoError(t, err)
+		_, err = migrate.NewMigrator(conn, db.SQLite, migrations).Up(context.Background())
+		require.NoError(t, err)
+
+		return conformanceStore{
+			Interviews: NewSQLiteInterviewRepository(conn, 5*time.Second),
+			Tx:         NewSQLiteTxManager(conn, DefaultTxConfig()),
+			Outbox:     NewOutboxRepository(conn),
+			Webhooks:   NewSQLiteWebhookRepository(conn),
+			Feedback:   NewSQLiteFeedbackRepository(conn),
+			Reminders:  NewSQLiteReminderRepository(conn),
+			SubmitFeedback: func(panelistID int, submittedAt time.Time) error {
+				_, err := conn.Exec(`UPDATE interview_panelists SET feedback = 'Hire', feedback_submitted_at = ? WHERE id = ?`, submittedAt, panelistID)
+				return err
+			},
+			CorruptOutbox: func(messageID int) error {
+				_, err := conn.Exec(`UPDATE outbox SET payload = '[]' WHERE id = ?`, messageID)
+				return err
+			},
+		}
+	})
+}
+
+// openConformanceDB connects to the database named by an environment variable and applies its migrations
+// The test is skipped when the variable is not set.
+func openConformanceDB(t *testing.T, env string, dialect db.Dialect) *sql.DB {
+	dsn := os.Getenv(env)
+	if dsn == "" {
+		t.Skip(env + " is not set")
+	}
+	require.Equal(t, dialect, db.DialectOf(dsn), env+" points to the wrong kind of database")
+	conn := db.Connect(dsn)
+	t.Cleanup(func() { conn.Close() })
+
+	migrations, err := migrate.Load(dialect)
+	require.NoError(t, err)
+	_, err = migrate.NewMigrator(conn, dialect, migrations).Up(context.Background())
+	require.NoError(t, err)
+	return conn
+}
+
+// runInterviewRepositoryConformance checks the behaviour every InterviewRepository implementation must share
+func runInterviewRepositoryConformance(t *testing.T, newStore func(t *testing.T) conformanceStore) {
+	ctx := context.Background()
+
+	t.Run("create and find", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := &domain.Interview{
+			CandidateID: 101, JobID: 201, InterviewDate: conformanceTime, Stage: "onsite", CandidateEmail: "jane@example.com",
+			Panel: []domain.Panelist{{Name: "Alice", Email: "alice@example.com"}, {Name: "Bob", Email: "bob@example.com"}},
+		}
+
+		// Execute
+		err := s.Interviews.Create(ctx, interview)
+
+		// Assertions
+		require.NoError(t, err)
+		assert.NotZero(t, interview.ID)
+		assert.Equal(t, domain.StatusScheduled, interview.Status)
+		for _, p := range interview.Panel {
+			assert.NotZero(t, p.ID)
+			assert.Equal(t, interview.ID, p.InterviewID)
+		}
+
+		found, err := s.Interviews.FindByID(ctx, interview.ID)
+		require.NoError(t, err)
+		assert.Equal(t, interview, found)
+
+		pending, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, domain.EventInterviewCreated, pending[0].Event.Type)
+		assert.Equal(t, interview.ID, pending[0].InterviewID)
+		assert.NotEmpty(t, pending[0].Event.ID)
+	})
+
+	t.Run("follow the outbox", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		empty, err := s.Outbox.LastID()
+		require.NoError(t, err)
+		first := createInterview(t, s, 101, 201, conformanceTime)
+		second := createInterview(t, s, 102, 201, conformanceTime)
+		pending, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.NoError(t, s.Outbox.MarkPublished(pending[0].ID, conformanceTime))
+
+		// Execute
+		last, err := s.Outbox.LastID()
+		require.NoError(t, err)
+		all, err := s.Outbox.FindAfter(0, 10)
+		require.NoError(t, err)
+		after, err := s.Outbox.FindAfter(pending[0].ID, 10)
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Zero(t, empty)
+		assert.Equal(t, pending[1].ID, last)
+		require.Len(t, all, 2) // Published messages included
+		assert.Equal(t, first.ID, all[0].InterviewID)
+		require.Len(t, after, 1)
+		assert.Equal(t, second.ID, after[0].InterviewID)
+		assert.Equal(t, domain.EventInterviewCreated, after[0].Event.Type)
+	})
+
+	t.Run("retry and abandon outbox messages", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		first := createInterview(t, s, 101, 201, conformanceTime)
+		rescheduled := *first
+		rescheduled.InterviewDate = conformanceTime.Add(24 * time.Hour)
+		require.NoError(t, s.Interviews.Update(ctx, &rescheduled))
+		second := createInterview(t, s, 102, 201, conformanceTime)
+		pending, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		require.Len(t, pending, 3)
+		retryAt := conformanceTime.Add(time.Minute)
+
+		// Execute
+		require.NoError(t, s.Outbox.MarkFailed(pending[0].ID, "webhooks unavailable", retryAt))
+		waiting, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		due, err := s.Outbox.FindPending(10, retryAt)
+		require.NoError(t, err)
+		require.NoError(t, s.Outbox.MarkAbandoned(pending[0].ID, "webhooks unavailable", retryAt))
+		released, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		count, err := s.Outbox.CountPending()
+		require.NoError(t, err)
+
+		// Assertions
+		require.Len(t, waiting, 1) // The later message of the same interview is held back too
+		assert.Equal(t, second.ID, waiting[0].InterviewID)
+		require.Len(t, due, 3)
+		assert.Equal(t, 1, due[0].Attempts)
+		assert.Equal(t, "webhooks unavailable", due[0].LastError)
+		require.NotNil(t, due[0].NextAttemptAt)
+		assert.True(t, due[0].NextAttemptAt.Equal(retryAt))
+		require.Len(t, released, 2)
+		assert.Equal(t, pending[1].ID, released[0].ID)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("abandon undecodable outbox messages", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		if s.CorruptOutbox == nil {
+			t.Skip("the storage cannot hold an undecodable message")
+		}
+		createInterview(t, s, 101, 201, conformanceTime)
+		second := createInterview(t, s, 102, 201, conformanceTime)
+		pending, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		require.NoError(t, s.CorruptOutbox(pending[0].ID))
+
+		// Execute
+		found, err := s.Outbox.FindPending(10, conformanceTime)
+
+		// Assertions
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, second.ID, found[0].InterviewID)
+		count, err := s.Outbox.CountPending()
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		all, err := s.Outbox.FindAfter(0, 10)
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("find missing interview", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+
+		// Execute
+		found, err := s.Interviews.FindByID(ctx, 42)
+
+		// Assertions
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, found)
+	})
+
+	t.Run("returned interviews are copies", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+
+		// Execute
+		found, err := s.Interviews.FindByID(ctx, interview.ID)
+		require.NoError(t, err)
+		found.Feedback = "changed without saving"
+		found.Panel[0].Name = "changed without saving"
+
+		// Assertions
+		again, err := s.Interviews.FindByID(ctx, interview.ID)
+		require.NoError(t, err)
+		assert.Empty(t, again.Feedback)
+		assert.Equal(t, "Alice", again.Panel[0].Name)
+	})
+
+	t.Run("find by IDs and find all", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		first := createInterview(t, s, 101, 201, conformanceTime)
+		second := createInterview(t, s, 102, 201, conformanceTime.Add(time.Hour))
+		third := createInterview(t, s, 103, 202, conformanceTime.Add(2*time.Hour))
+
+		// Execute
+		some, err := s.Interviews.FindByIDs(ctx, []int{third.ID, first.ID, third.ID + 100})
+		require.NoError(t, err)
+		all, err := s.Interviews.FindAll(ctx)
+		require.NoError(t, err)
+		none, err := s.Interviews.FindByIDs(ctx, nil)
+		require.NoError(t, err)
+
+		// Assertions
+		assert.ElementsMatch(t, []*domain.Interview{first, third}, some)
+		assert.ElementsMatch(t, []*domain.Interview{first, second, third}, all)
+		assert.Empty(t, none)
+	})
+
+	t.Run("find matching", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		first := createInterview(t, s, 101, 201, conformanceTime.Add(2*time.Hour))
+		second := createInterview(t, s, 101, 202, conformanceTime)
+		third := createInterview(t, s, 101, 201, conformanceTime.Add(time.Hour))
+		createInterview(t, s, 102, 201, conformanceTime) // Other candidate
+		require.NoError(t, s.Interviews.Cancel(ctx, third, "withdrew"))
+
+		// Execute
+		byCandidate, err := s.Interviews.FindMatching(ctx, domain.InterviewFilter{CandidateID: 101})
+		require.NoError(t, err)
+		limited, err := s.Interviews.FindMatching(ctx, domain.InterviewFilter{CandidateID: 101, Limit: 2})
+		require.NoError(t, err)
+		byJobAndStatus, err := s.Interviews.FindMatching(ctx,
+			domain.InterviewFilter{CandidateID: 101, JobID: 201, Status: domain.StatusScheduled})
+		require.NoError(t, err)
+		all, err := s.Interviews.FindMatching(ctx, domain.InterviewFilter{})
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Equal(t, []int{first.ID, second.ID, third.ID}, interviewIDs(byCandidate))
+		assert.Equal(t, []int{first.ID, second.ID}, interviewIDs(limited))
+		assert.Equal(t, []int{first.ID}, interviewIDs(byJobAndStatus))
+		assert.Len(t, all, 4)
+		assert.Equal(t, first.Panel, byJobAndStatus[0].Panel)
+	})
+
+	t.Run("update and reschedule", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+		changed := *interview
+		changed.Feedback = "Strong candidate"
+		changed.Stage = "final"
+		changed.InterviewDate = conformanceTime.Add(24 * time.Hour)
+
+		// Execute
+		err := s.Interviews.Update(ctx, &changed)
+
+		// Assertions
+		require.NoError(t, err)
+		found, err := s.Interviews.FindByID(ctx, interview.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Strong candidate", found.Feedback)
+		assert.Equal(t, "final", found.Stage)
+		assert.True(t, found.InterviewDate.Equal(changed.InterviewDate))
+
+		pending, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, domain.EventInterviewRescheduled, pending[1].Event.Type)
+		require.NotNil(t, pending[1].Event.PreviousDate)
+		assert.True(t, pending[1].Event.PreviousDate.Equal(conformanceTime))
+	})
+
+	t.Run("update without moving the date records no event", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+		interview.Feedback = "Good"
+
+		// Execute
+		err := s.Interviews.Update(ctx, interview)
+
+		// Assertions
+		require.NoError(t, err)
+		count, err := s.Outbox.CountPending()
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("dates are returned in UTC", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		madrid := time.FixedZone("CET", 3600)
+		interview := createInterview(t, s, 101, 201, conformanceTime.In(madrid))
+
+		// Execute
+		found, err := s.Interviews.FindByID(ctx, interview.ID)
+		require.NoError(t, err)
+		upcoming, err := s.Interviews.FindUpcomingByCandidate(ctx, 101, conformanceTime.Add(-time.Minute).In(madrid))
+		require.NoError(t, err)
+		notYet, err := s.Interviews.FindUpcomingByCandidate(ctx, 101, conformanceTime.Add(time.Minute))
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Equal(t, conformanceTime, found.InterviewDate)
+		assert.Equal(t, []int{interview.ID}, interviewIDs(upcoming))
+		assert.Empty(t, notYet)
+	})
+
+	t.Run("update missing interview", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+
+		// Execute
+		err := s.Interviews.Update(ctx, &domain.Interview{ID: 42, InterviewDate: conformanceTime})
+
+		// Assertions
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("reschedule cancelled interview", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+		require.NoError(t, s.Interviews.Cancel(ctx, interview, "position filled"))
+		interview.InterviewDate = conformanceTime.Add(time.Hour)
+
+		// Execute
+		err := s.Interviews.Update(ctx, interview)
+
+		// Assertions
+		assert.ErrorIs(t, err, ErrNotScheduled)
+	})
+
+	t.Run("find upcoming", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		later := createInterview(t, s, 101, 201, conformanceTime.Add(48*time.Hour))
+		sooner := createInterview(t, s, 101, 202, conformanceTime.Add(24*time.Hour))
+		createInterview(t, s, 101, 201, conformanceTime.Add(-time.Hour)) // In the past
+		cancelled := createInterview(t, s, 101, 201, conformanceTime.Add(72*time.Hour))
+		require.NoError(t, s.Interviews.Cancel(ctx, cancelled, "withdrew"))
+		createInterview(t, s, 102, 201, conformanceTime.Add(24*time.Hour)) // Other candidate
+
+		// Execute
+		byCandidate, err := s.Interviews.FindUpcomingByCandidate(ctx, 101, conformanceTime)
+		require.NoError(t, err)
+		byJob, err := s.Interviews.FindUpcomingByJob(ctx, 201, conformanceTime)
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Equal(t, []int{sooner.ID, later.ID}, interviewIDs(byCandidate))
+		assert.Len(t, byJob, 2)
+		assert.Equal(t, later.ID, byJob[1].ID)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+
+		// Execute
+		err := s.Interviews.Cancel(ctx, interview, "position filled")
+		again := s.Interviews.Cancel(ctx, interview, "twice")
+
+		// Assertions
+		require.NoError(t, err)
+		assert.ErrorIs(t, again, ErrNotFound)
+		found, err := s.Interviews.FindByID(ctx, interview.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusCancelled, found.Status)
+		assert.Equal(t, "position filled", found.CancellationReason)
+
+		pending, err := s.Outbox.FindPending(10, conformanceTime)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		assert.Equal(t, domain.EventInterviewCancelled, pending[1].Event.Type)
+		assert.Equal(t, "position filled", pending[1].Event.Reason)
+	})
+
+	t.Run("flag overdue and resolve", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		overdue := createInterview(t, s, 101, 201, conformanceTime.Add(-2*time.Hour))
+		withFeedback := createInterview(t, s, 102, 201, conformanceTime.Add(-2*time.Hour))
+		withFeedback.Feedback = "Hire"
+		require.NoError(t, s.Interviews.Update(ctx, withFeedback))
+		createInterview(t, s, 103, 201, conformanceTime.Add(time.Hour)) // Not held yet
+
+		// Execute
+		flagged, err := s.Interviews.FlagOverdue(ctx, conformanceTime.Add(-time.Hour))
+		require.NoError(t, err)
+		needsAttention, err := s.Interviews.FindByStatus(ctx, domain.StatusNeedsAttention)
+		require.NoError(t, err)
+		resolved, err := s.Interviews.Resolve(ctx, []int{overdue.ID, withFeedback.ID}, domain.StatusCompleted)
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Equal(t, 1, flagged)
+		assert.Equal(t, []int{overdue.ID}, interviewIDs(needsAttention))
+		assert.Equal(t, 1, resolved)
+		found, err := s.Interviews.FindByID(ctx, overdue.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusCompleted, found.Status)
+		found, err = s.Interviews.FindByID(ctx, withFeedback.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusScheduled, found.Status)
+	})
+
+	t.Run("flag overdue counts panel feedback", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		reviewed := createInterview(t, s, 101, 201, conformanceTime.Add(-2*time.Hour))
+		require.NoError(t, s.SubmitFeedback(reviewed.Panel[0].ID, conformanceTime.Add(-90*time.Minute)))
+		pending := createInterview(t, s, 102, 201, conformanceTime.Add(-2*time.Hour))
+
+		// Execute
+		flagged, err := s.Interviews.FlagOverdue(ctx, conformanceTime.Add(-time.Hour))
+		require.NoError(t, err)
+		needsAttention, err := s.Interviews.FindByStatus(ctx, domain.StatusNeedsAttention)
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Equal(t, 1, flagged)
+		assert.Equal(t, []int{pending.ID}, interviewIDs(needsAttention))
+		found, err := s.Interviews.FindByID(ctx, reviewed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusScheduled, found.Status)
+	})
+
+	t.Run("webhook subscriptions and deliveries", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		if s.Webhooks == nil {
+			t.Skip("the storage has no webhooks")
+		}
+		sub := &domain.WebhookSubscription{URL: "https://example.com/hook", Secret: "s3cret",
+			EventTypes: []domain.EventType{domain.EventInterviewCreated}, CreatedAt: conformanceTime}
+		require.NoError(t, s.Webhooks.CreateSubscription(sub))
+		delivery := func() *domain.WebhookDelivery {
+			return &domain.WebhookDelivery{SubscriptionID: sub.ID, EventID: "evt-1", EventType: domain.EventInterviewCreated,
+				Payload: []byte(`{"id":"evt-1"}`), Status: domain.DeliveryPending, NextAttemptAt: conformanceTime,
+				CreatedAt: conformanceTime, UpdatedAt: conformanceTime}
+		}
+
+		// Execute
+		first, again := delivery(), delivery()
+		require.NoError(t, s.Webhooks.CreateDelivery(first))
+		require.NoError(t, s.Webhooks.CreateDelivery(again))
+		notDue, err := s.Webhooks.FindDueDeliveries(conformanceTime.Add(-time.Minute), 10)
+		require.NoError(t, err)
+		due, err := s.Webhooks.FindDueDeliveries(conformanceTime, 10)
+		require.NoError(t, err)
+		first.Status, first.Attempts, first.ResponseCode = domain.DeliverySucceeded, 1, 200
+		require.NoError(t, s.Webhooks.UpdateDelivery(first))
+		succeeded, err := s.Webhooks.FindDeliveriesByStatus(domain.DeliverySucceeded)
+		require.NoError(t, err)
+		found, err := s.Webhooks.FindSubscriptionByID(sub.ID)
+		require.NoError(t, err)
+		require.NoError(t, s.Webhooks.DeleteSubscription(sub.ID))
+
+		// Assertions
+		assert.NotZero(t, first.ID)
+		assert.Equal(t, first.ID, again.ID) // One delivery per event
+		assert.Empty(t, notDue)
+		require.Len(t, due, 1)
+		assert.True(t, due[0].NextAttemptAt.Equal(conformanceTime))
+		assert.JSONEq(t, `{"id":"evt-1"}`, string(due[0].Payload))
+		require.Len(t, succeeded, 1)
+		assert.Equal(t, 200, succeeded[0].ResponseCode)
+		assert.Equal(t, sub, found)
+		_, err = s.Webhooks.FindSubscriptionByID(sub.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+		deliveries, err := s.Webhooks.FindDeliveriesBySubscription(sub.ID)
+		require.NoError(t, err)
+		assert.Empty(t, deliveries)
+	})
+
+	t.Run("feedback assignments and nudges", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		if s.Feedback == nil {
+			t.Skip("the storage has no feedback")
+		}
+		interview := &domain.Interview{CandidateID: 101, JobID: 201, InterviewDate: conformanceTime,
+			Panel: []domain.Panelist{{Name: "Alice", Email: "alice@example.com"}, {Name: "Bob", Email: "bob@example.com"}}}
+		require.NoError(t, s.Interviews.Create(ctx, interview))
+		alice, bob := interview.Panel[0].ID, interview.Panel[1].ID
+
+		// Execute
+		require.NoError(t, s.Feedback.SubmitFeedback(interview.ID, alice, "Hire", conformanceTime.Add(time.Hour)))
+		require.NoError(t, s.Feedback.SubmitFeedback(interview.ID, alice, "Hire", conformanceTime.Add(2*time.Hour)))
+		missing := s.Feedback.SubmitFeedback(interview.ID+1, alice, "Hire", conformanceTime)
+		require.NoError(t, s.Feedback.MarkNudged(bob, 1, conformanceTime.Add(time.Hour)))
+		require.NoError(t, s.Feedback.MarkNudged(bob, 1, conformanceTime.Add(time.Hour)))
+		assignments, err := s.Feedback.FindAssignments(conformanceTime.Add(-time.Hour), conformanceTime.Add(time.Hour))
+		require.NoError(t, err)
+		outside, err := s.Feedback.FindAssignments(conformanceTime.Add(time.Hour), conformanceTime.Add(2*time.Hour))
+		require.NoError(t, err)
+
+		// Assertions
+		assert.ErrorIs(t, missing, ErrNotFound)
+		require.Len(t, assignments, 2)
+		assert.Equal(t, interview.ID, assignments[0].Interview.ID)
+		assert.True(t, assignments[0].Interview.InterviewDate.Equal(conformanceTime))
+		assert.Equal(t, "Hire", assignments[0].Panelist.Feedback)
+		require.NotNil(t, assignments[0].Panelist.FeedbackSubmittedAt)
+		assert.True(t, assignments[0].Panelist.FeedbackSubmittedAt.Equal(conformanceTime.Add(time.Hour))) // First submission kept
+		assert.Zero(t, assignments[0].NudgeLevel)
+		assert.Nil(t, assignments[1].Panelist.FeedbackSubmittedAt)
+		assert.Equal(t, 1, assignments[1].NudgeLevel)
+		assert.Empty(t, outside)
+	})
+
+	t.Run("reminders", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		if s.Reminders == nil {
+			t.Skip("the storage has no reminders")
+		}
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+		cancelled := createInterview(t, s, 102, 201, conformanceTime)
+		require.NoError(t, s.Interviews.Cancel(ctx, cancelled, "withdrew"))
+		now := conformanceTime.Add(-2 * time.Hour)
+
+		// Execute
+		due, err := s.Reminders.FindDue(24*time.Hour, now, 10)
+		require.NoError(t, err)
+		tooEarly, err := s.Reminders.FindDue(time.Hour, now, 10)
+		require.NoError(t, err)
+		require.NoError(t, s.Reminders.MarkSent(interview, []time.Duration{24 * time.Hour}, now))
+		require.NoError(t, s.Reminders.MarkSent(interview, []time.Duration{24 * time.Hour, 2 * time.Hour}, now))
+		sent, err := s.Reminders.FindDue(24*time.Hour, now, 10)
+		require.NoError(t, err)
+		interview.InterviewDate = conformanceTime.Add(time.Hour)
+		require.NoError(t, s.Interviews.Update(ctx, interview))
+		rescheduled, err := s.Reminders.FindDue(24*time.Hour, now, 10)
+		require.NoError(t, err)
+
+		// Assertions
+		assert.Equal(t, []int{interview.ID}, interviewIDs(due))
+		assert.Equal(t, interview.Panel, due[0].Panel)
+		assert.Empty(t, tooEarly)
+		assert.Empty(t, sent)
+		assert.Equal(t, []int{interview.ID}, interviewIDs(rescheduled)) // Due anew for the new date
+	})
+
+	t.Run("transaction commits", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+
+		// Execute
+		err := s.Tx.WithinTx(ctx, func(ctx context.Context, repos Repositories) error {
+			if err := repos.Interviews.Cancel(ctx, interview, "withdrew"); err != nil {
+				return err
+			}
+			return repos.Interviews.Create(ctx, &domain.Interview{CandidateID: 102, JobID: 201, InterviewDate: conformanceTime})
+		})
+
+		// Assertions
+		require.NoError(t, err)
+		all, err := s.Interviews.FindAll(ctx)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+		count, err := s.Outbox.CountPending()
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+	})
+
+	t.Run("transaction rolls back on error", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+		errAbort := errors.New("abort")
+
+		// Execute
+		err := s.Tx.WithinTx(ctx, func(ctx context.Context, repos Repositories) error {
+			if err := repos.Interviews.Cancel(ctx, interview, "withdrew"); err != nil {
+				return err
+			}
+			if err := repos.Interviews.Create(ctx, &domain.Interview{CandidateID: 102, JobID: 201, InterviewDate: conformanceTime}); err != nil {
+				return err
+			}
+			return errAbort
+		})
+
+		// Assertions
+		assert.ErrorIs(t, err, errAbort)
+		assertUnchanged(t, s, interview.ID)
+	})
+
+	t.Run("transaction rolls back on panic", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		interview := createInterview(t, s, 101, 201, conformanceTime)
+
+		// Execute
+		assert.Panics(t, func() {
+			_ = s.Tx.WithinTx(ctx, func(ctx context.Context, repos Repositories) error {
+				if err := repos.Interviews.Cancel(ctx, interview, "withdrew"); err != nil {
+					return err
+				}
+				panic("boom")
+			})
+		})
+
+		// Assertions
+		assertUnchanged(t, s, interview.ID)
+	})
+
+	t.Run("concurrent writes", func(t *testing.T) {
+		// Setup
+		s := newStore(t)
+		const writers = 8
+
+		// Execute
+		var wg sync.WaitGroup
+		for w := 0; w < writers; w++ {
+			wg.Add(1)
+			go func(candidateID int) {
+				defer wg.Done()
+				interview := &domain.Interview{CandidateID: candidateID, JobID: 201, InterviewDate: conformanceTime}
+				assert.NoError(t, s.Tx.WithinTx(ctx, func(ctx context.Context, repos Repositories) error {
+					return repos.Interviews.Create(ctx, interview)
+				}))
+				assert.NoError(t, s.Interviews.Cancel(ctx, interview, "withdrew"))
+			}(100 + w)
+		}
+		wg.Wait()
+
+		// Assertions
+		cancelled, err := s.Interviews.FindByStatus(ctx, domain.StatusCancelled)
+		require.NoError(t, err)
+		assert.Len(t, cancelled, writers)
+		count, err := s.Outbox.CountPending()
+		require.NoError(t, err)
+		assert.Equal(t, 2*writers, count)
+	})
+}
+
+// createInterview stores a scheduled interview with a single panelist
+func createInterview(t *testing.T, s conformanceStore, candidateID, jobID int, date time.Time) *domain.Interview {
+	t.Helper()
+	interview := &domain.Interview{
+		CandidateID: candidateID, JobID: jobID, InterviewDate: date,
+		Panel: []domain.Panelist{{Name: "Alice", Email: "alice@example.com"}},
+	}
+	require.NoError(t, s.Interviews.Create(context.Background(), interview))
+	return interview
+}
+
+// assertUnchanged checks that a rolled back unit of work left only the interview created by the test and its event
+func assertUnchanged(t *testing.T, s conformanceStore, id int) {
+	t.Helper()
+	all, err := s.Interviews.FindAll(context.Background())
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, id, all[0].ID)
+	assert.Equal(t, domain.StatusScheduled, all[0].Status)
+	count, err := s.Outbox.CountPending()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+// interviewIDs lists the IDs of interviews in order
+func interviewIDs(interviews []*domain.Interview) []int {
+	ids := make([]int, 0, len(interviews))
+	for _, i := range interviews {
+		ids = append(ids, i.ID)
+	}
+	return ids
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"time"
+
 	"github.com/poolcamacho/interviews-service/internal/domain"
 	"github.com/stretchr/testify/mock"
 )
@@ -11,10 +14,24 @@ type MockInterviewRepository struct {
 }
 
 // FindAll mocks the FindAll method
+// @param ctx context.Context - The context of the call
 // @return []*domain.Interview - A slice of interviews
 // @return error - An error if the operation fails
-func (m *MockInterviewRepository) FindAll() ([]*domain.Interview, error) {
-	args := m.Called()
+func (m *MockInterviewRepository) FindAll(ctx context.Context) ([]*domain.Interview, error) {
+	args := m.Called(ctx)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindMatching mocks the FindMatching method
+// @param ctx context.Context - The context of the call
+// @param filter domain.InterviewFilter - The filter of the call
+// @return []*domain.Interview - A slice of interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FindMatching(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error) {
+	args := m.Called(ctx, filter)
 	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
 		return interviews, args.Error(1)
 	}
@@ -22,9 +39,117 @@ func (m *MockInterviewRepository) FindAll() ([]*domain.Interview, error) {
 }
 
 // Create mocks the Create method
+// @param ctx context.Context - The context of the call
 // @param interview *domain.Interview - The interview data to be added
 // @return error - An error if the operation fails
-func (m *MockInterviewRepository) Create(interview *domain.Interview) error {
-	args := m.Called(interview)
+func (m *MockInterviewRepository) Create(ctx context.Context, interview *domain.Interview) error {
+	args := m.Called(ctx, interview)
+	return args.Error(0)
+}
+
+// FindUpcomingByCandidate mocks the FindUpcomingByCandidate method
+// @param ctx context.Context - The context of the call
+// @param candidateID int - The ID of the candidate
+// @param from time.Time - Interviews dated after this time are returned
+// @return []*domain.Interview - A slice of interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FindUpcomingByCandidate(ctx context.Context, candidateID int, from time.Time) ([]*domain.Interview, error) {
+	args := m.Called(ctx, candidateID, from)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindUpcomingByJob mocks the FindUpcomingByJob method
+// @param ctx context.Context - The context of the call
+// @param jobID int - The ID of the job
+// @param from time.Time - Interviews dated after this time are returned
+// @return []*domain.Interview - A slice of interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FindUpcomingByJob(ctx context.Context, jobID int, from time.Time) ([]*domain.Interview, error) {
+	args := m.Called(ctx, jobID, from)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Cancel mocks the Cancel method
+// @param ctx context.Context - The context of the call
+// @param interview *domain.Interview - The interview to cancel
+// @param reason string - The reason given for the cancellation
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) Cancel(ctx context.Context, interview *domain.Interview, reason string) error {
+	args := m.Called(ctx, interview, reason)
+	return args.Error(0)
+}
+
+// FlagOverdue mocks the FlagOverdue method
+// @param ctx context.Context - The context of the call
+// @param endedBefore time.Time - Interviews dated at or before this time are considered over
+// @return int - The number of interviews flagged
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FlagOverdue(ctx context.Context, endedBefore time.Time) (int, error) {
+	args := m.Called(ctx, endedBefore)
+	return args.Int(0), args.Error(1)
+}
+
+// FindByStatus mocks the FindByStatus method
+// @param ctx context.Context - The context of the call
+// @param status domain.InterviewStatus - The status to filter by
+// @return []*domain.Interview - A slice of interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FindByStatus(ctx context.Context, status domain.InterviewStatus) ([]*domain.Interview, error) {
+	args := m.Called(ctx, status)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Resolve mocks the Resolve method
+// @param ctx context.Context - The context of the call
+// @param ids []int - The IDs of the interviews
+// @param status domain.InterviewStatus - The outcome to record
+// @return int - The number of interviews updated
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) Resolve(ctx context.Context, ids []int, status domain.InterviewStatus) (int, error) {
+	args := m.Called(ctx, ids, status)
+	return args.Int(0), args.Error(1)
+}
+
+// FindByID mocks the FindByID method
+// @param ctx context.Context - The context of the call
+// @param id int - The ID of the interview
+// @return *domain.Interview - The interview
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FindByID(ctx context.Context, id int) (*domain.Interview, error) {
+	args := m.Called(ctx, id)
+	if interview, ok := args.Get(0).(*domain.Interview); ok {
+		return interview, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindByIDs mocks the FindByIDs method
+// @param ctx context.Context - The context of the call
+// @param ids []int - The IDs of the interviews
+// @return []*domain.Interview - The interviews found
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) FindByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error) {
+	args := m.Called(ctx, ids)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// Update mocks the Update method
+// @param ctx context.Context - The context of the call
+// @param interview *domain.Interview - The interview with its new values
+// @return error - An error if the operation fails
+func (m *MockInterviewRepository) Update(ctx context.Context, interview *domain.Interview) error {
+	args := m.Called(ctx, interview)
 	return args.Error(0)
 }
@@ -0,0 +1,564 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// MemoryStore keeps interviews and their outbox in memory
+// It backs the InterviewRepository, OutboxRepository and TxManager returned by its methods, which behave like
+// their MySQL counterparts so the service can run without a database. Data is lost when the process exits.
+// Stored values are never modified in place: every change replaces the value, so keeping the previous values
+// of the entries a unit of work changed is enough to roll it back.
+type MemoryStore struct {
+	mu             sync.RWMutex                 // Guards every field below
+	interviews     map[int]*domain.Interview    // Stored interviews with their panels, keyed by ID
+	outbox         map[int]domain.OutboxMessage // Outbox messages keyed by ID
+	nextInterview  int                          // Last interview ID handed out
+	nextPanelist   int                          // Last panelist ID handed out
+	nextOutboxItem int                          // Last outbox message ID handed out
+	undo           *undoLog                     // Changes of the running unit of work; nil outside of one
+}
+
+// undoLog keeps what a unit of work changed in a MemoryStore so it can be rolled back
+type undoLog struct {
+	interviews     map[int]*domain.Interview     // Interviews before their first change, nil for the ones created
+	outbox         map[int]*domain.OutboxMessage // Outbox messages before their first change, nil for the ones created
+	nextInterview  int                           // Last interview ID handed out before the unit of work
+	nextPanelist   int                           // Last panelist ID handed out before the unit of work
+	nextOutboxItem int                           // Last outbox message ID handed out before the unit of work
+}
+
+// NewMemoryStore creates an empty MemoryStore
+// @return *MemoryStore - A store without interviews
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{interviews: make(map[int]*domain.Interview), outbox: make(map[int]domain.OutboxMessage)}
+}
+
+// Interviews returns the InterviewRepository backed by the store
+// @return InterviewRepository - A repository safe for concurrent use
+func (s *MemoryStore) Interviews() InterviewRepository {
+	return &memoryInterviewRepository{store: s}
+}
+
+// Outbox returns the OutboxRepository backed by the store
+// @return OutboxRepository - A repository safe for concurrent use
+func (s *MemoryStore) Outbox() OutboxRepository {
+	return &memoryOutboxRepository{store: s}
+}
+
+// TxManager returns a TxManager running units of work on the store
+// Units of work hold the store's lock until they return, so they never conflict and are never retried.
+// @return TxManager - A manager safe for concurrent use
+func (s *MemoryStore) TxManager() TxManager {
+	return &memoryTxManager{store: s}
+}
+
+type memoryTxManager struct {
+	store *MemoryStore // Store the units of work run on
+}
+
+// WithinTx runs fn with the store locked, undoing its changes when fn fails or panics
+// Only the entries fn changes are recorded, so the cost of a unit of work does not grow with the store.
+// @param ctx context.Context - Passed on to fn
+// @param fn func(ctx context.Context, repos Repositories) error - The unit of work
+// @return error - The error returned by fn
+func (m *memoryTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) (err error) {
+	s := m.store
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.undo = &undoLog{
+		interviews:     make(map[int]*domain.Interview),
+		outbox:         make(map[int]*domain.OutboxMessage),
+		nextInterview:  s.nextInterview,
+		nextPanelist:   s.nextPanelist,
+		nextOutboxItem: s.nextOutboxItem,
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			s.rollback()
+		}
+		s.undo = nil
+	}()
+
+	if err := fn(ctx, Repositories{Interviews: &memoryInterviewRepository{store: s, inTx: true}}); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// rollback restores the entries and IDs recorded in the undo log; the caller holds the write lock
+func (s *MemoryStore) rollback() {
+	for id, previous := range s.undo.interviews {
+		if previous == nil {
+			delete(s.interviews, id)
+		} else {
+			s.interviews[id] = previous
+		}
+	}
+	for id, previous := range s.undo.outbox {
+		if previous == nil {
+			delete(s.outbox, id)
+		} else {
+			s.outbox[id] = *previous
+		}
+	}
+	s.nextInterview, s.nextPanelist, s.nextOutboxItem = s.undo.nextInterview, s.undo.nextPanelist, s.undo.nextOutboxItem
+}
+
+// putInterview stores an interview, recording its previous value in the undo log of a running unit of work
+// The caller holds the write lock.
+// @param interview *domain.Interview - The value to store, which must not be modified afterwards
+func (s *MemoryStore) putInterview(interview *domain.Interview) {
+	if s.undo != nil {
+		if _, recorded := s.undo.interviews[interview.ID]; !recorded {
+			s.undo.interviews[interview.ID] = s.interviews[interview.ID]
+		}
+	}
+	s.interviews[interview.ID] = interview
+}
+
+// putOutbox stores an outbox message, recording its previous value in the undo log of a running unit of work
+// The caller holds the write lock.
+// @param message domain.OutboxMessage - The message to store
+func (s *MemoryStore) putOutbox(message domain.OutboxMessage) {
+	if s.undo != nil {
+		if _, recorded := s.undo.outbox[message.ID]; !recorded {
+			var previous *domain.OutboxMessage
+			if stored, ok := s.outbox[message.ID]; ok {
+				previous = &stored
+			}
+			s.undo.outbox[message.ID] = previous
+		}
+	}
+	s.outbox[message.ID] = message
+}
+
+type memoryInterviewRepository struct {
+	store *MemoryStore // Store holding the interviews
+	inTx  bool         // True inside MemoryStore.TxManager().WithinTx, where the store is already locked
+}
+
+// read locks the store for reading unless a unit of work already holds it
+// @return func() - Releases the lock
+func (r *memoryInterviewRepository) read() func() {
+	if r.inTx {
+		return func() {}
+	}
+	r.store.mu.RLock()
+	return r.store.mu.RUnlock
+}
+
+// write locks the store for writing unless a unit of work already holds it
+// @return func() - Releases the lock
+func (r *memoryInterviewRepository) write() func() {
+	if r.inTx {
+		return func() {}
+	}
+	r.store.mu.Lock()
+	return r.store.mu.Unlock
+}
+
+// FindAll returns copies of every interview ordered by ID
+func (r *memoryInterviewRepository) FindAll(ctx context.Context) ([]*domain.Interview, error) {
+	return r.filter(ctx, func(*domain.Interview) bool { return true }, orderByID)
+}
+
+// FindMatching returns copies of the interviews matching a filter ordered by ID
+func (r *memoryInterviewRepository) FindMatching(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error) {
+	interviews, err := r.filter(ctx, func(i *domain.Interview) bool {
+		return (filter.Status == "" || i.Status == filter.Status) &&
+			(filter.CandidateID == 0 || i.CandidateID == filter.CandidateID) &&
+			(filter.JobID == 0 || i.JobID == filter.JobID)
+	}, orderByID)
+	if err != nil || filter.Limit <= 0 || len(interviews) <= filter.Limit {
+		return interviews, err
+	}
+	return interviews[:filter.Limit], nil
+}
+
+// Create stores a copy of the interview and its panel and records the interview.created event
+// The interview is scheduled and given the next ID; its panelists are given IDs too.
+func (r *memoryInterviewRepository) Create(ctx context.Context, interview *domain.Interview) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer r.write()()
+	s := r.store
+
+	s.nextInterview++
+	interview.ID = s.nextInterview
+	interview.Status = domain.StatusScheduled
+	for idx := range interview.Panel {
+		s.nextPanelist++
+		interview.Panel[idx].ID = s.nextPanelist
+		interview.Panel[idx].InterviewID = interview.ID
+	}
+
+	stored := cloneInterview(interview)
+	stored.InterviewDate = stored.InterviewDate.UTC() // Dates are returned in UTC, as the databases return them
+	stored.CancellationReason = ""
+	for idx := range stored.Panel {
+		stored.Panel[idx].Feedback = "" // Panelists submit feedback later, like the name and email only INSERT
+		stored.Panel[idx].FeedbackSubmittedAt = nil
+	}
+	s.putInterview(stored)
+
+	return s.enqueue(&domain.Event{
+		Type:       domain.EventInterviewCreated,
+		Interview:  *interview,
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+// FindByID returns a copy of an interview
+func (r *memoryInterviewRepository) FindByID(ctx context.Context, id int) (*domain.Interview, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	defer r.read()()
+
+	stored, ok := r.store.interviews[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return cloneInterview(stored), nil
+}
+
+// FindByIDs returns copies of the interviews with the given IDs, skipping unknown ones
+func (r *memoryInterviewRepository) FindByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	return r.filter(ctx, func(i *domain.Interview) bool { return wanted[i.ID] }, orderByID)
+}
+
+// Update saves the date, feedback, stage and candidate email of an interview
+// Moving the date of an interview that is not scheduled fails with ErrNotScheduled; moving it records
+// the interview.rescheduled event.
+func (r *memoryInterviewRepository) Update(ctx context.Context, interview *domain.Interview) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer r.write()()
+	s := r.store
+
+	stored, ok := s.interviews[interview.ID]
+	if !ok {
+		return ErrNotFound
+	}
+	previousDate := stored.InterviewDate
+	rescheduled := !previousDate.Equal(interview.InterviewDate)
+	if rescheduled && stored.Status != domain.StatusScheduled {
+		return ErrNotScheduled
+	}
+
+	updated := cloneInterview(stored)
+	updated.InterviewDate = interview.InterviewDate.UTC()
+	updated.Feedback = interview.Feedback
+	updated.Stage = interview.Stage
+	updated.CandidateEmail = interview.CandidateEmail
+	s.putInterview(updated)
+	interview.Status = stored.Status
+
+	if !rescheduled {
+		return nil
+	}
+	return s.enqueue(&domain.Event{
+		Type:         domain.EventInterviewRescheduled,
+		Interview:    *interview,
+		PreviousDate: &previousDate,
+		OccurredAt:   time.Now().UTC(),
+	})
+}
+
+// FindUpcomingByCandidate returns copies of the candidate's scheduled interviews dated after from, soonest first
+func (r *memoryInterviewRepository) FindUpcomingByCandidate(ctx context.Context, candidateID int, from time.Time) ([]*domain.Interview, error) {
+	return r.filter(ctx, func(i *domain.Interview) bool {
+		return i.CandidateID == candidateID && i.Status == domain.StatusScheduled && i.InterviewDate.After(from)
+	}, orderByDate)
+}
+
+// FindUpcomingByJob returns copies of the job's scheduled interviews dated after from, soonest first
+func (r *memoryInterviewRepository) FindUpcomingByJob(ctx context.Context, jobID int, from time.Time) ([]*domain.Interview, error) {
+	return r.filter(ctx, func(i *domain.Interview) bool {
+		return i.JobID == jobID && i.Status == domain.StatusScheduled && i.InterviewDate.After(from)
+	}, orderByDate)
+}
+
+// Cancel marks a scheduled interview as cancelled and records the interview.cancelled event
+func (r *memoryInterviewRepository) Cancel(ctx context.Context, interview *domain.Interview, reason string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	defer r.write()()
+	s := r.store
+
+	stored, ok := s.interviews[interview.ID]
+	if !ok || stored.Status != domain.StatusScheduled {
+		return ErrNotFound
+	}
+	cancelled := cloneInterview(stored)
+	cancelled.Status = domain.StatusCancelled
+	cancelled.CancellationReason = reason
+	s.putInterview(cancelled)
+
+	interview.Status = domain.StatusCancelled
+	interview.CancellationReason = reason
+	return s.enqueue(&domain.Event{
+		Type:       domain.EventInterviewCancelled,
+		Interview:  *interview,
+		Reason:     reason,
+		OccurredAt: time.Now().UTC(),
+	})
+}
+
+// FlagOverdue moves scheduled interviews dated at or before endedBefore without feedback from the interview or its panel to needs_attention
+func (r *memoryInterviewRepository) FlagOverdue(ctx context.Context, endedBefore time.Time) (int, error) {
+	return r.setStatus(ctx, domain.StatusNeedsAttention, func(i *domain.Interview) bool {
+		return i.Status == domain.StatusScheduled && !i.InterviewDate.After(endedBefore) && i.Feedback == "" && !panelSubmitted(i)
+	})
+}
+
+// panelSubmitted reports whether a panelist of the interview has submitted feedback
+func panelSubmitted(i *domain.Interview) bool {
+	for _, p := range i.Panel {
+		if p.FeedbackSubmittedAt != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// FindByStatus returns copies of the interviews in a status, oldest first
+func (r *memoryInterviewRepository) FindByStatus(ctx context.Context, status domain.InterviewStatus) ([]*domain.Interview, error) {
+	return r.filter(ctx, func(i *domain.Interview) bool { return i.Status == status }, orderByDate)
+}
+
+// Resolve sets the status of the given interviews that are in needs_attention
+func (r *memoryInterviewRepository) Resolve(ctx context.Context, ids []int, status domain.InterviewStatus) (int, error) {
+	wanted := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	return r.setStatus(ctx, status, func(i *domain.Interview) bool {
+		return wanted[i.ID] && i.Status == domain.StatusNeedsAttention
+	})
+}
+
+// filter returns copies of the interviews matching a predicate
+// @param ctx context.Context - Checked for cancellation before reading
+// @param match func(*domain.Interview) bool - Selects the interviews to return
+// @param less func(a, b *domain.Interview) bool - Orders the result
+// @return []*domain.Interview - The matching interviews, nil when there are none
+// @return error - The context's error if it is done
+func (r *memoryInterviewRepository) filter(ctx context.Context, match func(*domain.Interview) bool,
+	less func(a, b *domain.Interview) bool) ([]*domain.Interview, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	defer r.read()()
+
+	var interviews []*domain.Interview
+	for _, stored := range r.store.interviews {
+		if match(stored) {
+			interviews = append(interviews, cloneInterview(stored))
+		}
+	}
+	sort.Slice(interviews, func(i, j int) bool { return less(interviews[i], interviews[j]) })
+	return interviews, nil
+}
+
+// setStatus changes the status of the interviews matching a predicate
+// @param ctx context.Context - Checked for cancellation before writing
+// @param status domain.InterviewStatus - The new status
+// @param match func(*domain.Interview) bool - Selects the interviews to update
+// @return int - The number of interviews updated
+// @return error - The context's error if it is done
+func (r *memoryInterviewRepository) setStatus(ctx context.Context, status domain.InterviewStatus,
+	match func(*domain.Interview) bool) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	defer r.write()()
+
+	updated := 0
+	for _, stored := range r.store.interviews {
+		if match(stored) {
+			changed := cloneInterview(stored)
+			changed.Status = status
+			r.store.putInterview(changed)
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+// enqueue records an event in the outbox; the caller holds the write lock
+// @param event *domain.Event - The event, given an ID when it has none
+// @return error - An error if no event ID could be generated
+func (s *MemoryStore) enqueue(event *domain.Event) error {
+	if err := assignEventID(event); err != nil {
+		return err
+	}
+	event.Interview = *cloneInterview(&event.Interview)
+	s.nextOutboxItem++
+	s.putOutbox(domain.OutboxMessage{
+		ID:          s.nextOutboxItem,
+		InterviewID: event.Interview.ID,
+		Event:       *event,
+		LastError:   "",
+		CreatedAt:   event.OccurredAt,
+	})
+	return nil
+}
+
+type memoryOutboxRepository struct {
+	store *MemoryStore // Store holding the outbox
+}
+
+// FindPending returns copies of the oldest due messages, holding back an interview from its first waiting message
+func (r *memoryOutboxRepository) FindPending(limit int, now time.Time) ([]*domain.OutboxMessage, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var pending []*domain.OutboxMessage
+	for _, m := range r.store.outbox {
+		if m.PublishedAt == nil && m.AbandonedAt == nil {
+			message := m
+			pending = append(pending, &message)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].ID < pending[j].ID })
+
+	var messages []*domain.OutboxMessage
+	waiting := make(map[int]bool) // Interviews with a message waiting for its next attempt
+	for _, m := range pending {
+		if m.NextAttemptAt != nil && m.NextAttemptAt.After(now) {
+			waiting[m.InterviewID] = true
+		}
+		if !waiting[m.InterviewID] && len(messages) < limit {
+			messages = append(messages, m)
+		}
+	}
+	return messages, nil
+}
+
+// MarkPublished records the time a message was relayed
+func (r *memoryOutboxRepository) MarkPublished(id int, publishedAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if m, ok := r.store.outbox[id]; ok {
+		m.PublishedAt = &publishedAt
+		r.store.putOutbox(m)
+	}
+	return nil
+}
+
+// MarkFailed counts a failed relay attempt, keeps its error and schedules the next attempt
+func (r *memoryOutboxRepository) MarkFailed(id int, lastError string, nextAttemptAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if m, ok := r.store.outbox[id]; ok {
+		m.Attempts++
+		m.LastError = lastError
+		m.NextAttemptAt = &nextAttemptAt
+		r.store.putOutbox(m)
+	}
+	return nil
+}
+
+// MarkAbandoned counts a last failed relay attempt, keeps its error and records when the message was abandoned
+func (r *memoryOutboxRepository) MarkAbandoned(id int, lastError string, abandonedAt time.Time) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if m, ok := r.store.outbox[id]; ok {
+		m.Attempts++
+		m.LastError = lastError
+		m.AbandonedAt = &abandonedAt
+		r.store.putOutbox(m)
+	}
+	return nil
+}
+
+// CountPending counts the messages that are neither published nor abandoned
+func (r *memoryOutboxRepository) CountPending() (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	count := 0
+	for _, m := range r.store.outbox {
+		if m.PublishedAt == nil && m.AbandonedAt == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// FindAfter returns copies of the messages with a higher ID, oldest first
+func (r *memoryOutboxRepository) FindAfter(afterID, limit int) ([]*domain.OutboxMessage, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	var messages []*domain.OutboxMessage
+	for _, m := range r.store.outbox {
+		if m.ID > afterID {
+			message := m
+			messages = append(messages, &message)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].ID < messages[j].ID })
+	if len(messages) > limit {
+		messages = messages[:limit]
+	}
+	return messages, nil
+}
+
+// LastID returns the ID of the latest message, 0 when there is none
+func (r *memoryOutboxRepository) LastID() (int, error) {
+	r.store.mu.RLock()
+	defer r.store.mu.RUnlock()
+
+	last := 0
+	for id := range r.store.outbox {
+		last = max(last, id)
+	}
+	return last, nil
+}
+
+// cloneInterview copies an interview and its panel so callers cannot modify stored values
+// @param i *domain.Interview - The interview to copy
+// @return *domain.Interview - The copy
+func cloneInterview(i *domain.Interview) *domain.Interview {
+	c := *i
+	if i.Panel != nil {
+		c.Panel = make([]domain.Panelist, len(i.Panel))
+		copy(c.Panel, i.Panel)
+	}
+	return &c
+}
+
+// orderByID orders interviews by ID
+func orderByID(a, b *domain.Interview) bool { return a.ID < b.ID }
+
+// orderByDate orders interviews by date, then by ID so interviews at the same time keep a stable order
+func orderByDate(a, b *domain.Interview) bool {
+	if !a.InterviewDate.Equal(b.InterviewDate) {
+		return a.InterviewDate.Before(b.InterviewDate)
+	}
+	return a.ID < b.ID
+}
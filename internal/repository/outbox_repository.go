@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// OutboxRepository defines methods for draining the outbox table
+// Messages are written by the other repositories inside their own transactions; this
+// interface only covers what the relay needs to read and acknowledge them, and what every
+// replica needs to follow the messages as they are written.
+type OutboxRepository interface {
+	// FindPending retrieves messages that are due to be relayed
+	// Skips abandoned messages, messages waiting for their next attempt, and the messages of an interview written
+	// after one that is waiting, so the relay keeps per-interview ordering. Messages whose payload cannot be
+	// decoded are abandoned instead of returned.
+	// @param limit int - The maximum number of messages to return
+	// @param now time.Time - The current time, compared to the next attempt of failed messages
+	// @return []*domain.OutboxMessage - The due messages in the order they were written
+	// @return error - An error if the query fails
+	FindPending(limit int, now time.Time) ([]*domain.OutboxMessage, error)
+
+	// MarkPublished records that a message has been relayed
+	// @param id int - The ID of the message
+	// @param publishedAt time.Time - The time at which the message was relayed
+	// @return error - An error if the query fails
+	MarkPublished(id int, publishedAt time.Time) error
+
+	// MarkFailed records a failed relay attempt so the message is retried later
+	// @param id int - The ID of the message
+	// @param lastError string - The error returned by the publisher
+	// @param nextAttemptAt time.Time - The time before which the message is not retried
+	// @return error - An error if the query fails
+	MarkFailed(id int, lastError string, nextAttemptAt time.Time) error
+
+	// MarkAbandoned records a last failed relay attempt after which the message is no longer retried
+	// @param id int - The ID of the message
+	// @param lastError string - The error of the last attempt
+	// @param abandonedAt time.Time - The time at which the relay gave up on the message
+	// @return error - An error if the query fails
+	MarkAbandoned(id int, lastError string, abandonedAt time.Time) error
+
+	// CountPending returns the number of messages that have been neither published nor abandoned
+	// @return int - The size of the backlog
+	// @return error - An error if the query fails
+	CountPending() (int, error)
+
+	// FindAfter retrieves messages written after a given message, published or not
+	// @param afterID int - The ID of the message to start after, 0 to start from the first one
+	// @param limit int - The maximum number of messages to return
+	// @return []*domain.OutboxMessage - The messages in the order they were written
+	// @return error - An error if the query fails
+	FindAfter(afterID, limit int) ([]*domain.OutboxMessage, error)
+
+	// LastID returns the ID of the latest message
+	// @return int - The highest message ID, 0 when the outbox is empty
+	// @return error - An error if the query fails
+	LastID() (int, error)
+}
+
+type outboxRepositoryImpl struct {
+	db *sql.DB // Database connection instance
+}
+
+// NewOutboxRepository creates a new OutboxRepository instance for MySQL or SQLite
+// This constructor initializes the repository with the provided database connection.
+// @param db *sql.DB - The database connection used for executing queries
+// @return OutboxRepository - An instance of the repository interface implementation
+func NewOutboxRepository(db *sql.DB) OutboxRepository {
+	return &outboxRepositoryImpl{db: db}
+}
+
+// FindPending retrieves due rows of the outbox table ordered by ID and abandons the undecodable ones
+func (r *outboxRepositoryImpl) FindPending(limit int, now time.Time) ([]*domain.OutboxMessage, error) {
+	query := `SELECT ` + outboxColumns + ` FROM outbox o
+		WHERE published_at IS NULL AND abandoned_at IS NULL AND NOT EXISTS (SELECT 1 FROM outbox w
+			WHERE w.interview_id = o.interview_id AND w.id <= o.id AND w.published_at IS NULL
+			AND w.abandoned_at IS NULL AND w.next_attempt_at > ?)
+		ORDER BY id LIMIT ?`
+	rows, err := r.db.Query(query, now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	messages, corrupt, err := scanOutboxMessages(rows)
+	if err != nil {
+		return nil, err
+	}
+	for id, decodeErr := range corrupt {
+		if err := r.MarkAbandoned(id, decodeErr, now); err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
+
+// MarkPublished sets the published_at column of an outbox row
+func (r *outboxRepositoryImpl) MarkPublished(id int, publishedAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE outbox SET published_at = ? WHERE id = ?`, publishedAt, id)
+	return err
+}
+
+// MarkFailed increments the attempts of an outbox row, stores the error and schedules the next attempt
+func (r *outboxRepositoryImpl) MarkFailed(id int, lastError string, nextAttemptAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE outbox SET attempts = attempts + 1, last_error = ?, next_attempt_at = ? WHERE id = ?`,
+		lastError, nextAttemptAt.UTC(), id)
+	return err
+}
+
+// MarkAbandoned increments the attempts of an outbox row, stores the error and sets its abandoned_at column
+func (r *outboxRepositoryImpl) MarkAbandoned(id int, lastError string, abandonedAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE outbox SET attempts = attempts + 1, last_error = ?, abandoned_at = ? WHERE id = ?`,
+		lastError, abandonedAt.UTC(), id)
+	return err
+}
+
+// CountPending counts the rows of the outbox table that are neither published nor abandoned
+func (r *outboxRepositoryImpl) CountPending() (int, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM outbox WHERE published_at IS NULL AND abandoned_at IS NULL`).Scan(&count)
+	return count, err
+}
+
+// FindAfter retrieves rows of the outbox table with a higher ID, ordered by ID, skipping the undecodable ones
+func (r *outboxRepositoryImpl) FindAfter(afterID, limit int) ([]*domain.OutboxMessage, error) {
+	rows, err := r.db.Query(`SELECT `+outboxColumns+` FROM outbox WHERE id > ? ORDER BY id LIMIT ?`, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	messages, _, err := scanOutboxMessages(rows)
+	return messages, err
+}
+
+// LastID returns the highest ID of the outbox table
+func (r *outboxRepositoryImpl) LastID() (int, error) {
+	var id int
+	err := r.db.QueryRow(`SELECT COALESCE(MAX(id), 0) FROM outbox`).Scan(&id)
+	return id, err
+}
+
+// outboxColumns are the columns of the outbox table read by scanOutboxMessages, in order
+const outboxColumns = `id, interview_id, payload, attempts, last_error, next_attempt_at, created_at, published_at, abandoned_at`
+
+// scanOutboxMessages reads outbox rows selected as outboxColumns
+// Shared by every dialect; the rows are closed once read. A row whose payload cannot be decoded is left out of
+// the messages, so one corrupt row does not stop the rows after it from being read.
+// @param rows *sql.Rows - The result of the query
+// @return []*domain.OutboxMessage - The messages, with their event decoded from the payload
+// @return map[int]string - The decoding errors of the rows left out, by message ID
+// @return error - An error if a row could not be read
+func scanOutboxMessages(rows *sql.Rows) ([]*domain.OutboxMessage, map[int]string, error) {
+	defer rows.Close()
+
+	var messages []*domain.OutboxMessage
+	corrupt := make(map[int]string)
+	for rows.Next() {
+		var m domain.OutboxMessage
+		var payload []byte
+		var nextAttemptAt, publishedAt, abandonedAt sql.NullTime
+		if err := rows.Scan(&m.ID, &m.InterviewID, &payload, &m.Attempts, &m.LastError, &nextAttemptAt,
+			&m.CreatedAt, &publishedAt, &abandonedAt); err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal(payload, &m.Event); err != nil {
+			corrupt[m.ID] = "payload could not be decoded: " + err.Error()
+			continue
+		}
+		m.CreatedAt = m.CreatedAt.UTC()
+		m.NextAttemptAt, m.PublishedAt, m.AbandonedAt = utcTime(nextAttemptAt), utcTime(publishedAt), utcTime(abandonedAt)
+		messages = append(messages, &m)
+	}
+	return messages, corrupt, rows.Err()
+}
+
+// utcTime converts a nullable column to a time in UTC
+// @param t sql.NullTime - The value read from the column
+// @return *time.Time - The time in UTC, nil when the column is NULL
+func utcTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	utc := t.Time.UTC()
+	return &utc
+}
+
+// enqueueEvent writes an event to the outbox table as part of the caller's transaction
+// Assigns the event a random ID when it has none so consumers can deduplicate redeliveries.
+// @param ctx context.Context - Cancels the insert and carries its deadline
+// @param tx *sql.Tx - The transaction performing the change the event describes
+// @param event *domain.Event - The event to record
+// @return error - An error if the query fails
+func enqueueEvent(ctx context.Context, tx *sql.Tx, event *domain.Event) error {
+	if err := assignEventID(event); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO outbox (event_id, interview_id, event_type, payload, attempts, last_error, created_at) VALUES (?, ?, ?, ?, 0, '', ?)`,
+		event.ID, event.Interview.ID, string(event.Type), payload, event.OccurredAt)
+	return err
+}
+
+// assignEventID gives an event a random ID when it has none so consumers can deduplicate redeliveries
+// @param event *domain.Event - The event to identify
+// @return error - An error if no random bytes could be read
+func assignEventID(event *domain.Event) error {
+	if event.ID != "" {
+		return nil
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	event.ID = hex.EncodeToString(b)
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockOutboxRepository is a mock implementation of OutboxRepository for testing
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+// FindPending mocks the FindPending method
+func (m *MockOutboxRepository) FindPending(limit int, now time.Time) ([]*domain.OutboxMessage, error) {
+	args := m.Called(limit, now)
+	if messages, ok := args.Get(0).([]*domain.OutboxMessage); ok {
+		return messages, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// MarkPublished mocks the MarkPublished method
+func (m *MockOutboxRepository) MarkPublished(id int, publishedAt time.Time) error {
+	args := m.Called(id, publishedAt)
+	return args.Error(0)
+}
+
+// MarkFailed mocks the MarkFailed method
+func (m *MockOutboxRepository) MarkFailed(id int, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(id, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+// MarkAbandoned mocks the MarkAbandoned method
+func (m *MockOutboxRepository) MarkAbandoned(id int, lastError string, abandonedAt time.Time) error {
+	args := m.Called(id, lastError, abandonedAt)
+	return args.Error(0)
+}
+
+// CountPending mocks the CountPending method
+func (m *MockOutboxRepository) CountPending() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
+
+// FindAfter mocks the FindAfter method
+func (m *MockOutboxRepository) FindAfter(afterID, limit int) ([]*domain.OutboxMessage, error) {
+	args := m.Called(afterID, limit)
+	if messages, ok := args.Get(0).([]*domain.OutboxMessage); ok {
+		return messages, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// LastID mocks the LastID method
+func (m *MockOutboxRepository) LastID() (int, error) {
+	args := m.Called()
+	return args.Int(0), args.Error(1)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+type postgresFeedbackRepository struct {
+	db *sql.DB // Database connection instance
+}
+
+// NewPostgresFeedbackRepository creates a new FeedbackRepository instance backed by PostgreSQL
+// It behaves like the MySQL repository returned by NewFeedbackRepository on the schema of the postgres migrations.
+// @param db *sql.DB - The database connection used for executing queries, opened with the pgx driver
+// @return FeedbackRepository - An instance of the repository interface implementation
+func NewPostgresFeedbackRepository(db *sql.DB) FeedbackRepository {
+	return &postgresFeedbackRepository{db: db}
+}
+
+// SubmitFeedback stores the feedback of a panelist
+// PostgreSQL counts matched rows as affected, so no rows means the panelist is not part of the interview.
+func (r *postgresFeedbackRepository) SubmitFeedback(interviewID, panelistID int, feedback string, submittedAt time.Time) error {
+	result, err := r.db.Exec(`UPDATE interview_panelists SET feedback = $1, feedback_submitted_at = COALESCE(feedback_submitted_at, $2)
+		WHERE id = $3 AND interview_id = $4`, feedback, submittedAt, panelistID, interviewID)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// FindAssignments retrieves the feedback owed for interviews held in a period
+func (r *postgresFeedbackRepository) FindAssignments(from, to time.Time) ([]*domain.FeedbackAssignment, error) {
+	query := `SELECT i.` + strings.ReplaceAll(interviewColumns, ", ", ", i.") + `,
+			p.id, p.interview_id, p.name, p.email, COALESCE(p.feedback, ''), p.feedback_submitted_at,
+			(SELECT COALESCE(MAX(n.level), 0) FROM feedback_nudges n WHERE n.panelist_id = p.id)
+		FROM interview_panelists p JOIN interviews i ON i.id = p.interview_id
+		WHERE i.interview_date >= $1 AND i.interview_date < $2 AND i.status NOT IN ($3, $4)
+		ORDER BY i.interview_date, p.id`
+	rows, err := r.db.Query(query, from, to, string(domain.StatusCancelled), string(domain.StatusNoShow))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []*domain.FeedbackAssignment
+	for rows.Next() {
+		var a domain.FeedbackAssignment
+		var submittedAt sql.NullTime
+		dest := append(interviewDest(&a.Interview), &a.Panelist.ID, &a.Panelist.InterviewID, &a.Panelist.Name,
+			&a.Panelist.Email, &a.Panelist.Feedback, &submittedAt, &a.NudgeLevel)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		a.Interview.InterviewDate = a.Interview.InterviewDate.UTC()
+		if submittedAt.Valid {
+			submitted := submittedAt.Time.UTC()
+			a.Panelist.FeedbackSubmittedAt = &submitted
+		}
+		assignments = append(assignments, &a)
+	}
+	return assignments, rows.Err()
+}
+
+// MarkNudged records that a nudge about missing feedback was sent
+// Conflicts are ignored so recording the same nudge twice is harmless.
+func (r *postgresFeedbackRepository) MarkNudged(panelistID, level int, sentAt time.Time) error {
+	_, err := r.db.Exec(`INSERT INTO feedback_nudges (panelist_id, level, sent_at) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING`,
+		panelistID, level, sentAt)
+	return err
+}
@@ -0,0 +1,352 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+type postgresInterviewRepository struct {
+	db           *sql.DB       // Database connection instance, nil when bound to a transaction
+	tx           *sql.Tx       // Transaction of the unit of work the repository belongs to, nil outside of TxManager.WithinTx
+	queryTimeout time.Duration // Deadline of each call, including its transaction; zero for none
+}
+
+// NewPostgresInterviewRepository creates a new InterviewRepository instance backed by PostgreSQL
+// It behaves like the MySQL repository returned by NewInterviewRepository on the schema of the postgres migrations.
+// @param db *sql.DB - The database connection used for executing queries, opened with the pgx driver
+// @param queryTimeout time.Duration - How long a call may run before it is cancelled, zero to only rely on the caller's context
+// @return InterviewRepository - An instance of the repository interface implementation
+func NewPostgresInterviewRepository(db *sql.DB, queryTimeout time.Duration) InterviewRepository {
+	return &postgresInterviewRepository{db: db, queryTimeout: queryTimeout}
+}
+
+// conn returns what the queries of a call run on
+// @return dbtx - The transaction the repository is bound to, or the database connection
+func (r *postgresInterviewRepository) conn() dbtx {
+	if r.tx != nil {
+		return r.tx
+	}
+	return r.db
+}
+
+// withinTx runs the writes of a call atomically
+// Reuses the transaction the repository is bound to, otherwise starts one bounded by the query timeout.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param fn func(ctx context.Context, tx *sql.Tx) error - The writes to run on the transaction
+// @return error - The error returned by fn, or an error if the transaction could not be started or committed
+func (r *postgresInterviewRepository) withinTx(ctx context.Context, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	if r.tx != nil {
+		return fn(ctx, r.tx)
+	}
+	return runInTx(ctx, r.db, r.queryTimeout, fn)
+}
+
+// FindAll retrieves all interviews ordered by ID
+func (r *postgresInterviewRepository) FindAll(ctx context.Context) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews ORDER BY id`)
+}
+
+// FindMatching retrieves the interviews matching a filter, ordered by ID and limited in the database
+func (r *postgresInterviewRepository) FindMatching(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error) {
+	columns, args := filterConditions(filter)
+	query := `SELECT ` + interviewColumns + ` FROM interviews WHERE TRUE`
+	for i, column := range columns {
+		query += ` AND ` + column + ` = $` + strconv.Itoa(i+1)
+	}
+	query += ` ORDER BY id`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += ` LIMIT $` + strconv.Itoa(len(args))
+	}
+	return r.queryInterviews(ctx, query, args...)
+}
+
+// Create inserts an interview, its panelists and its interview.created outbox event in a single transaction
+// Identifiers are read back with RETURNING since PostgreSQL has no LastInsertId.
+func (r *postgresInterviewRepository) Create(ctx context.Context, interview *domain.Interview) error {
+	return r.withinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		interview.Status = domain.StatusScheduled
+		err := tx.QueryRowContext(ctx, `INSERT INTO interviews (candidate_id, job_id, interview_date, feedback, candidate_email, status, cancellation_reason, stage)
+			VALUES ($1, $2, $3, $4, $5, $6, '', $7) RETURNING id`,
+			interview.CandidateID, interview.JobID, interview.InterviewDate.UTC(), interview.Feedback,
+			interview.CandidateEmail, string(interview.Status), interview.Stage).Scan(&interview.ID)
+		if err != nil {
+			return err
+		}
+
+		for idx := range interview.Panel {
+			panelist := &interview.Panel[idx]
+			panelist.InterviewID = interview.ID
+			if err := tx.QueryRowContext(ctx, `INSERT INTO interview_panelists (interview_id, name, email) VALUES ($1, $2, $3) RETURNING id`,
+				panelist.InterviewID, panelist.Name, panelist.Email).Scan(&panelist.ID); err != nil {
+				return err
+			}
+		}
+
+		return enqueuePostgresEvent(ctx, tx, &domain.Event{
+			Type:       domain.EventInterviewCreated,
+			Interview:  *interview,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+}
+
+// FindByID retrieves an interview with its panel, or ErrNotFound
+func (r *postgresInterviewRepository) FindByID(ctx context.Context, id int) (*domain.Interview, error) {
+	interviews, err := r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(interviews) == 0 {
+		return nil, ErrNotFound
+	}
+	return interviews[0], nil
+}
+
+// FindByIDs retrieves several interviews with their panels in a single round trip
+func (r *postgresInterviewRepository) FindByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews WHERE id IN (`+placeholders(1, len(ids))+`)`, args...)
+}
+
+// Update saves the date, feedback, stage and candidate email of an interview
+// Locks the row to read the previous date and status and records the interview.rescheduled outbox event
+// when the date moved, as the MySQL repository does.
+func (r *postgresInterviewRepository) Update(ctx context.Context, interview *domain.Interview) error {
+	return r.withinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var previousDate time.Time
+		var status domain.InterviewStatus
+		err := tx.QueryRowContext(ctx, `SELECT interview_date, status FROM interviews WHERE id = $1 FOR UPDATE`, interview.ID).
+			Scan(&previousDate, &status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return err
+		}
+		previousDate = previousDate.UTC()
+
+		rescheduled := !previousDate.Equal(interview.InterviewDate)
+		if rescheduled && status != domain.StatusScheduled {
+			return ErrNotScheduled
+		}
+
+		if _, err := tx.ExecContext(ctx, `UPDATE interviews SET interview_date = $1, feedback = $2, stage = $3, candidate_email = $4 WHERE id = $5`,
+			interview.InterviewDate.UTC(), interview.Feedback, interview.Stage, interview.CandidateEmail, interview.ID); err != nil {
+			return err
+		}
+		interview.Status = status
+
+		if !rescheduled {
+			return nil
+		}
+		return enqueuePostgresEvent(ctx, tx, &domain.Event{
+			Type:         domain.EventInterviewRescheduled,
+			Interview:    *interview,
+			PreviousDate: &previousDate,
+			OccurredAt:   time.Now().UTC(),
+		})
+	})
+}
+
+// FindUpcomingByCandidate retrieves the scheduled interviews of a candidate dated after from, soonest first
+func (r *postgresInterviewRepository) FindUpcomingByCandidate(ctx context.Context, candidateID int, from time.Time) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews
+		WHERE candidate_id = $1 AND status = $2 AND interview_date > $3 ORDER BY interview_date, id`,
+		candidateID, string(domain.StatusScheduled), from.UTC())
+}
+
+// FindUpcomingByJob retrieves the scheduled interviews for a job dated after from, soonest first
+func (r *postgresInterviewRepository) FindUpcomingByJob(ctx context.Context, jobID int, from time.Time) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews
+		WHERE job_id = $1 AND status = $2 AND interview_date > $3 ORDER BY interview_date, id`,
+		jobID, string(domain.StatusScheduled), from.UTC())
+}
+
+// Cancel marks a scheduled interview as cancelled and records the interview.cancelled outbox event
+func (r *postgresInterviewRepository) Cancel(ctx context.Context, interview *domain.Interview, reason string) error {
+	return r.withinTx(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, `UPDATE interviews SET status = $1, cancellation_reason = $2 WHERE id = $3 AND status = $4`,
+			string(domain.StatusCancelled), reason, interview.ID, string(domain.StatusScheduled))
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected == 0 {
+			return ErrNotFound
+		}
+
+		interview.Status = domain.StatusCancelled
+		interview.CancellationReason = reason
+		return enqueuePostgresEvent(ctx, tx, &domain.Event{
+			Type:       domain.EventInterviewCancelled,
+			Interview:  *interview,
+			Reason:     reason,
+			OccurredAt: time.Now().UTC(),
+		})
+	})
+}
+
+// FlagOverdue moves scheduled interviews dated at or before endedBefore without feedback from the interview or its panel to needs_attention
+func (r *postgresInterviewRepository) FlagOverdue(ctx context.Context, endedBefore time.Time) (int, error) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	result, err := r.conn().ExecContext(ctx, `UPDATE interviews SET status = $1 WHERE status = $2 AND interview_date <= $3 AND feedback = ''
+		AND NOT EXISTS (`+submittedFeedback+`)`,
+		string(domain.StatusNeedsAttention), string(domain.StatusScheduled), endedBefore.UTC())
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// FindByStatus retrieves the interviews in a status, oldest first
+func (r *postgresInterviewRepository) FindByStatus(ctx context.Context, status domain.InterviewStatus) ([]*domain.Interview, error) {
+	return r.queryInterviews(ctx, `SELECT `+interviewColumns+` FROM interviews WHERE status = $1 ORDER BY interview_date, id`, string(status))
+}
+
+// Resolve sets the status of the given interviews that are in needs_attention
+func (r *postgresInterviewRepository) Resolve(ctx context.Context, ids []int, status domain.InterviewStatus) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	args := []interface{}{string(status), string(domain.StatusNeedsAttention)}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	result, err := r.conn().ExecContext(ctx, `UPDATE interviews SET status = $1 WHERE status = $2 AND id IN (`+placeholders(3, len(ids))+`)`, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	return int(affected), err
+}
+
+// queryInterviews runs a query returning interviews rows and loads their panels
+// Both queries share a single query timeout. Dates are returned in UTC, as the MySQL driver does with loc=UTC.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param query string - A SELECT query returning interviewColumns
+// @param args ...interface{} - The query arguments
+// @return []*domain.Interview - The matching interviews
+// @return error - An error if the query execution fails
+func (r *postgresInterviewRepository) queryInterviews(ctx context.Context, query string, args ...interface{}) ([]*domain.Interview, error) {
+	ctx, cancel := withTimeout(ctx, r.queryTimeout)
+	defer cancel()
+	rows, err := r.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interviews []*domain.Interview
+	for rows.Next() {
+		var i domain.Interview
+		if err := rows.Scan(interviewDest(&i)...); err != nil {
+			return nil, err
+		}
+		i.InterviewDate = i.InterviewDate.UTC()
+		interviews = append(interviews, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := r.loadPanels(ctx, interviews); err != nil {
+		return nil, err
+	}
+	return interviews, nil
+}
+
+// loadPanels attaches panelists to the given interviews with a single query
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param interviews []*domain.Interview - The interviews whose panels should be loaded
+// @return error - An error if the query execution fails
+func (r *postgresInterviewRepository) loadPanels(ctx context.Context, interviews []*domain.Interview) error {
+	if len(interviews) == 0 {
+		return nil
+	}
+
+	byID := make(map[int]*domain.Interview, len(interviews))
+	args := make([]interface{}, 0, len(interviews))
+	for _, i := range interviews {
+		byID[i.ID] = i
+		args = append(args, i.ID)
+	}
+
+	query := `SELECT id, interview_id, name, email, COALESCE(feedback, ''), feedback_submitted_at FROM interview_panelists WHERE interview_id IN (` +
+		placeholders(1, len(args)) + `) ORDER BY id`
+	rows, err := r.conn().QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var p domain.Panelist
+		var submittedAt sql.NullTime
+		if err := rows.Scan(&p.ID, &p.InterviewID, &p.Name, &p.Email, &p.Feedback, &submittedAt); err != nil {
+			return err
+		}
+		if submittedAt.Valid {
+			submitted := submittedAt.Time.UTC()
+			p.FeedbackSubmittedAt = &submitted
+		}
+		if i, ok := byID[p.InterviewID]; ok {
+			i.Panel = append(i.Panel, p)
+		}
+	}
+	return rows.Err()
+}
+
+// enqueuePostgresEvent writes an event to the outbox table as part of the caller's transaction
+// @param ctx context.Context - Cancels the insert and carries its deadline
+// @param tx *sql.Tx - The transaction performing the change the event describes
+// @param event *domain.Event - The event to record, given an ID when it has none
+// @return error - An error if the query fails
+func enqueuePostgresEvent(ctx context.Context, tx *sql.Tx, event *domain.Event) error {
+	if err := assignEventID(event); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO outbox (event_id, interview_id, event_type, payload, attempts, last_error, created_at) VALUES ($1, $2, $3, $4, 0, '', $5)`,
+		event.ID, event.Interview.ID, string(event.Type), string(payload), event.OccurredAt)
+	return err
+}
+
+// placeholders returns n numbered PostgreSQL placeholders separated by commas
+// @param from int - The number of the first placeholder, e.g. 3 for $3
+// @param n int - The number of placeholders
+// @return string - The placeholders, e.g. "$3,$4"
+func placeholders(from, n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString("$" + strconv.Itoa(from+i))
+	}
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+type postgresOutboxRepository struct {
+	db *sql.DB // Database connection instance
+}
+
+// NewPostgresOutboxRepository creates a new OutboxRepository instance backed by PostgreSQL
+// This constructor initializes the repository with the provided database connection.
+// @param db *sql.DB - The database connection used for executing queries, opened with the pgx driver
+// @return OutboxRepository - An instance of the repository interface implementation
+func NewPostgresOutboxRepository(db *sql.DB) OutboxRepository {
+	return &postgresOutboxRepository{db: db}
+}
+
+// FindPending retrieves due rows of the outbox table ordered by ID and abandons the undecodable ones
+func (r *postgresOutboxRepository) FindPending(limit int, now time.Time) ([]*domain.OutboxMessage, error) {
+	rows, err := r.db.Query(`SELECT `+outboxColumns+` FROM outbox o
+		WHERE published_at IS NULL AND abandoned_at IS NULL AND NOT EXISTS (SELECT 1 FROM outbox w
+			WHERE w.interview_id = o.interview_id AND w.id <= o.id AND w.published_at IS NULL
+			AND w.abandoned_at IS NULL AND w.next_attempt_at > $1)
+		ORDER BY id LIMIT $2`, now.UTC(), limit)
+	if err != nil {
+		return nil, err
+	}
+	messages, corrupt, err := scanOutboxMessages(rows)
+	if err != nil {
+		return nil, err
+	}
+	for id, decodeErr := range corrupt {
+		if err := r.MarkAbandoned(id, decodeErr, now); err != nil {
+			return nil, err
+		}
+	}
+	return messages, nil
+}
+
+// MarkPublished sets the published_at column of an outbox row
+func (r *postgresOutboxRepository) MarkPublished(id int, publishedAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE outbox SET published_at = $1 WHERE id = $2`, publishedAt, id)
+	return err
+}
+
+// MarkFailed increments the attempts of an outbox row, stores the error and schedules the next attempt
+func (r *postgresOutboxRepository) MarkFailed(id int, lastError string, nextAttemptAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE outbox SET attempts = attempts + 1, last_error = $1, next_attempt_at = $2 WHERE id = $3`,
+		lastError, nextAttemptAt.UTC(), id)
+	return err
+}
+
+// MarkAbandoned increments the attempts of an outbox row, stores the error and sets its abandoned_at column
+func (r *postgresOutboxRepository) MarkAbandoned(id int, lastError string, abandonedAt time.Time) error {
+	_, err := r.db.Exec(`UPDATE outbox SET attempts = attempts + 1, last_error = $1, abandoned_at = $2 WHERE id = $3`,
+		lastError, abandonedAt.UTC(), id)
+	return err
+}
+
+// CountPending counts the rows of the outbox table that are neither published nor abandoned
+func (r *postgresOutboxRepository) CountPending() (int, error) {
+	var count int
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM outbox WHERE published_at IS NULL AND abandoned_at IS NULL`).Scan(&count)
+	return count, err
+}
+
+// FindAfter retrieves rows of the outbox table with a higher ID, ordered by ID, skipping the undecodable ones
+func (r *postgresOutboxRepository) FindAfter(afterID, limit int) ([]*domain.OutboxMessage, error) {
+	rows, err := r.db.Query(`SELECT `+outboxColumns+` FROM outbox WHERE id > $1 ORDER BY id LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+	messages, _, err := scanOutboxMessages(rows)
+	return messages, err
+}
+
+// LastID returns the highest ID of the outbox table
+func (r *postgresOutboxRepository) LastID() (int, error) {
+	var id int
+	err := r.db.QueryRow(`SELECT COALESCE(MAX(id), 0) FROM outbox`).Scan(&id)
+	return id, err
+}
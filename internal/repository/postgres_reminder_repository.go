@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+type postgresReminderRepository struct {
+	db        *sql.DB                      // Database connection instance
+	interview *postgresInterviewRepository // Used to load interviews with their panels
+}
+
+// NewPostgresReminderRepository creates a new ReminderRepository instance backed by PostgreSQL
+// It behaves like the MySQL repository returned by NewReminderRepository on the schema of the postgres migrations.
+// @param db *sql.DB - The database connection used for executing queries, opened with the pgx driver
+// @return ReminderRepository - An instance of the repository interface implementation
+func NewPostgresReminderRepository(db *sql.DB) ReminderRepository {
+	return &postgresReminderRepository{db: db, interview: &postgresInterviewRepository{db: db}}
+}
+
+// FindDue retrieves scheduled interviews dated between now and now+offset without a reminder for the offset and their current date
+func (r *postgresReminderRepository) FindDue(offset time.Duration, now time.Time, limit int) ([]*domain.Interview, error) {
+	return r.interview.queryInterviews(context.Background(), `SELECT `+interviewColumns+` FROM interviews i
+		WHERE status = $1 AND interview_date > $2 AND interview_date <= $3
+		AND NOT EXISTS (SELECT 1 FROM interview_reminders r
+			WHERE r.interview_id = i.id AND r.offset_minutes = $4 AND r.interview_date = i.interview_date)
+		ORDER BY interview_date LIMIT $5`,
+		string(domain.StatusScheduled), now, now.Add(offset), offsetMinutes(offset), limit)
+}
+
+// MarkSent records sent reminders
+// Conflicts are ignored so recording the same reminder twice is harmless.
+func (r *postgresReminderRepository) MarkSent(interview *domain.Interview, offsets []time.Duration, sentAt time.Time) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, 4*len(offsets))
+	values := make([]string, 0, len(offsets))
+	for _, offset := range offsets {
+		values = append(values, `(`+placeholders(len(args)+1, 4)+`)`)
+		args = append(args, interview.ID, offsetMinutes(offset), interview.InterviewDate, sentAt)
+	}
+	query := `INSERT INTO interview_reminders (interview_id, offset_minutes, interview_date, sent_at) VALUES ` +
+		strings.Join(values, ",") + ` ON CONFLICT DO NOTHING`
+	_, err := r.db.Exec(query, args...)
+	return err
+}
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+type postgresWebhookRepository struct {
+	db *sql.DB // Database connection instance
+}
+
+// NewPostgresWebhookRepository creates a new WebhookRepository instance backed by PostgreSQL
+// It behaves like the MySQL repository returned by NewWebhookRepository on the schema of the postgres migrations.
+// @param db *sql.DB - The database connection used for executing queries, opened with the pgx driver
+// @return WebhookRepository - An instance of the repository interface implementation
+func NewPostgresWebhookRepository(db *sql.DB) WebhookRepository {
+	return &postgresWebhookRepository{db: db}
+}
+
+// CreateSubscription inserts a new subscription into the webhook_subscriptions table
+// The identifier is read back with RETURNING since PostgreSQL has no LastInsertId.
+func (r *postgresWebhookRepository) CreateSubscription(sub *domain.WebhookSubscription) error {
+	return r.db.QueryRow(`INSERT INTO webhook_subscriptions (url, secret, event_types, created_at) VALUES ($1, $2, $3, $4) RETURNING id`,
+		sub.URL, sub.Secret, joinEventTypes(sub.EventTypes), sub.CreatedAt).Scan(&sub.ID)
+}
+
+// FindAllSubscriptions retrieves every row of the webhook_subscriptions table
+func (r *postgresWebhookRepository) FindAllSubscriptions() ([]*domain.WebhookSubscription, error) {
+	rows, err := r.db.Query(`SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*domain.WebhookSubscription
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, rows.Err()
+}
+
+// FindSubscriptionByID retrieves a single row of the webhook_subscriptions table
+func (r *postgresWebhookRepository) FindSubscriptionByID(id int) (*domain.WebhookSubscription, error) {
+	row := r.db.QueryRow(`SELECT `+subscriptionColumns+` FROM webhook_subscriptions WHERE id = $1`, id)
+	sub, err := scanSubscription(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return sub, err
+}
+
+// DeleteSubscription removes a subscription together with its delivery log
+func (r *postgresWebhookRepository) DeleteSubscription(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // No-op once the transaction has been committed
+
+	if _, err := tx.Exec(`DELETE FROM webhook_deliveries WHERE subscription_id = $1`, id); err != nil {
+		return err
+	}
+	result, err := tx.Exec(`DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// CreateDelivery inserts a new row into the webhook_deliveries table
+// A duplicate of the subscription and event IDs is kept as is; the no-op update makes RETURNING report its ID.
+func (r *postgresWebhookRepository) CreateDelivery(d *domain.WebhookDelivery) error {
+	query := `INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts, response_code, last_error, next_attempt_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (subscription_id, event_id) DO UPDATE SET event_id = excluded.event_id RETURNING id`
+	return r.db.QueryRow(query, d.SubscriptionID, d.EventID, string(d.EventType), string(d.Payload), string(d.Status),
+		d.Attempts, d.ResponseCode, d.LastError, d.NextAttemptAt, d.CreatedAt, d.UpdatedAt).Scan(&d.ID)
+}
+
+// UpdateDelivery saves the mutable columns of a webhook_deliveries row
+func (r *postgresWebhookRepository) UpdateDelivery(d *domain.WebhookDelivery) error {
+	query := `UPDATE webhook_deliveries SET status = $1, attempts = $2, response_code = $3, last_error = $4, next_attempt_at = $5, updated_at = $6 WHERE id = $7`
+	_, err := r.db.Exec(query, string(d.Status), d.Attempts, d.ResponseCode, d.LastError, d.NextAttemptAt, d.UpdatedAt, d.ID)
+	return err
+}
+
+// FindDeliveryByID retrieves a single row of the webhook_deliveries table
+func (r *postgresWebhookRepository) FindDeliveryByID(id int) (*domain.WebhookDelivery, error) {
+	row := r.db.QueryRow(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE id = $1`, id)
+	d, err := scanDelivery(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return d, err
+}
+
+// FindDueDeliveries retrieves pending deliveries whose next attempt time has passed
+func (r *postgresWebhookRepository) FindDueDeliveries(now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	return r.queryDeliveries(`SELECT `+deliveryColumns+` FROM webhook_deliveries
+		WHERE status = $1 AND next_attempt_at <= $2 ORDER BY next_attempt_at, id LIMIT $3`,
+		string(domain.DeliveryPending), now, limit)
+}
+
+// FindDeliveriesBySubscription retrieves the deliveries of one subscription
+func (r *postgresWebhookRepository) FindDeliveriesBySubscription(subscriptionID int) ([]*domain.WebhookDelivery, error) {
+	return r.queryDeliveries(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE subscription_id = $1 ORDER BY id DESC`, subscriptionID)
+}
+
+// FindDeliveriesByStatus retrieves the deliveries with a given status
+func (r *postgresWebhookRepository) FindDeliveriesByStatus(status domain.DeliveryStatus) ([]*domain.WebhookDelivery, error) {
+	return r.queryDeliveries(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE status = $1 ORDER BY id DESC`, string(status))
+}
+
+// queryDeliveries runs a query returning webhook_deliveries rows
+func (r *postgresWebhookRepository) queryDeliveries(query string, args ...interface{}) ([]*domain.WebhookDelivery, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*domain.WebhookDelivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
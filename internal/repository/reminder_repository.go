@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// ReminderRepository defines methods for tracking interview reminders
+// Every reminder sent is recorded in the interview_reminders table together with the interview
+// date it was sent for, so restarts do not send it again while a reschedule makes it due anew.
+type ReminderRepository interface {
+	// FindDue retrieves scheduled interviews starting within offset of now that have not been reminded for that offset
+	// @param offset time.Duration - How long before the interview the reminder is sent
+	// @param now time.Time - The current time
+	// @param limit int - The maximum number of interviews to return
+	// @return []*domain.Interview - The interviews due for a reminder, soonest first
+	// @return error - An error if the query fails
+	FindDue(offset time.Duration, now time.Time, limit int) ([]*domain.Interview, error)
+
+	// MarkSent records that reminders were sent for an interview
+	// @param interview *domain.Interview - The interview that was reminded
+	// @param offsets []time.Duration - The reminder offsets to record as sent
+	// @param sentAt time.Time - The time at which the reminder was sent
+	// @return error - An error if the query fails
+	MarkSent(interview *domain.Interview, offsets []time.Duration, sentAt time.Time) error
+}
+
+type reminderRepositoryImpl struct {
+	db        *sql.DB                  // Database connection instance
+	interview *interviewRepositoryImpl // Used to load interviews with their panels
+	sqlite    bool                     // True on SQLite, which spells INSERT IGNORE as INSERT OR IGNORE
+}
+
+// NewReminderRepository creates a new ReminderRepository instance
+// This constructor initializes the repository with the provided database connection.
+// @param db *sql.DB - The database connection used for executing queries
+// @return ReminderRepository - An instance of the repository interface implementation
+func NewReminderRepository(db *sql.DB) ReminderRepository {
+	return &reminderRepositoryImpl{db: db, interview: &interviewRepositoryImpl{db: db}}
+}
+
+// NewSQLiteReminderRepository creates a new ReminderRepository instance backed by SQLite
+// SQLite shares the placeholders of MySQL, so the same queries run on the schema of the sqlite migrations.
+// @param db *sql.DB - The database connection used for executing queries, opened by db.Connect with a sqlite:// URL
+// @return ReminderRepository - An instance of the repository interface implementation
+func NewSQLiteReminderRepository(db *sql.DB) ReminderRepository {
+	return &reminderRepositoryImpl{db: db, interview: &interviewRepositoryImpl{db: db, sqlite: true}, sqlite: true}
+}
+
+// FindDue retrieves interviews due for a reminder
+// Executes a SELECT query on scheduled interviews dated between now and now+offset that have
+// no interview_reminders row for the offset and their current date.
+// @param offset time.Duration - How long before the interview the reminder is sent
+// @param now time.Time - The current time
+// @param limit int - The maximum number of interviews to return
+// @return []*domain.Interview - The interviews due for a reminder
+// @return error - An error if the query execution fails
+func (r *reminderRepositoryImpl) FindDue(offset time.Duration, now time.Time, limit int) ([]*domain.Interview, error) {
+	return r.interview.queryInterviews(context.Background(), `SELECT `+interviewColumns+` FROM interviews i
+		WHERE status = ? AND interview_date > ? AND interview_date <= ?
+		AND NOT EXISTS (SELECT 1 FROM interview_reminders r
+			WHERE r.interview_id = i.id AND r.offset_minutes = ? AND r.interview_date = i.interview_date)
+		ORDER BY interview_date LIMIT ?`,
+		string(domain.StatusScheduled), now.UTC(), now.Add(offset).UTC(), offsetMinutes(offset), limit)
+}
+
+// MarkSent records sent reminders
+// Executes an INSERT IGNORE, or INSERT OR IGNORE on SQLite, so recording the same reminder twice is harmless.
+// @param interview *domain.Interview - The interview that was reminded
+// @param offsets []time.Duration - The reminder offsets to record as sent
+// @param sentAt time.Time - The time at which the reminder was sent
+// @return error - An error if the query execution fails
+func (r *reminderRepositoryImpl) MarkSent(interview *domain.Interview, offsets []time.Duration, sentAt time.Time) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	args := make([]interface{}, 0, 4*len(offsets))
+	for _, offset := range offsets {
+		args = append(args, interview.ID, offsetMinutes(offset), interview.InterviewDate.UTC(), sentAt.UTC())
+	}
+	query := insertIgnore(r.sqlite) + ` INTO interview_reminders (interview_id, offset_minutes, interview_date, sent_at) VALUES ` +
+		strings.TrimSuffix(strings.Repeat("(?, ?, ?, ?),", len(offsets)), ",")
+	_, err := r.db.Exec(query, args...)
+	return err
+}
+
+// insertIgnore returns the start of an INSERT that skips rows clashing with a unique key
+// @param sqlite bool - True on SQLite
+// @return string - INSERT OR IGNORE on SQLite, INSERT IGNORE on MySQL
+func insertIgnore(sqlite bool) string {
+	if sqlite {
+		return `INSERT OR IGNORE`
+	}
+	return `INSERT IGNORE`
+}
+
+// offsetMinutes converts a reminder offset to the whole minutes stored in interview_reminders
+func offsetMinutes(offset time.Duration) int {
+	return int(offset / time.Minute)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockReminderRepository is a mock implementation of ReminderRepository for testing
+type MockReminderRepository struct {
+	mock.Mock
+}
+
+// FindDue mocks the FindDue method
+func (m *MockReminderRepository) FindDue(offset time.Duration, now time.Time, limit int) ([]*domain.Interview, error) {
+	args := m.Called(offset, now, limit)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// MarkSent mocks the MarkSent method
+func (m *MockReminderRepository) MarkSent(interview *domain.Interview, offsets []time.Duration, sentAt time.Time) error {
+	args := m.Called(interview, offsets, sentAt)
+	return args.Error(0)
+}
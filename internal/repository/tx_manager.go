@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// mysqlDeadlock is the MySQL error number of ER_LOCK_DEADLOCK, returned when InnoDB picks the transaction as a deadlock victim
+const mysqlDeadlock = 1213
+
+// postgresDeadlock is the PostgreSQL SQLSTATE of deadlock_detected, returned to the transaction aborted to break a deadlock
+const postgresDeadlock = "40P01"
+
+// dbtx is the part of *sql.DB and *sql.Tx the repositories run their queries on
+type dbtx interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Repositories groups the repositories taking part in a unit of work
+// Inside TxManager.WithinTx every repository runs its queries on the same transaction.
+type Repositories struct {
+	Interviews InterviewRepository // Interviews, their panels and the outbox events they record
+}
+
+// TxManager runs units of work spanning several repository calls in a single transaction
+// This interface lets the service layer make several writes atomic without knowing about *sql.Tx.
+type TxManager interface {
+	// WithinTx runs fn inside a transaction
+	// The transaction is committed when fn returns nil and rolled back when it returns an error or panics.
+	// fn is run again from the start when the database aborts the transaction as a deadlock victim, so it must
+	// not have side effects outside the repositories it is given.
+	// @param ctx context.Context - Cancels the transaction and carries its deadline
+	// @param fn func(ctx context.Context, repos Repositories) error - The unit of work, given repositories bound to the transaction
+	// @return error - The error returned by fn, or an error if the transaction could not be started or committed
+	WithinTx(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error
+}
+
+// TxConfig holds the settings of a TxManager
+type TxConfig struct {
+	Timeout      time.Duration // Deadline of each attempt, from begin to commit; zero for none
+	MaxRetries   int           // Attempts after the first one when the transaction is a deadlock victim
+	RetryBackoff time.Duration // Delay before the first retry, doubled on every further retry
+}
+
+// DefaultTxConfig returns the transaction settings used by the service
+// @return TxConfig - A 5 second timeout and up to 3 retries starting at 20ms
+func DefaultTxConfig() TxConfig {
+	return TxConfig{
+		Timeout:      5 * time.Second,
+		MaxRetries:   3,
+		RetryBackoff: 20 * time.Millisecond,
+	}
+}
+
+type sqlTxManager struct {
+	db   *sql.DB                       // Database connection instance
+	cfg  TxConfig                      // Timeout and retry settings
+	bind func(tx *sql.Tx) Repositories // Creates the repositories of a unit of work on its transaction
+}
+
+// NewTxManager creates a new TxManager instance for MySQL
+// This constructor initializes the manager with the provided database connection.
+// @param db *sql.DB - The database connection transactions are started on
+// @param cfg TxConfig - The timeout and retry settings
+// @return TxManager - An instance of the manager interface implementation
+func NewTxManager(db *sql.DB, cfg TxConfig) TxManager {
+	return &sqlTxManager{db: db, cfg: cfg, bind: func(tx *sql.Tx) Repositories {
+		return Repositories{
+			Interviews: &interviewRepositoryImpl{tx: tx}, // The transaction's deadline covers every query
+		}
+	}}
+}
+
+// NewSQLiteTxManager creates a new TxManager instance for SQLite
+// Units of work are given the SQLite repositories; they run in immediate transactions that wait for the
+// database's write lock, so they do not deadlock.
+// @param db *sql.DB - The database connection transactions are started on, opened by db.Connect with a sqlite:// URL
+// @param cfg TxConfig - The timeout and retry settings
+// @return TxManager - An instance of the manager interface implementation
+func NewSQLiteTxManager(db *sql.DB, cfg TxConfig) TxManager {
+	return &sqlTxManager{db: db, cfg: cfg, bind: func(tx *sql.Tx) Repositories {
+		return Repositories{
+			Interviews: &interviewRepositoryImpl{tx: tx, sqlite: true}, // The transaction's deadline covers every query
+		}
+	}}
+}
+
+// NewPostgresTxManager creates a new TxManager instance for PostgreSQL
+// Units of work are given the PostgreSQL repositories and retried on deadlock_detected errors.
+// @param db *sql.DB - The database connection transactions are started on, opened with the pgx driver
+// @param cfg TxConfig - The timeout and retry settings
+// @return TxManager - An instance of the manager interface implementation
+func NewPostgresTxManager(db *sql.DB, cfg TxConfig) TxManager {
+	return &sqlTxManager{db: db, cfg: cfg, bind: func(tx *sql.Tx) Repositories {
+		return Repositories{
+			Interviews: &postgresInterviewRepository{tx: tx}, // The transaction's deadline covers every query
+		}
+	}}
+}
+
+// WithinTx runs fn inside a transaction, retrying it when the database reports a deadlock
+// Each attempt starts a new transaction; waiting between attempts stops early when ctx is done.
+// @param ctx context.Context - Cancels the transaction and carries its deadline
+// @param fn func(ctx context.Context, repos Repositories) error - The unit of work
+// @return error - The error of the last attempt, or nil once a transaction committed
+func (m *sqlTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error {
+	var err error
+	for attempt := 0; attempt <= m.cfg.MaxRetries; attempt++ {
+		if attempt > 0 && !waitRetry(ctx, m.cfg.RetryBackoff<<(attempt-1)) {
+			return err
+		}
+		err = runInTx(ctx, m.db, m.cfg.Timeout, func(ctx context.Context, tx *sql.Tx) error {
+			return fn(ctx, m.bind(tx))
+		})
+		if !IsDeadlock(err) {
+			return err
+		}
+	}
+	return err
+}
+
+// waitRetry pauses before a retry
+// @param ctx context.Context - Stops the wait when done
+// @param d time.Duration - How long to wait
+// @return bool - False when ctx was done before the delay elapsed
+func waitRetry(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// IsDeadlock reports whether err means the database rolled the transaction back to break a deadlock
+// @param err error - The error returned by a query or commit
+// @return bool - True for MySQL error 1213 and PostgreSQL SQLSTATE 40P01
+func IsDeadlock(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlDeadlock
+	}
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == postgresDeadlock
+}
+
+// runInTx runs fn inside a new transaction
+// The transaction is committed when fn returns nil; it is rolled back when fn returns an error
+// or panics, in which case the panic is propagated once the rollback is done.
+// @param ctx context.Context - Cancels the transaction and carries its deadline
+// @param db *sql.DB - The database connection the transaction is started on
+// @param timeout time.Duration - Deadline of the whole transaction, zero for none
+// @param fn func(ctx context.Context, tx *sql.Tx) error - The work to run on the transaction
+// @return error - The error returned by fn, or an error if the transaction could not be started or committed
+func runInTx(ctx context.Context, db *sql.DB, timeout time.Duration, fn func(ctx context.Context, tx *sql.Tx) error) error {
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // Runs on errors and panics; no-op once the transaction has been committed
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// withTimeout derives the context of a database call, bounded by timeout
+// The caller's deadline wins when it is earlier.
+// @param ctx context.Context - The caller's context
+// @param timeout time.Duration - The maximum duration of the call, zero for none
+// @return context.Context - The context to run the queries with
+// @return context.CancelFunc - Releases the context, to be deferred by the caller
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
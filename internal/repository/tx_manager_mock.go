@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTxManager is a mock implementation of TxManager for testing
+// WithinTx runs the unit of work against Repositories, so expectations are set on the mocked repositories as usual.
+type MockTxManager struct {
+	mock.Mock
+	Repositories Repositories // Handed to every unit of work
+}
+
+// WithinTx mocks the WithinTx method
+// @param ctx context.Context - The context of the call
+// @param fn func(ctx context.Context, repos Repositories) error - The unit of work, run unless the mock returns an error
+// @return error - The error configured on the mock, or the error returned by fn
+func (m *MockTxManager) WithinTx(ctx context.Context, fn func(ctx context.Context, repos Repositories) error) error {
+	args := m.Called(ctx)
+	if err := args.Error(0); err != nil {
+		return err
+	}
+	return fn(ctx, m.Repositories)
+}
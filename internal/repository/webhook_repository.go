@@ -0,0 +1,299 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// ErrNotFound is returned when a requested record does not exist
+var ErrNotFound = errors.New("record not found")
+
+// WebhookRepository defines methods for accessing the webhook tables
+// This interface abstracts database operations for subscriptions and their deliveries.
+type WebhookRepository interface {
+	// CreateSubscription inserts a new subscription and stores its generated identifier
+	// @param sub *domain.WebhookSubscription - The subscription to be saved
+	// @return error - An error if the query fails
+	CreateSubscription(sub *domain.WebhookSubscription) error
+
+	// FindAllSubscriptions retrieves every subscription
+	// @return []*domain.WebhookSubscription - A slice containing all subscriptions
+	// @return error - An error if the query fails
+	FindAllSubscriptions() ([]*domain.WebhookSubscription, error)
+
+	// FindSubscriptionByID retrieves a single subscription
+	// @param id int - The ID of the subscription
+	// @return *domain.WebhookSubscription - The subscription
+	// @return error - ErrNotFound if the subscription does not exist, or an error if the query fails
+	FindSubscriptionByID(id int) (*domain.WebhookSubscription, error)
+
+	// DeleteSubscription removes a subscription and its deliveries
+	// @param id int - The ID of the subscription
+	// @return error - ErrNotFound if the subscription does not exist, or an error if the query fails
+	DeleteSubscription(id int) error
+
+	// CreateDelivery inserts a new delivery and stores its generated identifier
+	// A subscription has a single delivery per event, so creating the delivery of an event again,
+	// e.g. when the outbox relays it twice, leaves the existing delivery untouched and stores its identifier.
+	// @param delivery *domain.WebhookDelivery - The delivery to be saved
+	// @return error - An error if the query fails
+	CreateDelivery(delivery *domain.WebhookDelivery) error
+
+	// UpdateDelivery saves the outcome of a delivery attempt
+	// @param delivery *domain.WebhookDelivery - The delivery to be updated
+	// @return error - An error if the query fails
+	UpdateDelivery(delivery *domain.WebhookDelivery) error
+
+	// FindDeliveryByID retrieves a single delivery
+	// @param id int - The ID of the delivery
+	// @return *domain.WebhookDelivery - The delivery
+	// @return error - ErrNotFound if the delivery does not exist, or an error if the query fails
+	FindDeliveryByID(id int) (*domain.WebhookDelivery, error)
+
+	// FindDueDeliveries retrieves pending deliveries whose next attempt is due
+	// @param now time.Time - The current time
+	// @param limit int - The maximum number of deliveries to return
+	// @return []*domain.WebhookDelivery - The due deliveries, oldest first
+	// @return error - An error if the query fails
+	FindDueDeliveries(now time.Time, limit int) ([]*domain.WebhookDelivery, error)
+
+	// FindDeliveriesBySubscription retrieves the delivery log of a subscription
+	// @param subscriptionID int - The ID of the subscription
+	// @return []*domain.WebhookDelivery - The deliveries, newest first
+	// @return error - An error if the query fails
+	FindDeliveriesBySubscription(subscriptionID int) ([]*domain.WebhookDelivery, error)
+
+	// FindDeliveriesByStatus retrieves every delivery with the given status
+	// @param status domain.DeliveryStatus - The status to filter by
+	// @return []*domain.WebhookDelivery - The deliveries, newest first
+	// @return error - An error if the query fails
+	FindDeliveriesByStatus(status domain.DeliveryStatus) ([]*domain.WebhookDelivery, error)
+}
+
+type webhookRepositoryImpl struct {
+	db     *sql.DB // Database connection instance
+	sqlite bool    // True on SQLite, which has no ON DUPLICATE KEY UPDATE
+}
+
+// NewWebhookRepository creates a new WebhookRepository instance
+// This constructor initializes the repository with the provided database connection.
+// @param db *sql.DB - The database connection used for executing queries
+// @return WebhookRepository - An instance of the repository interface implementation
+func NewWebhookRepository(db *sql.DB) WebhookRepository {
+	return &webhookRepositoryImpl{db: db}
+}
+
+// NewSQLiteWebhookRepository creates a new WebhookRepository instance backed by SQLite
+// SQLite shares the placeholders of MySQL, so the same queries run on the schema of the sqlite migrations
+// except for the insert of deliveries, which reads the ID of a duplicate back with RETURNING.
+// @param db *sql.DB - The database connection used for executing queries, opened by db.Connect with a sqlite:// URL
+// @return WebhookRepository - An instance of the repository interface implementation
+func NewSQLiteWebhookRepository(db *sql.DB) WebhookRepository {
+	return &webhookRepositoryImpl{db: db, sqlite: true}
+}
+
+const subscriptionColumns = `id, url, secret, event_types, created_at`
+
+const deliveryColumns = `id, subscription_id, event_id, event_type, payload, status, attempts, response_code, last_error, next_attempt_at, created_at, updated_at`
+
+// CreateSubscription inserts a new subscription into the webhook_subscriptions table
+func (r *webhookRepositoryImpl) CreateSubscription(sub *domain.WebhookSubscription) error {
+	query := `INSERT INTO webhook_subscriptions (url, secret, event_types, created_at) VALUES (?, ?, ?, ?)`
+	result, err := r.db.Exec(query, sub.URL, sub.Secret, joinEventTypes(sub.EventTypes), sub.CreatedAt.UTC())
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	sub.ID = int(id)
+	return nil
+}
+
+// FindAllSubscriptions retrieves every row of the webhook_subscriptions table
+func (r *webhookRepositoryImpl) FindAllSubscriptions() ([]*domain.WebhookSubscription, error) {
+	rows, err := r.db.Query(`SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions ORDER BY id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*domain.WebhookSubscription
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+	return subs, rows.Err()
+}
+
+// FindSubscriptionByID retrieves a single row of the webhook_subscriptions table
+func (r *webhookRepositoryImpl) FindSubscriptionByID(id int) (*domain.WebhookSubscription, error) {
+	row := r.db.QueryRow(`SELECT `+subscriptionColumns+` FROM webhook_subscriptions WHERE id = ?`, id)
+	sub, err := scanSubscription(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return sub, err
+}
+
+// DeleteSubscription removes a subscription together with its delivery log
+func (r *webhookRepositoryImpl) DeleteSubscription(id int) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() // No-op once the transaction has been committed
+
+	if _, err := tx.Exec(`DELETE FROM webhook_deliveries WHERE subscription_id = ?`, id); err != nil {
+		return err
+	}
+	result, err := tx.Exec(`DELETE FROM webhook_subscriptions WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// CreateDelivery inserts a new row into the webhook_deliveries table
+// A duplicate of the subscription and event IDs is kept as is; LAST_INSERT_ID(id) makes LastInsertId return its ID.
+func (r *webhookRepositoryImpl) CreateDelivery(d *domain.WebhookDelivery) error {
+	query := `INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status, attempts, response_code, last_error, next_attempt_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) `
+	args := []interface{}{d.SubscriptionID, d.EventID, string(d.EventType), []byte(d.Payload), string(d.Status),
+		d.Attempts, d.ResponseCode, d.LastError, d.NextAttemptAt.UTC(), d.CreatedAt.UTC(), d.UpdatedAt.UTC()}
+	if r.sqlite {
+		// The no-op update makes RETURNING report the ID of the existing delivery
+		query += `ON CONFLICT (subscription_id, event_id) DO UPDATE SET event_id = excluded.event_id RETURNING id`
+		return r.db.QueryRow(query, args...).Scan(&d.ID)
+	}
+
+	result, err := r.db.Exec(query+`ON DUPLICATE KEY UPDATE id = LAST_INSERT_ID(id)`, args...)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	d.ID = int(id)
+	return nil
+}
+
+// UpdateDelivery saves the mutable columns of a webhook_deliveries row
+func (r *webhookRepositoryImpl) UpdateDelivery(d *domain.WebhookDelivery) error {
+	query := `UPDATE webhook_deliveries SET status = ?, attempts = ?, response_code = ?, last_error = ?, next_attempt_at = ?, updated_at = ? WHERE id = ?`
+	_, err := r.db.Exec(query, string(d.Status), d.Attempts, d.ResponseCode, d.LastError, d.NextAttemptAt.UTC(), d.UpdatedAt.UTC(), d.ID)
+	return err
+}
+
+// FindDeliveryByID retrieves a single row of the webhook_deliveries table
+func (r *webhookRepositoryImpl) FindDeliveryByID(id int) (*domain.WebhookDelivery, error) {
+	row := r.db.QueryRow(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE id = ?`, id)
+	d, err := scanDelivery(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return d, err
+}
+
+// FindDueDeliveries retrieves pending deliveries whose next attempt time has passed
+func (r *webhookRepositoryImpl) FindDueDeliveries(now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	return r.queryDeliveries(`SELECT `+deliveryColumns+` FROM webhook_deliveries
+		WHERE status = ? AND next_attempt_at <= ? ORDER BY next_attempt_at, id LIMIT ?`,
+		string(domain.DeliveryPending), now.UTC(), limit)
+}
+
+// FindDeliveriesBySubscription retrieves the deliveries of one subscription
+func (r *webhookRepositoryImpl) FindDeliveriesBySubscription(subscriptionID int) ([]*domain.WebhookDelivery, error) {
+	return r.queryDeliveries(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE subscription_id = ? ORDER BY id DESC`, subscriptionID)
+}
+
+// FindDeliveriesByStatus retrieves the deliveries with a given status
+func (r *webhookRepositoryImpl) FindDeliveriesByStatus(status domain.DeliveryStatus) ([]*domain.WebhookDelivery, error) {
+	return r.queryDeliveries(`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE status = ? ORDER BY id DESC`, string(status))
+}
+
+// queryDeliveries runs a query returning webhook_deliveries rows
+func (r *webhookRepositoryImpl) queryDeliveries(query string, args ...interface{}) ([]*domain.WebhookDelivery, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*domain.WebhookDelivery
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanSubscription maps a row to a WebhookSubscription
+func scanSubscription(s scanner) (*domain.WebhookSubscription, error) {
+	var sub domain.WebhookSubscription
+	var eventTypes string
+	if err := s.Scan(&sub.ID, &sub.URL, &sub.Secret, &eventTypes, &sub.CreatedAt); err != nil {
+		return nil, err
+	}
+	sub.EventTypes = splitEventTypes(eventTypes)
+	sub.CreatedAt = sub.CreatedAt.UTC() // SQLite returns dates in the local zone when its offset is UTC's
+	return &sub, nil
+}
+
+// scanDelivery maps a row to a WebhookDelivery
+func scanDelivery(s scanner) (*domain.WebhookDelivery, error) {
+	var d domain.WebhookDelivery
+	var eventType, status string
+	var payload []byte
+	if err := s.Scan(&d.ID, &d.SubscriptionID, &d.EventID, &eventType, &payload, &status, &d.Attempts,
+		&d.ResponseCode, &d.LastError, &d.NextAttemptAt, &d.CreatedAt, &d.UpdatedAt); err != nil {
+		return nil, err
+	}
+	d.EventType = domain.EventType(eventType)
+	d.Status = domain.DeliveryStatus(status)
+	d.Payload = payload
+	d.NextAttemptAt, d.CreatedAt, d.UpdatedAt = d.NextAttemptAt.UTC(), d.CreatedAt.UTC(), d.UpdatedAt.UTC()
+	return &d, nil
+}
+
+// joinEventTypes encodes event types as a comma-separated column value
+func joinEventTypes(types []domain.EventType) string {
+	parts := make([]string, len(types))
+	for i, t := range types {
+		parts[i] = string(t)
+	}
+	return strings.Join(parts, ",")
+}
+
+// splitEventTypes decodes a comma-separated column value into event types
+func splitEventTypes(value string) []domain.EventType {
+	var types []domain.EventType
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			types = append(types, domain.EventType(part))
+		}
+	}
+	return types
+}
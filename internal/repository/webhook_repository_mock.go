@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhookRepository is a mock implementation of WebhookRepository for testing
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+// CreateSubscription mocks the CreateSubscription method
+func (m *MockWebhookRepository) CreateSubscription(sub *domain.WebhookSubscription) error {
+	args := m.Called(sub)
+	return args.Error(0)
+}
+
+// FindAllSubscriptions mocks the FindAllSubscriptions method
+func (m *MockWebhookRepository) FindAllSubscriptions() ([]*domain.WebhookSubscription, error) {
+	args := m.Called()
+	if subs, ok := args.Get(0).([]*domain.WebhookSubscription); ok {
+		return subs, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindSubscriptionByID mocks the FindSubscriptionByID method
+func (m *MockWebhookRepository) FindSubscriptionByID(id int) (*domain.WebhookSubscription, error) {
+	args := m.Called(id)
+	if sub, ok := args.Get(0).(*domain.WebhookSubscription); ok {
+		return sub, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// DeleteSubscription mocks the DeleteSubscription method
+func (m *MockWebhookRepository) DeleteSubscription(id int) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// CreateDelivery mocks the CreateDelivery method
+func (m *MockWebhookRepository) CreateDelivery(delivery *domain.WebhookDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+// UpdateDelivery mocks the UpdateDelivery method
+func (m *MockWebhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	args := m.Called(delivery)
+	return args.Error(0)
+}
+
+// FindDeliveryByID mocks the FindDeliveryByID method
+func (m *MockWebhookRepository) FindDeliveryByID(id int) (*domain.WebhookDelivery, error) {
+	args := m.Called(id)
+	if delivery, ok := args.Get(0).(*domain.WebhookDelivery); ok {
+		return delivery, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindDueDeliveries mocks the FindDueDeliveries method
+func (m *MockWebhookRepository) FindDueDeliveries(now time.Time, limit int) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(now, limit)
+	if deliveries, ok := args.Get(0).([]*domain.WebhookDelivery); ok {
+		return deliveries, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindDeliveriesBySubscription mocks the FindDeliveriesBySubscription method
+func (m *MockWebhookRepository) FindDeliveriesBySubscription(subscriptionID int) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(subscriptionID)
+	if deliveries, ok := args.Get(0).([]*domain.WebhookDelivery); ok {
+		return deliveries, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindDeliveriesByStatus mocks the FindDeliveriesByStatus method
+func (m *MockWebhookRepository) FindDeliveriesByStatus(status domain.DeliveryStatus) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(status)
+	if deliveries, ok := args.Get(0).([]*domain.WebhookDelivery); ok {
+		return deliveries, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+)
+
+// Kind classifies the errors returned by the services
+// Transports map each kind to a response without knowing what caused the error.
+type Kind string
+
+const (
+	KindNotFound    Kind = "not-found"        // The requested resource does not exist
+	KindConflict    Kind = "conflict"         // The resource is in a state that does not allow the operation
+	KindValidation  Kind = "validation-error" // The input is invalid; Fields tells which parts
+	KindForbidden   Kind = "forbidden"        // The caller is not allowed to perform the operation
+	KindUnavailable Kind = "unavailable"      // A service this one depends on cannot be reached
+)
+
+// FieldError describes why a single input field is invalid
+type FieldError struct {
+	Field   string `json:"field"`   // Name of the field as sent by the client, e.g. candidate_id
+	Message string `json:"message"` // What is wrong with the value
+}
+
+// Error is an error returned by the services
+// It wraps the underlying error, so errors.Is keeps matching sentinels such as ErrInvalidReference
+// or repository.ErrNotFound.
+type Error struct {
+	Kind    Kind         // Classification of the error
+	Message string       // Human-readable description, safe to show to clients
+	Fields  []FieldError // Invalid fields, only set for KindValidation
+	Err     error        // The underlying error, may be nil
+}
+
+// Error returns the message of the error
+// @return string - The error message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the underlying error
+// @return error - The wrapped error, nil if there is none
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// notFound builds a KindNotFound error
+// @param err error - The underlying error
+// @param format string - The message format
+// @param args ...interface{} - The message arguments
+// @return *Error - The error
+func notFound(err error, format string, args ...interface{}) *Error {
+	return &Error{Kind: KindNotFound, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// conflict builds a KindConflict error
+// @param err error - The underlying error
+// @param format string - The message format
+// @param args ...interface{} - The message arguments
+// @return *Error - The error
+func conflict(err error, format string, args ...interface{}) *Error {
+	return &Error{Kind: KindConflict, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+// invalid builds a KindValidation error about a single field
+// @param err error - The underlying error, usually a sentinel such as ErrInvalidReference
+// @param field string - The name of the invalid field
+// @param format string - The message format, describing what is wrong with the field
+// @param args ...interface{} - The message arguments
+// @return *Error - The error, whose message is prefixed with the underlying error
+func invalid(err error, field, format string, args ...interface{}) *Error {
+	message := fmt.Sprintf(format, args...)
+	return &Error{
+		Kind:    KindValidation,
+		Message: fmt.Sprintf("%v: %s", err, message),
+		Fields:  []FieldError{{Field: field, Message: message}},
+		Err:     err,
+	}
+}
+
+// fromRepository classifies the errors returned by the repositories
+// ErrNotFound becomes KindNotFound, ErrNotScheduled KindConflict and unreachable services
+// KindUnavailable; any other error is returned unchanged.
+// @param err error - The error returned by a repository or client
+// @param resource string - What was being looked up, e.g. "interview 7", used in the message
+// @return error - The classified error, nil if err is nil
+func fromRepository(err error, resource string) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, repository.ErrNotFound):
+		return notFound(err, "%s not found", resource)
+	case errors.Is(err, repository.ErrNotScheduled):
+		return conflict(err, "%s is not scheduled", resource)
+	case errors.Is(err, client.ErrUnavailable):
+		return &Error{Kind: KindUnavailable, Message: "could not look up " + resource + ", try again later", Err: err}
+	}
+	return err
+}
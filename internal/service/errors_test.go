@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromRepository(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		kind    Kind
+		message string
+	}{
+		{name: "not found", err: repository.ErrNotFound, kind: KindNotFound, message: "interview 7 not found"},
+		{name: "not scheduled", err: repository.ErrNotScheduled, kind: KindConflict, message: "interview 7 is not scheduled"},
+		{name: "unavailable", err: fmt.Errorf("looking up job 201: %w", client.ErrUnavailable), kind: KindUnavailable,
+			message: "could not look up interview 7, try again later"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Execute
+			err := fromRepository(tt.err, "interview 7")
+
+			// Assertions
+			var serviceErr *Error
+			require.True(t, errors.As(err, &serviceErr))
+			assert.Equal(t, tt.kind, serviceErr.Kind)
+			assert.Equal(t, tt.message, serviceErr.Error())
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	unexpected := errors.New("connection reset")
+	assert.Same(t, unexpected, fromRepository(unexpected, "interview 7"))
+	assert.NoError(t, fromRepository(nil, "interview 7"))
+}
+
+func TestInvalid(t *testing.T) {
+	// Execute
+	err := invalid(ErrInvalidReference, "candidate_id", "candidate %d does not exist", 101)
+
+	// Assertions
+	assert.Equal(t, KindValidation, err.Kind)
+	assert.Equal(t, "invalid reference: candidate 101 does not exist", err.Error())
+	assert.Equal(t, []FieldError{{Field: "candidate_id", Message: "candidate 101 does not exist"}}, err.Fields)
+	assert.ErrorIs(t, err, ErrInvalidReference)
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/sla"
+)
+
+// ErrInvalidFeedback is returned when a feedback submission is empty
+var ErrInvalidFeedback = errors.New("feedback is required")
+
+// overdueLookback bounds how far back missing feedback is still chased
+const overdueLookback = 90 * 24 * time.Hour
+
+// FeedbackService defines methods for interviewer feedback and its deadlines
+// This interface abstracts the business logic for feedback SLAs.
+type FeedbackService interface {
+	// SubmitFeedback stores the feedback of a panelist
+	// @param interviewID int - The ID of the interview
+	// @param panelistID int - The ID of the panelist
+	// @param feedback string - The feedback
+	// @return error - A KindValidation *Error wrapping ErrInvalidFeedback, a KindNotFound *Error, or an error if the feedback could not be stored
+	SubmitFeedback(interviewID, panelistID int, feedback string) error
+
+	// OverdueFeedback retrieves the feedback that is missing past its deadline
+	// @param now time.Time - The current time
+	// @return []*domain.FeedbackAssignment - The overdue assignments grouped by interviewer
+	// @return error - An error if the assignments could not be retrieved
+	OverdueFeedback(now time.Time) ([]*domain.FeedbackAssignment, error)
+
+	// RecordNudge records that a nudge about missing feedback was sent
+	// @param panelistID int - The ID of the panelist
+	// @param level int - The escalation level of the nudge
+	// @param sentAt time.Time - The time at which the nudge was sent
+	// @return error - An error if the nudge could not be recorded
+	RecordNudge(panelistID, level int, sentAt time.Time) error
+
+	// Report summarises feedback timeliness per interviewer and per job
+	// @param from time.Time - Start of the period, inclusive
+	// @param to time.Time - End of the period, exclusive
+	// @param now time.Time - The current time, used to tell pending from overdue feedback
+	// @return *domain.SLAReport - The report
+	// @return error - An error if the assignments could not be retrieved
+	Report(from, to, now time.Time) (*domain.SLAReport, error)
+
+	// SetPolicy replaces the feedback deadlines, e.g. when the configuration is reloaded
+	// @param policy sla.Policy - The new deadlines, applied to the next lookup
+	SetPolicy(policy sla.Policy)
+}
+
+type feedbackServiceImpl struct {
+	repo   repository.FeedbackRepository // Dependency on the FeedbackRepository
+	mu     sync.RWMutex                  // Guards policy against SetPolicy during lookups
+	policy sla.Policy                    // Feedback deadlines per stage
+}
+
+// NewFeedbackService creates a new FeedbackService instance
+// This constructor initializes the service with the provided repository and SLA policy.
+// @param repo repository.FeedbackRepository - The repository used for database operations
+// @param policy sla.Policy - The feedback deadlines
+// @return FeedbackService - An instance of the service interface implementation
+func NewFeedbackService(repo repository.FeedbackRepository, policy sla.Policy) FeedbackService {
+	return &feedbackServiceImpl{repo: repo, policy: policy}
+}
+
+// SubmitFeedback stores the feedback of a panelist
+// @param interviewID int - The ID of the interview
+// @param panelistID int - The ID of the panelist
+// @param feedback string - The feedback
+// @return error - A KindValidation *Error wrapping ErrInvalidFeedback, a KindNotFound *Error, or an error if the update fails
+func (s *feedbackServiceImpl) SubmitFeedback(interviewID, panelistID int, feedback string) error {
+	if feedback == "" {
+		return invalid(ErrInvalidFeedback, "feedback", "must not be empty")
+	}
+	err := s.repo.SubmitFeedback(interviewID, panelistID, feedback, time.Now().UTC())
+	return fromRepository(err, fmt.Sprintf("panelist %d of interview %d", panelistID, interviewID))
+}
+
+// OverdueFeedback retrieves the feedback that is missing past its deadline
+// Interviews held in the last 90 days are considered.
+// @param now time.Time - The current time
+// @return []*domain.FeedbackAssignment - The overdue assignments sorted by interviewer and deadline
+// @return error - An error if the retrieval fails
+func (s *feedbackServiceImpl) OverdueFeedback(now time.Time) ([]*domain.FeedbackAssignment, error) {
+	assignments, err := s.assignments(now.Add(-overdueLookback), now)
+	if err != nil {
+		return nil, err
+	}
+
+	overdue := []*domain.FeedbackAssignment{}
+	for _, a := range assignments {
+		if a.Panelist.FeedbackSubmittedAt == nil && now.After(a.Deadline) {
+			overdue = append(overdue, a)
+		}
+	}
+	sort.SliceStable(overdue, func(i, j int) bool {
+		if overdue[i].Panelist.Email != overdue[j].Panelist.Email {
+			return overdue[i].Panelist.Email < overdue[j].Panelist.Email
+		}
+		return overdue[i].Deadline.Before(overdue[j].Deadline)
+	})
+	return overdue, nil
+}
+
+// RecordNudge records that a nudge about missing feedback was sent
+// @param panelistID int - The ID of the panelist
+// @param level int - The escalation level of the nudge
+// @param sentAt time.Time - The time at which the nudge was sent
+// @return error - An error if the insert fails
+func (s *feedbackServiceImpl) RecordNudge(panelistID, level int, sentAt time.Time) error {
+	return s.repo.MarkNudged(panelistID, level, sentAt)
+}
+
+// Report summarises feedback timeliness per interviewer and per job
+// Feedback submitted by its deadline counts as on time, after it as late; missing feedback is
+// overdue once its deadline has passed and pending before that.
+// @param from time.Time - Start of the period, inclusive
+// @param to time.Time - End of the period, exclusive
+// @param now time.Time - The current time
+// @return *domain.SLAReport - The report, entries sorted by key
+// @return error - An error if the retrieval fails
+func (s *feedbackServiceImpl) Report(from, to, now time.Time) (*domain.SLAReport, error) {
+	assignments, err := s.assignments(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	byInterviewer := make(map[string]*domain.SLAEntry)
+	byJob := make(map[string]*domain.SLAEntry)
+	for _, a := range assignments {
+		interviewer := a.Panelist.Email
+		if interviewer == "" {
+			interviewer = a.Panelist.Name
+		}
+		for _, entry := range []*domain.SLAEntry{
+			slaEntry(byInterviewer, interviewer),
+			slaEntry(byJob, strconv.Itoa(a.Interview.JobID)),
+		} {
+			switch submitted := a.Panelist.FeedbackSubmittedAt; {
+			case submitted != nil && !submitted.After(a.Deadline):
+				entry.OnTime++
+			case submitted != nil:
+				entry.Late++
+			case now.After(a.Deadline):
+				entry.Overdue++
+			default:
+				entry.Pending++
+			}
+		}
+	}
+
+	return &domain.SLAReport{
+		From:          from,
+		To:            to,
+		ByInterviewer: sortedEntries(byInterviewer),
+		ByJob:         sortedEntries(byJob),
+	}, nil
+}
+
+// assignments loads the assignments of a period and computes their deadlines
+func (s *feedbackServiceImpl) assignments(from, to time.Time) ([]*domain.FeedbackAssignment, error) {
+	assignments, err := s.repo.FindAssignments(from, to)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	policy := s.policy
+	s.mu.RUnlock()
+	for _, a := range assignments {
+		a.Deadline = policy.Deadline(&a.Interview)
+	}
+	return assignments, nil
+}
+
+// SetPolicy replaces the feedback deadlines
+// @param policy sla.Policy - The new deadlines, applied to the next lookup
+func (s *feedbackServiceImpl) SetPolicy(policy sla.Policy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = policy
+}
+
+// slaEntry returns the entry for a key, creating it on first use
+func slaEntry(entries map[string]*domain.SLAEntry, key string) *domain.SLAEntry {
+	entry, ok := entries[key]
+	if !ok {
+		entry = &domain.SLAEntry{Key: key}
+		entries[key] = entry
+	}
+	return entry
+}
+
+// sortedEntries computes compliance rates and returns the entries sorted by key
+func sortedEntries(entries map[string]*domain.SLAEntry) []domain.SLAEntry {
+	sorted := make([]domain.SLAEntry, 0, len(entries))
+	for _, entry := range entries {
+		entry.ComplianceRate = 1
+		if due := entry.OnTime + entry.Late + entry.Overdue; due > 0 {
+			entry.ComplianceRate = float64(entry.OnTime) / float64(due)
+		}
+		sorted = append(sorted, *entry)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+	return sorted
+}
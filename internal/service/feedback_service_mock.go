@@ -0,0 +1,49 @@
+package service
+
+import (
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/sla"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockFeedbackService is a mock implementation of FeedbackService for testing
+type MockFeedbackService struct {
+	mock.Mock
+}
+
+// SubmitFeedback mocks the SubmitFeedback method
+func (m *MockFeedbackService) SubmitFeedback(interviewID, panelistID int, feedback string) error {
+	args := m.Called(interviewID, panelistID, feedback)
+	return args.Error(0)
+}
+
+// OverdueFeedback mocks the OverdueFeedback method
+func (m *MockFeedbackService) OverdueFeedback(now time.Time) ([]*domain.FeedbackAssignment, error) {
+	args := m.Called(now)
+	if assignments, ok := args.Get(0).([]*domain.FeedbackAssignment); ok {
+		return assignments, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// RecordNudge mocks the RecordNudge method
+func (m *MockFeedbackService) RecordNudge(panelistID, level int, sentAt time.Time) error {
+	args := m.Called(panelistID, level, sentAt)
+	return args.Error(0)
+}
+
+// Report mocks the Report method
+func (m *MockFeedbackService) Report(from, to, now time.Time) (*domain.SLAReport, error) {
+	args := m.Called(from, to, now)
+	if report, ok := args.Get(0).(*domain.SLAReport); ok {
+		return report, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// SetPolicy mocks the SetPolicy method
+func (m *MockFeedbackService) SetPolicy(policy sla.Policy) {
+	m.Called(policy)
+}
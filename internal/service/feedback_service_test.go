@@ -0,0 +1,100 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/sla"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFeedbackReport(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockFeedbackRepository)
+	feedbackService := NewFeedbackService(mockRepo, sla.DefaultPolicy())
+	monday := time.Date(2024, time.December, 16, 10, 0, 0, 0, time.UTC) // Feedback due Tuesday 11:00
+	now := time.Date(2024, time.December, 18, 9, 0, 0, 0, time.UTC)
+	from, to := monday.AddDate(0, 0, -1), now
+
+	// Mock data
+	onTime := monday.Add(5 * time.Hour)
+	late := monday.Add(30 * time.Hour)
+	assignments := []*domain.FeedbackAssignment{
+		{Interview: domain.Interview{JobID: 201, InterviewDate: monday},
+			Panelist: domain.Panelist{ID: 1, Email: "alice@example.com", FeedbackSubmittedAt: &onTime}},
+		{Interview: domain.Interview{JobID: 201, InterviewDate: monday},
+			Panelist: domain.Panelist{ID: 2, Email: "bob@example.com", FeedbackSubmittedAt: &late}},
+		{Interview: domain.Interview{JobID: 202, InterviewDate: monday},
+			Panelist: domain.Panelist{ID: 3, Email: "alice@example.com"}},
+		{Interview: domain.Interview{JobID: 202, InterviewDate: now.Add(-2 * time.Hour)},
+			Panelist: domain.Panelist{ID: 4, Email: "bob@example.com"}},
+	}
+
+	// Mock behavior
+	mockRepo.On("FindAssignments", from, to).Return(assignments, nil)
+
+	// Execute
+	report, err := feedbackService.Report(from, to, now)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.SLAEntry{
+		{Key: "alice@example.com", OnTime: 1, Overdue: 1, ComplianceRate: 0.5},
+		{Key: "bob@example.com", Late: 1, Pending: 1, ComplianceRate: 0},
+	}, report.ByInterviewer)
+	assert.Equal(t, []domain.SLAEntry{
+		{Key: "201", OnTime: 1, Late: 1, ComplianceRate: 0.5},
+		{Key: "202", Overdue: 1, Pending: 1, ComplianceRate: 0},
+	}, report.ByJob)
+}
+
+func TestOverdueFeedback(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockFeedbackRepository)
+	feedbackService := NewFeedbackService(mockRepo, sla.DefaultPolicy())
+	now := time.Date(2024, time.December, 18, 9, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, time.December, 16, 10, 0, 0, 0, time.UTC)
+	submitted := monday.Add(time.Hour)
+
+	// Mock data
+	assignments := []*domain.FeedbackAssignment{
+		{Interview: domain.Interview{InterviewDate: monday}, Panelist: domain.Panelist{ID: 1, Email: "bob@example.com"}},
+		{Interview: domain.Interview{InterviewDate: monday}, Panelist: domain.Panelist{ID: 2, Email: "alice@example.com"}},
+		{Interview: domain.Interview{InterviewDate: monday}, Panelist: domain.Panelist{ID: 3, FeedbackSubmittedAt: &submitted}},
+		{Interview: domain.Interview{InterviewDate: now}, Panelist: domain.Panelist{ID: 4}}, // Not due yet
+	}
+
+	// Mock behavior
+	mockRepo.On("FindAssignments", mock.AnythingOfType("time.Time"), now).Return(assignments, nil)
+
+	// Execute
+	overdue, err := feedbackService.OverdueFeedback(now)
+
+	// Assertions
+	assert.NoError(t, err)
+	if assert.Len(t, overdue, 2) {
+		assert.Equal(t, "alice@example.com", overdue[0].Panelist.Email)
+		assert.Equal(t, time.Date(2024, time.December, 17, 11, 0, 0, 0, time.UTC), overdue[0].Deadline)
+	}
+}
+
+func TestSubmitFeedback_RequiresFeedback(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockFeedbackRepository)
+	feedbackService := NewFeedbackService(mockRepo, sla.DefaultPolicy())
+
+	// Mock behavior
+	mockRepo.On("SubmitFeedback", 7, 1, "Strong candidate", mock.AnythingOfType("time.Time")).Return(nil)
+
+	// Execute
+	err := feedbackService.SubmitFeedback(7, 1, "Strong candidate")
+	emptyErr := feedbackService.SubmitFeedback(7, 1, "")
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.ErrorIs(t, emptyErr, ErrInvalidFeedback)
+	mockRepo.AssertNumberOfCalls(t, "SubmitFeedback", 1)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer starts the spans of service calls
+var tracer = otel.Tracer("github.com/poolcamacho/interviews-service/internal/service")
+
+// startSpan starts the span of a service call
+// Only IDs are recorded as attributes; emails, feedback and cancellation reasons never leave the service.
+// @param ctx context.Context - The context of the call, carrying the span of the caller
+// @param method string - The method called, e.g. GetInterviewByID
+// @param attrs ...attribute.KeyValue - Attributes describing the call
+// @return context.Context - The context to make the call with
+// @return func(err error) - Ends the span, given the error returned by the call
+func startSpan(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, func(err error)) {
+	ctx, span := tracer.Start(ctx, "InterviewService."+method, trace.WithAttributes(attrs...))
+	return ctx, func(err error) {
+		if err != nil {
+			span.RecordError(err)
+			// Errors the caller caused are answered with a 4xx and are not failures of the service
+			var serviceErr *Error
+			if errors.As(err, &serviceErr) && serviceErr.Kind != KindUnavailable {
+				span.SetAttributes(attribute.String("error.kind", string(serviceErr.Kind)))
+			} else {
+				span.SetStatus(codes.Error, err.Error())
+			}
+		}
+		span.End()
+	}
+}
+
+type instrumentedInterviewService struct {
+	next InterviewService // Service the calls are forwarded to
+}
+
+// InstrumentInterviewService traces the calls of an InterviewService
+// Every call becomes an InterviewService.<Method> span, parent of the repository and client spans it causes.
+// @param next InterviewService - The service the calls are forwarded to
+// @return InterviewService - A service recording a span for every call
+func InstrumentInterviewService(next InterviewService) InterviewService {
+	return &instrumentedInterviewService{next: next}
+}
+
+// GetAllInterviews traces GetAllInterviews on the wrapped service
+func (s *instrumentedInterviewService) GetAllInterviews(ctx context.Context) (interviews []*domain.Interview, err error) {
+	ctx, end := startSpan(ctx, "GetAllInterviews")
+	defer func() { end(err) }()
+	return s.next.GetAllInterviews(ctx)
+}
+
+// FindInterviews traces FindInterviews on the wrapped service
+func (s *instrumentedInterviewService) FindInterviews(ctx context.Context, filter domain.InterviewFilter) (interviews []*domain.Interview, err error) {
+	ctx, end := startSpan(ctx, "FindInterviews", attribute.Int("interview.limit", filter.Limit))
+	defer func() { end(err) }()
+	return s.next.FindInterviews(ctx, filter)
+}
+
+// AddInterview traces AddInterview on the wrapped service
+func (s *instrumentedInterviewService) AddInterview(ctx context.Context, interview *domain.Interview) (err error) {
+	ctx, end := startSpan(ctx, "AddInterview",
+		attribute.Int("candidate.id", interview.CandidateID), attribute.Int("job.id", interview.JobID))
+	defer func() { end(err) }()
+	return s.next.AddInterview(ctx, interview)
+}
+
+// GetInterviewByID traces GetInterviewByID on the wrapped service
+func (s *instrumentedInterviewService) GetInterviewByID(ctx context.Context, id int) (interview *domain.Interview, err error) {
+	ctx, end := startSpan(ctx, "GetInterviewByID", attribute.Int("interview.id", id))
+	defer func() { end(err) }()
+	return s.next.GetInterviewByID(ctx, id)
+}
+
+// GetInterviewsByIDs traces GetInterviewsByIDs on the wrapped service
+func (s *instrumentedInterviewService) GetInterviewsByIDs(ctx context.Context, ids []int) (interviews []*domain.Interview, err error) {
+	ctx, end := startSpan(ctx, "GetInterviewsByIDs", attribute.Int("interview.count", len(ids)))
+	defer func() { end(err) }()
+	return s.next.GetInterviewsByIDs(ctx, ids)
+}
+
+// UpdateInterview traces UpdateInterview on the wrapped service
+func (s *instrumentedInterviewService) UpdateInterview(ctx context.Context, interview *domain.Interview) (err error) {
+	ctx, end := startSpan(ctx, "UpdateInterview", attribute.Int("interview.id", interview.ID))
+	defer func() { end(err) }()
+	return s.next.UpdateInterview(ctx, interview)
+}
+
+// CancelInterview traces CancelInterview on the wrapped service
+func (s *instrumentedInterviewService) CancelInterview(ctx context.Context, id int, reason string) (interview *domain.Interview, err error) {
+	ctx, end := startSpan(ctx, "CancelInterview", attribute.Int("interview.id", id))
+	defer func() { end(err) }()
+	return s.next.CancelInterview(ctx, id, reason)
+}
+
+// CancelUpcomingForCandidate traces CancelUpcomingForCandidate on the wrapped service
+func (s *instrumentedInterviewService) CancelUpcomingForCandidate(ctx context.Context, candidateID int, reason string) (n int, err error) {
+	ctx, end := startSpan(ctx, "CancelUpcomingForCandidate", attribute.Int("candidate.id", candidateID))
+	defer func() { end(err) }()
+	return s.next.CancelUpcomingForCandidate(ctx, candidateID, reason)
+}
+
+// CancelUpcomingForJob traces CancelUpcomingForJob on the wrapped service
+func (s *instrumentedInterviewService) CancelUpcomingForJob(ctx context.Context, jobID int, reason string) (n int, err error) {
+	ctx, end := startSpan(ctx, "CancelUpcomingForJob", attribute.Int("job.id", jobID))
+	defer func() { end(err) }()
+	return s.next.CancelUpcomingForJob(ctx, jobID, reason)
+}
+
+// FlagOverdueInterviews traces FlagOverdueInterviews on the wrapped service
+func (s *instrumentedInterviewService) FlagOverdueInterviews(ctx context.Context, now time.Time) (n int, err error) {
+	ctx, end := startSpan(ctx, "FlagOverdueInterviews")
+	defer func() { end(err) }()
+	return s.next.FlagOverdueInterviews(ctx, now)
+}
+
+// GetInterviewsNeedingAttention traces GetInterviewsNeedingAttention on the wrapped service
+func (s *instrumentedInterviewService) GetInterviewsNeedingAttention(ctx context.Context) (interviews []*domain.Interview, err error) {
+	ctx, end := startSpan(ctx, "GetInterviewsNeedingAttention")
+	defer func() { end(err) }()
+	return s.next.GetInterviewsNeedingAttention(ctx)
+}
+
+// ResolveAttention traces ResolveAttention on the wrapped service
+func (s *instrumentedInterviewService) ResolveAttention(ctx context.Context, resolution domain.AttentionResolution) (n int, err error) {
+	ctx, end := startSpan(ctx, "ResolveAttention", attribute.Int("interview.count", len(resolution.InterviewIDs)))
+	defer func() { end(err) }()
+	return s.next.ResolveAttention(ctx, resolution)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// spans records the spans ended by the package tracer; the global provider can only be delegated to once
+var spans = tracetest.NewSpanRecorder()
+
+func init() {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+}
+
+func TestInstrumentInterviewService(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus codes.Code
+	}{
+		{name: "success", err: nil, wantStatus: codes.Unset},
+		{name: "caller error", err: notFound(repository.ErrNotFound, "interview %d not found", 7), wantStatus: codes.Unset},
+		{name: "unavailable", err: &Error{Kind: KindUnavailable, Message: "could not look up candidate"}, wantStatus: codes.Error},
+		{name: "unexpected error", err: errors.New("connection reset"), wantStatus: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockService := new(MockInterviewService)
+			svc := InstrumentInterviewService(mockService)
+			started := len(spans.Ended())
+
+			// Mock behavior
+			mockService.On("CancelInterview", mock.Anything, 7, "candidate@example.com asked to reschedule").
+				Return(&domain.Interview{ID: 7}, tt.err)
+
+			// Execute
+			_, err := svc.CancelInterview(context.Background(), 7, "candidate@example.com asked to reschedule")
+
+			// Assertions
+			assert.Equal(t, tt.err, err) // Errors are passed through untouched
+			ended := spans.Ended()
+			require.Len(t, ended, started+1)
+			span := ended[started]
+			assert.Equal(t, "InterviewService.CancelInterview", span.Name())
+			assert.Equal(t, tt.wantStatus, span.Status().Code)
+			assert.Contains(t, span.Attributes(), attribute.Int("interview.id", 7))
+			for _, attr := range span.Attributes() {
+				assert.NotContains(t, attr.Value.Emit(), "example.com") // The reason is never recorded
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
@@ -1,50 +1,416 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/client"
 	"github.com/poolcamacho/interviews-service/internal/domain"
 	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Metrics exported on /metrics, counted once the transaction has committed
+var (
+	createdTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "interviews_created_total",
+		Help: "Interviews scheduled since start.",
+	})
+	cancelledTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "interviews_cancelled_total",
+		Help: "Interviews cancelled since start, by what triggered the cancellation: api, candidate or job.",
+	}, []string{"trigger"})
 )
 
+// ErrInvalidResolution is returned when interviews are resolved with an outcome other than completed or no_show
+var ErrInvalidResolution = errors.New("invalid resolution")
+
+// ErrInvalidReference is returned when an interview refers to an unknown or inactive candidate or job
+var ErrInvalidReference = errors.New("invalid reference")
+
 // InterviewService defines methods for interview-related operations
 // This interface abstracts the business logic for managing interviews.
 type InterviewService interface {
 	// GetAllInterviews retrieves all interviews from the repository
 	// Delegates the retrieval operation to the repository layer.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
 	// @return []*domain.Interview - A slice containing all interviews
 	// @return error - An error if there is an issue retrieving the interviews
-	GetAllInterviews() ([]*domain.Interview, error)
+	GetAllInterviews(ctx context.Context) ([]*domain.Interview, error)
+
+	// FindInterviews retrieves the interviews matching a filter
+	// The filter and limit are applied by the repository, so only the interviews returned are read.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param filter domain.InterviewFilter - The status, candidate, job and maximum number of interviews
+	// @return []*domain.Interview - The matching interviews, ordered by ID
+	// @return error - An error if there is an issue retrieving the interviews
+	FindInterviews(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error)
 
 	// AddInterview adds a new interview to the repository
-	// Delegates the creation operation to the repository layer.
+	// Checks the candidate and job against their services, then delegates the creation operation
+	// to the repository layer, which also records the interview.created event.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
 	// @param interview *domain.Interview - The interview data to be added
-	// @return error - An error if there is an issue creating the interview
-	AddInterview(interview *domain.Interview) error
+	// @return error - A KindValidation *Error wrapping ErrInvalidReference for an unknown or inactive candidate or job,
+	// a KindUnavailable *Error if a service cannot be reached, or an error if there is an issue creating the interview
+	AddInterview(ctx context.Context, interview *domain.Interview) error
+
+	// GetInterviewByID retrieves a single interview
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param id int - The ID of the interview
+	// @return *domain.Interview - The interview with its panel
+	// @return error - A KindNotFound *Error, or an error if there is an issue retrieving the interview
+	GetInterviewByID(ctx context.Context, id int) (*domain.Interview, error)
+
+	// GetInterviewsByIDs retrieves several interviews at once
+	// Used to batch lookups that would otherwise hit the repository once per interview.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param ids []int - The IDs of the interviews
+	// @return []*domain.Interview - The interviews found, in no particular order; unknown IDs are skipped
+	// @return error - An error if there is an issue retrieving the interviews
+	GetInterviewsByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error)
+
+	// UpdateInterview saves the date, feedback, stage and candidate email of an interview
+	// Moving the date notifies participants through the interview.rescheduled event.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param interview *domain.Interview - The interview with its new values, updated in place
+	// @return error - A KindNotFound or KindConflict *Error, or an error if there is an issue saving the interview
+	UpdateInterview(ctx context.Context, interview *domain.Interview) error
+
+	// CancelInterview cancels a single scheduled interview
+	// Participants are notified through the interview.cancelled event.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param id int - The ID of the interview
+	// @param reason string - The reason given for the cancellation
+	// @return *domain.Interview - The cancelled interview
+	// @return error - A KindNotFound or KindConflict *Error, or an error if there is an issue cancelling the interview
+	CancelInterview(ctx context.Context, id int, reason string) (*domain.Interview, error)
+
+	// CancelUpcomingForCandidate cancels every future interview of a candidate
+	// Either every interview is cancelled or none is; participants are notified through the
+	// interview.cancelled event of each interview.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param candidateID int - The ID of the candidate
+	// @param reason string - The reason given for the cancellations
+	// @return int - The number of interviews cancelled
+	// @return error - An error if the interviews could not be retrieved or cancelled
+	CancelUpcomingForCandidate(ctx context.Context, candidateID int, reason string) (int, error)
+
+	// CancelUpcomingForJob cancels every future interview for a job
+	// Either every interview is cancelled or none is; participants are notified through the
+	// interview.cancelled event of each interview.
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param jobID int - The ID of the job
+	// @param reason string - The reason given for the cancellations
+	// @return int - The number of interviews cancelled
+	// @return error - An error if the interviews could not be retrieved or cancelled
+	CancelUpcomingForJob(ctx context.Context, jobID int, reason string) (int, error)
+
+	// FlagOverdueInterviews moves interviews that ended without an outcome or feedback to needs_attention
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param now time.Time - The current time
+	// @return int - The number of interviews flagged
+	// @return error - An error if the interviews could not be updated
+	FlagOverdueInterviews(ctx context.Context, now time.Time) (int, error)
+
+	// GetInterviewsNeedingAttention retrieves the interviews flagged as needing attention
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @return []*domain.Interview - The flagged interviews, oldest first
+	// @return error - An error if the interviews could not be retrieved
+	GetInterviewsNeedingAttention(ctx context.Context) ([]*domain.Interview, error)
+
+	// ResolveAttention records the outcome of flagged interviews in bulk
+	// @param ctx context.Context - Cancels the operation and carries its deadline
+	// @param resolution domain.AttentionResolution - The interviews and the outcome to record
+	// @return int - The number of interviews updated; interviews no longer flagged are skipped
+	// @return error - A KindValidation *Error wrapping ErrInvalidResolution, or an error if the interviews could not be updated
+	ResolveAttention(ctx context.Context, resolution domain.AttentionResolution) (int, error)
 }
 
 type interviewServiceImpl struct {
-	repo repository.InterviewRepository // Dependency on the InterviewRepository
+	repo       repository.InterviewRepository // Dependency on the InterviewRepository, used for reads
+	tx         repository.TxManager           // Runs writes atomically, retrying them on deadlocks
+	candidates client.CandidateClient         // Looks up candidates, nil to skip validation
+	jobs       client.JobClient               // Looks up jobs, nil to skip validation
 }
 
 // NewInterviewService creates a new InterviewService instance
-// This constructor initializes the service with the provided repository.
+// This constructor initializes the service with the provided repository, transaction manager and service clients.
 // @param repo repository.InterviewRepository - The repository used for database operations
+// @param tx repository.TxManager - The transaction manager writes run through
+// @param candidates client.CandidateClient - The client used to validate candidate IDs, nil to skip the check
+// @param jobs client.JobClient - The client used to validate job IDs, nil to skip the check
 // @return InterviewService - An instance of the service interface implementation
-func NewInterviewService(repo repository.InterviewRepository) InterviewService {
-	return &interviewServiceImpl{repo: repo}
+func NewInterviewService(repo repository.InterviewRepository, tx repository.TxManager, candidates client.CandidateClient, jobs client.JobClient) InterviewService {
+	return &interviewServiceImpl{repo: repo, tx: tx, candidates: candidates, jobs: jobs}
 }
 
 // GetAllInterviews retrieves all interviews from the repository
 // This method interacts with the repository layer to fetch all interview records.
+// @param ctx context.Context - Cancels the operation and carries its deadline
 // @return []*domain.Interview - A slice containing all interviews
 // @return error - An error if the retrieval operation fails
-func (s *interviewServiceImpl) GetAllInterviews() ([]*domain.Interview, error) {
-	return s.repo.FindAll() // Call the repository method to fetch all interviews
+func (s *interviewServiceImpl) GetAllInterviews(ctx context.Context) ([]*domain.Interview, error) {
+	return s.repo.FindAll(ctx) // Call the repository method to fetch all interviews
+}
+
+// FindInterviews retrieves the interviews matching a filter
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param filter domain.InterviewFilter - The status, candidate, job and maximum number of interviews
+// @return []*domain.Interview - The matching interviews, ordered by ID
+// @return error - An error if the retrieval operation fails
+func (s *interviewServiceImpl) FindInterviews(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error) {
+	return s.repo.FindMatching(ctx, filter)
 }
 
 // AddInterview adds a new interview to the repository
-// This method interacts with the repository layer to save a new interview record.
+// This method checks that the candidate is active and the job is open, filling in the
+// candidate's email when none was given, and then interacts with the repository layer to
+// save a new interview record. The interview.created event is written to the outbox in the
+// same transaction and relayed to notifications and webhooks in the background.
+// @param ctx context.Context - Cancels the operation and carries its deadline
 // @param interview *domain.Interview - The interview data to be added
-// @return error - An error if the creation operation fails
-func (s *interviewServiceImpl) AddInterview(interview *domain.Interview) error {
-	return s.repo.Create(interview) // Call the repository method to add the new interview
+// @return error - A KindValidation or KindUnavailable *Error, or an error if the creation operation fails
+func (s *interviewServiceImpl) AddInterview(ctx context.Context, interview *domain.Interview) error {
+	if err := s.validateCandidate(ctx, interview); err != nil {
+		return err
+	}
+	if err := s.validateJob(ctx, interview.JobID); err != nil {
+		return err
+	}
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, repos repository.Repositories) error {
+		return repos.Interviews.Create(ctx, interview) // Call the repository method to add the new interview
+	})
+	if err != nil {
+		return err
+	}
+	createdTotal.Inc()
+	return nil
+}
+
+// validateCandidate checks that the interview's candidate exists and is still active
+// @param ctx context.Context - Cancels the lookup and carries its deadline and trace
+// @param interview *domain.Interview - The interview whose candidate is checked; its CandidateEmail is filled in when empty
+// @return error - A KindValidation or KindUnavailable *Error, or the client error if the candidate could not be looked up
+func (s *interviewServiceImpl) validateCandidate(ctx context.Context, interview *domain.Interview) error {
+	if s.candidates == nil {
+		return nil
+	}
+	candidate, err := s.candidates.GetCandidate(ctx, interview.CandidateID)
+	if errors.Is(err, client.ErrNotFound) {
+		return invalid(ErrInvalidReference, "candidate_id", "candidate %d does not exist", interview.CandidateID)
+	}
+	if err != nil {
+		return fromRepository(fmt.Errorf("looking up candidate %d: %w", interview.CandidateID, err), "candidate")
+	}
+	if !candidate.Active() {
+		return invalid(ErrInvalidReference, "candidate_id", "candidate %d is %s", interview.CandidateID, candidate.Status)
+	}
+	if interview.CandidateEmail == "" {
+		interview.CandidateEmail = candidate.Email
+	}
+	return nil
+}
+
+// validateJob checks that the job exists and is still open
+// @param ctx context.Context - Cancels the lookup and carries its deadline and trace
+// @param jobID int - The ID of the job
+// @return error - A KindValidation or KindUnavailable *Error, or the client error if the job could not be looked up
+func (s *interviewServiceImpl) validateJob(ctx context.Context, jobID int) error {
+	if s.jobs == nil {
+		return nil
+	}
+	job, err := s.jobs.GetJob(ctx, jobID)
+	if errors.Is(err, client.ErrNotFound) {
+		return invalid(ErrInvalidReference, "job_id", "job %d does not exist", jobID)
+	}
+	if err != nil {
+		return fromRepository(fmt.Errorf("looking up job %d: %w", jobID, err), "job")
+	}
+	if !job.Open() {
+		return invalid(ErrInvalidReference, "job_id", "job %d is %s", jobID, job.Status)
+	}
+	return nil
+}
+
+// GetInterviewByID retrieves a single interview
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param id int - The ID of the interview
+// @return *domain.Interview - The interview with its panel
+// @return error - A KindNotFound *Error, or an error if the retrieval operation fails
+func (s *interviewServiceImpl) GetInterviewByID(ctx context.Context, id int) (*domain.Interview, error) {
+	interview, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fromRepository(err, fmt.Sprintf("interview %d", id))
+	}
+	return interview, nil
+}
+
+// GetInterviewsByIDs retrieves several interviews at once
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param ids []int - The IDs of the interviews
+// @return []*domain.Interview - The interviews found
+// @return error - An error if the retrieval operation fails
+func (s *interviewServiceImpl) GetInterviewsByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error) {
+	return s.repo.FindByIDs(ctx, ids)
+}
+
+// UpdateInterview saves the date, feedback, stage and candidate email of an interview
+// The status, panel and references are kept as stored; the stored interview is read and saved
+// in the same transaction.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param interview *domain.Interview - The interview with its new values, updated in place
+// @return error - A KindNotFound or KindConflict *Error, or an error if the update fails
+func (s *interviewServiceImpl) UpdateInterview(ctx context.Context, interview *domain.Interview) error {
+	var saved *domain.Interview
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, repos repository.Repositories) error {
+		current, err := repos.Interviews.FindByID(ctx, interview.ID)
+		if err != nil {
+			return err
+		}
+
+		current.InterviewDate = interview.InterviewDate
+		current.Feedback = interview.Feedback
+		current.Stage = interview.Stage
+		current.CandidateEmail = interview.CandidateEmail
+		if err := repos.Interviews.Update(ctx, current); err != nil {
+			return err
+		}
+		saved = current
+		return nil
+	})
+	if err != nil {
+		return fromRepository(err, fmt.Sprintf("interview %d", interview.ID))
+	}
+	*interview = *saved
+	return nil
+}
+
+// CancelInterview cancels a single scheduled interview
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param id int - The ID of the interview
+// @param reason string - The reason given for the cancellation
+// @return *domain.Interview - The cancelled interview
+// @return error - A KindNotFound or KindConflict *Error, or an error if the cancellation fails
+func (s *interviewServiceImpl) CancelInterview(ctx context.Context, id int, reason string) (*domain.Interview, error) {
+	var interview *domain.Interview
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, repos repository.Repositories) error {
+		var err error
+		interview, err = repos.Interviews.FindByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if interview.Status != domain.StatusScheduled {
+			return repository.ErrNotScheduled
+		}
+		err = repos.Interviews.Cancel(ctx, interview, reason)
+		if errors.Is(err, repository.ErrNotFound) {
+			return repository.ErrNotScheduled // Cancelled concurrently
+		}
+		return err
+	})
+	if err != nil {
+		return nil, fromRepository(err, fmt.Sprintf("interview %d", id))
+	}
+	cancelledTotal.WithLabelValues("api").Inc()
+	return interview, nil
+}
+
+// CancelUpcomingForCandidate cancels every future interview of a candidate
+// This method retrieves the candidate's upcoming interviews and cancels them in a single transaction.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param candidateID int - The ID of the candidate
+// @param reason string - The reason given for the cancellations
+// @return int - The number of interviews cancelled
+// @return error - An error if the interviews could not be retrieved or cancelled
+func (s *interviewServiceImpl) CancelUpcomingForCandidate(ctx context.Context, candidateID int, reason string) (int, error) {
+	return s.cancelUpcoming(ctx, "candidate", reason, func(ctx context.Context, repo repository.InterviewRepository) ([]*domain.Interview, error) {
+		return repo.FindUpcomingByCandidate(ctx, candidateID, time.Now().UTC())
+	})
+}
+
+// CancelUpcomingForJob cancels every future interview for a job
+// This method retrieves the job's upcoming interviews and cancels them in a single transaction.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param jobID int - The ID of the job
+// @param reason string - The reason given for the cancellations
+// @return int - The number of interviews cancelled
+// @return error - An error if the interviews could not be retrieved or cancelled
+func (s *interviewServiceImpl) CancelUpcomingForJob(ctx context.Context, jobID int, reason string) (int, error) {
+	return s.cancelUpcoming(ctx, "job", reason, func(ctx context.Context, repo repository.InterviewRepository) ([]*domain.Interview, error) {
+		return repo.FindUpcomingByJob(ctx, jobID, time.Now().UTC())
+	})
+}
+
+// FlagOverdueInterviews moves interviews that ended without an outcome or feedback to needs_attention
+// An interview is considered over DefaultInterviewDuration after it started.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param now time.Time - The current time
+// @return int - The number of interviews flagged
+// @return error - An error if the update fails
+func (s *interviewServiceImpl) FlagOverdueInterviews(ctx context.Context, now time.Time) (int, error) {
+	return s.repo.FlagOverdue(ctx, now.Add(-domain.DefaultInterviewDuration))
+}
+
+// GetInterviewsNeedingAttention retrieves the interviews flagged as needing attention
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @return []*domain.Interview - The flagged interviews
+// @return error - An error if the retrieval operation fails
+func (s *interviewServiceImpl) GetInterviewsNeedingAttention(ctx context.Context) ([]*domain.Interview, error) {
+	return s.repo.FindByStatus(ctx, domain.StatusNeedsAttention)
+}
+
+// ResolveAttention records the outcome of flagged interviews in bulk
+// This method validates the outcome and delegates the update to the repository layer.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param resolution domain.AttentionResolution - The interviews and the outcome to record
+// @return int - The number of interviews updated
+// @return error - A KindValidation *Error wrapping ErrInvalidResolution, or an error if the update fails
+func (s *interviewServiceImpl) ResolveAttention(ctx context.Context, resolution domain.AttentionResolution) (int, error) {
+	if resolution.Status != domain.StatusCompleted && resolution.Status != domain.StatusNoShow {
+		return 0, invalid(ErrInvalidResolution, "status", "must be %q or %q", domain.StatusCompleted, domain.StatusNoShow)
+	}
+	if len(resolution.InterviewIDs) == 0 {
+		return 0, invalid(ErrInvalidResolution, "interview_ids", "is required")
+	}
+	return s.repo.Resolve(ctx, resolution.InterviewIDs, resolution.Status)
+}
+
+// cancelUpcoming cancels the interviews returned by find with the same reason, in a single transaction
+// Interviews cancelled concurrently by someone else are skipped, which keeps
+// reprocessing the same inbound event harmless.
+// @param ctx context.Context - Cancels the operation and carries its deadline
+// @param trigger string - What caused the cancellations, counted in interviews_cancelled_total
+// @param reason string - The reason given for the cancellations
+// @param find func(ctx context.Context, repo repository.InterviewRepository) ([]*domain.Interview, error) - Retrieves the interviews to cancel
+// @return int - The number of interviews cancelled, zero when the transaction was rolled back
+// @return error - The first error returned by the repository
+func (s *interviewServiceImpl) cancelUpcoming(ctx context.Context, trigger, reason string,
+	find func(ctx context.Context, repo repository.InterviewRepository) ([]*domain.Interview, error)) (int, error) {
+	cancelled := 0
+	err := s.tx.WithinTx(ctx, func(ctx context.Context, repos repository.Repositories) error {
+		interviews, err := find(ctx, repos.Interviews)
+		if err != nil {
+			return err
+		}
+		cancelled = 0 // Counted again when the transaction is retried
+		for _, interview := range interviews {
+			if err := repos.Interviews.Cancel(ctx, interview, reason); err != nil {
+				if errors.Is(err, repository.ErrNotFound) {
+					continue // Already cancelled
+				}
+				return err
+			}
+			cancelled++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	cancelledTotal.WithLabelValues(trigger).Add(float64(cancelled))
+	return cancelled, nil
 }
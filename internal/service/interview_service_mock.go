@@ -1,6 +1,9 @@
 package service
 
 import (
+	"context"
+	"time"
+
 	"github.com/poolcamacho/interviews-service/internal/domain"
 	"github.com/stretchr/testify/mock"
 )
@@ -11,10 +14,24 @@ type MockInterviewService struct {
 }
 
 // GetAllInterviews mocks the GetAllInterviews method
+// @param ctx context.Context - The context of the call
 // @return []*domain.Interview - A slice of interviews
 // @return error - An error if the operation fails
-func (m *MockInterviewService) GetAllInterviews() ([]*domain.Interview, error) {
-	args := m.Called()
+func (m *MockInterviewService) GetAllInterviews(ctx context.Context) ([]*domain.Interview, error) {
+	args := m.Called(ctx)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// FindInterviews mocks the FindInterviews method
+// @param ctx context.Context - The context of the call
+// @param filter domain.InterviewFilter - The filter of the call
+// @return []*domain.Interview - A slice of interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewService) FindInterviews(ctx context.Context, filter domain.InterviewFilter) ([]*domain.Interview, error) {
+	args := m.Called(ctx, filter)
 	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
 		return interviews, args.Error(1)
 	}
@@ -22,37 +39,122 @@ func (m *MockInterviewService) GetAllInterviews() ([]*domain.Interview, error) {
 }
 
 // AddInterview mocks the AddInterview method
+// @param ctx context.Context - The context of the call
 // @param interview *domain.Interview - The interview data to be added
 // @return error - An error if the operation fails
-func (m *MockInterviewService) AddInterview(interview *domain.Interview) error {
-	args := m.Called(interview)
+func (m *MockInterviewService) AddInterview(ctx context.Context, interview *domain.Interview) error {
+	args := m.Called(ctx, interview)
 	return args.Error(0)
 }
 
+// CancelUpcomingForCandidate mocks the CancelUpcomingForCandidate method
+// @param ctx context.Context - The context of the call
+// @param candidateID int - The ID of the candidate
+// @param reason string - The reason given for the cancellations
+// @return int - The number of interviews cancelled
+// @return error - An error if the operation fails
+func (m *MockInterviewService) CancelUpcomingForCandidate(ctx context.Context, candidateID int, reason string) (int, error) {
+	args := m.Called(ctx, candidateID, reason)
+	return args.Int(0), args.Error(1)
+}
+
+// CancelUpcomingForJob mocks the CancelUpcomingForJob method
+// @param ctx context.Context - The context of the call
+// @param jobID int - The ID of the job
+// @param reason string - The reason given for the cancellations
+// @return int - The number of interviews cancelled
+// @return error - An error if the operation fails
+func (m *MockInterviewService) CancelUpcomingForJob(ctx context.Context, jobID int, reason string) (int, error) {
+	args := m.Called(ctx, jobID, reason)
+	return args.Int(0), args.Error(1)
+}
+
+// FlagOverdueInterviews mocks the FlagOverdueInterviews method
+// @param ctx context.Context - The context of the call
+// @param now time.Time - The current time
+// @return int - The number of interviews flagged
+// @return error - An error if the operation fails
+func (m *MockInterviewService) FlagOverdueInterviews(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+// GetInterviewsNeedingAttention mocks the GetInterviewsNeedingAttention method
+// @param ctx context.Context - The context of the call
+// @return []*domain.Interview - A slice of interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewService) GetInterviewsNeedingAttention(ctx context.Context) ([]*domain.Interview, error) {
+	args := m.Called(ctx)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ResolveAttention mocks the ResolveAttention method
+// @param ctx context.Context - The context of the call
+// @param resolution domain.AttentionResolution - The interviews and the outcome to record
+// @return int - The number of interviews updated
+// @return error - An error if the operation fails
+func (m *MockInterviewService) ResolveAttention(ctx context.Context, resolution domain.AttentionResolution) (int, error) {
+	args := m.Called(ctx, resolution)
+	return args.Int(0), args.Error(1)
+}
+
 // GetInterviewByID mocks the GetInterviewByID method
+// @param ctx context.Context - The context of the call
 // @param id int - The ID of the interview to retrieve
 // @return *domain.Interview - The retrieved interview
 // @return error - An error if the operation fails
-func (m *MockInterviewService) GetInterviewByID(id int) (*domain.Interview, error) {
-	args := m.Called(id)
+func (m *MockInterviewService) GetInterviewByID(ctx context.Context, id int) (*domain.Interview, error) {
+	args := m.Called(ctx, id)
 	if interview, ok := args.Get(0).(*domain.Interview); ok {
 		return interview, args.Error(1)
 	}
 	return nil, args.Error(1)
 }
 
+// GetInterviewsByIDs mocks the GetInterviewsByIDs method
+// @param ctx context.Context - The context of the call
+// @param ids []int - The IDs of the interviews to retrieve
+// @return []*domain.Interview - The retrieved interviews
+// @return error - An error if the operation fails
+func (m *MockInterviewService) GetInterviewsByIDs(ctx context.Context, ids []int) ([]*domain.Interview, error) {
+	args := m.Called(ctx, ids)
+	if interviews, ok := args.Get(0).([]*domain.Interview); ok {
+		return interviews, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // UpdateInterview mocks the UpdateInterview method
+// @param ctx context.Context - The context of the call
 // @param interview *domain.Interview - The interview data to be updated
 // @return error - An error if the operation fails
-func (m *MockInterviewService) UpdateInterview(interview *domain.Interview) error {
-	args := m.Called(interview)
+func (m *MockInterviewService) UpdateInterview(ctx context.Context, interview *domain.Interview) error {
+	args := m.Called(ctx, interview)
 	return args.Error(0)
 }
 
+// CancelInterview mocks the CancelInterview method
+// @param ctx context.Context - The context of the call
+// @param id int - The ID of the interview to cancel
+// @param reason string - The reason given for the cancellation
+// @return *domain.Interview - The cancelled interview
+// @return error - An error if the operation fails
+func (m *MockInterviewService) CancelInterview(ctx context.Context, id int, reason string) (*domain.Interview, error) {
+	args := m.Called(ctx, id, reason)
+	if interview, ok := args.Get(0).(*domain.Interview); ok {
+		return interview, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // DeleteInterview mocks the DeleteInterview method
+// @param ctx context.Context - The context of the call
 // @param id int - The ID of the interview to delete
 // @return error - An error if the operation fails
-func (m *MockInterviewService) DeleteInterview(id int) error {
-	args := m.Called(id)
+func (m *MockInterviewService) DeleteInterview(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
 	return args.Error(0)
 }
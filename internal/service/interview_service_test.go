@@ -1,19 +1,23 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
+	"github.com/poolcamacho/interviews-service/internal/client"
 	"github.com/poolcamacho/interviews-service/internal/domain"
 	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestGetAllInterviews(t *testing.T) {
 	// Setup
 	mockRepo := new(repository.MockInterviewRepository)
-	interviewService := NewInterviewService(mockRepo)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
 
 	// Mock data
 	interviews := []*domain.Interview{
@@ -34,10 +38,10 @@ func TestGetAllInterviews(t *testing.T) {
 	}
 
 	// Mock behavior
-	mockRepo.On("FindAll").Return(interviews, nil)
+	mockRepo.On("FindAll", mock.Anything).Return(interviews, nil)
 
 	// Execute
-	result, err := interviewService.GetAllInterviews()
+	result, err := interviewService.GetAllInterviews(context.Background())
 
 	// Assertions
 	assert.NoError(t, err)
@@ -48,13 +52,13 @@ func TestGetAllInterviews(t *testing.T) {
 func TestGetAllInterviews_Error(t *testing.T) {
 	// Setup
 	mockRepo := new(repository.MockInterviewRepository)
-	interviewService := NewInterviewService(mockRepo)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
 
 	// Mock behavior
-	mockRepo.On("FindAll").Return(nil, errors.New("database error"))
+	mockRepo.On("FindAll", mock.Anything).Return(nil, errors.New("database error"))
 
 	// Execute
-	result, err := interviewService.GetAllInterviews()
+	result, err := interviewService.GetAllInterviews(context.Background())
 
 	// Assertions
 	assert.Error(t, err)
@@ -63,10 +67,32 @@ func TestGetAllInterviews_Error(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestFindInterviews(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+	filter := domain.InterviewFilter{Status: domain.StatusScheduled, CandidateID: 101, Limit: 10}
+
+	// Mock data
+	interviews := []*domain.Interview{{ID: 1, CandidateID: 101, JobID: 201, Status: domain.StatusScheduled}}
+
+	// Mock behavior
+	mockRepo.On("FindMatching", mock.Anything, filter).Return(interviews, nil)
+
+	// Execute
+	result, err := interviewService.FindInterviews(context.Background(), filter)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, interviews, result)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "FindAll", mock.Anything)
+}
+
 func TestAddInterview(t *testing.T) {
 	// Setup
 	mockRepo := new(repository.MockInterviewRepository)
-	interviewService := NewInterviewService(mockRepo)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
 
 	// Mock data
 	newInterview := &domain.Interview{
@@ -76,21 +102,24 @@ func TestAddInterview(t *testing.T) {
 		Feedback:      "Good communication skills.",
 	}
 
+	created := testutil.ToFloat64(createdTotal)
+
 	// Mock behavior
-	mockRepo.On("Create", newInterview).Return(nil)
+	mockRepo.On("Create", mock.Anything, newInterview).Return(nil)
 
 	// Execute
-	err := interviewService.AddInterview(newInterview)
+	err := interviewService.AddInterview(context.Background(), newInterview)
 
 	// Assertions
 	assert.NoError(t, err)
+	assert.Equal(t, created+1, testutil.ToFloat64(createdTotal))
 	mockRepo.AssertExpectations(t)
 }
 
 func TestAddInterview_Error(t *testing.T) {
 	// Setup
 	mockRepo := new(repository.MockInterviewRepository)
-	interviewService := NewInterviewService(mockRepo)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
 
 	// Mock data
 	newInterview := &domain.Interview{
@@ -100,18 +129,268 @@ func TestAddInterview_Error(t *testing.T) {
 		Feedback:      "Requires more technical expertise.",
 	}
 
+	created := testutil.ToFloat64(createdTotal)
+
 	// Mock behavior
-	mockRepo.On("Create", newInterview).Return(errors.New("insertion error"))
+	mockRepo.On("Create", mock.Anything, newInterview).Return(errors.New("insertion error"))
 
 	// Execute
-	err := interviewService.AddInterview(newInterview)
+	err := interviewService.AddInterview(context.Background(), newInterview)
 
 	// Assertions
 	assert.Error(t, err)
 	assert.EqualError(t, err, "insertion error")
+	assert.Equal(t, created, testutil.ToFloat64(createdTotal)) // Only committed interviews are counted
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAddInterview_ValidatesReferences(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	candidates := client.NewFakeCandidateClient(
+		&client.Candidate{ID: 101, Email: "ana@example.com", Status: "active"},
+		&client.Candidate{ID: 102, Status: "withdrawn"},
+	)
+	jobs := client.NewFakeJobClient(
+		&client.Job{ID: 201, Status: "open"},
+		&client.Job{ID: 202, Status: "closed"},
+	)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), candidates, jobs)
+
+	// Mock data
+	valid := &domain.Interview{CandidateID: 101, JobID: 201, InterviewDate: mockInterviewDate()}
+
+	// Mock behavior
+	mockRepo.On("Create", mock.Anything, valid).Return(nil)
+
+	// Execute and assert
+	assert.NoError(t, interviewService.AddInterview(context.Background(), valid))
+	assert.Equal(t, "ana@example.com", valid.CandidateEmail) // Filled in from the candidates service
+
+	for _, invalid := range []*domain.Interview{
+		{CandidateID: 999, JobID: 201, InterviewDate: mockInterviewDate()}, // Unknown candidate
+		{CandidateID: 102, JobID: 201, InterviewDate: mockInterviewDate()}, // Withdrawn candidate
+		{CandidateID: 101, JobID: 999, InterviewDate: mockInterviewDate()}, // Unknown job
+		{CandidateID: 101, JobID: 202, InterviewDate: mockInterviewDate()}, // Closed job
+	} {
+		err := interviewService.AddInterview(context.Background(), invalid)
+		assert.ErrorIs(t, err, ErrInvalidReference)
+	}
+	mockRepo.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestAddInterview_ServiceUnavailable(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	candidates := new(client.MockCandidateClient)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), candidates, nil)
+
+	// Mock behavior
+	candidates.On("GetCandidate", mock.Anything, 101).Return(nil, client.ErrUnavailable)
+
+	// Execute
+	err := interviewService.AddInterview(context.Background(), &domain.Interview{CandidateID: 101, JobID: 201, InterviewDate: mockInterviewDate()})
+
+	// Assertions
+	assert.ErrorIs(t, err, client.ErrUnavailable)
+	assert.NotErrorIs(t, err, ErrInvalidReference)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCancelUpcomingForCandidate(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+
+	// Mock data
+	upcoming := []*domain.Interview{
+		{ID: 1, CandidateID: 101, JobID: 201, Status: domain.StatusScheduled},
+		{ID: 2, CandidateID: 101, JobID: 202, Status: domain.StatusScheduled},
+		{ID: 3, CandidateID: 101, JobID: 203, Status: domain.StatusScheduled},
+	}
+	cancelledCount := testutil.ToFloat64(cancelledTotal.WithLabelValues("candidate"))
+
+	// Mock behavior
+	mockRepo.On("FindUpcomingByCandidate", mock.Anything, 101, mock.AnythingOfType("time.Time")).Return(upcoming, nil)
+	mockRepo.On("Cancel", mock.Anything, upcoming[0], "withdrawn").Return(nil)
+	mockRepo.On("Cancel", mock.Anything, upcoming[1], "withdrawn").Return(repository.ErrNotFound) // Cancelled concurrently
+	mockRepo.On("Cancel", mock.Anything, upcoming[2], "withdrawn").Return(nil)
+
+	// Execute
+	cancelled, err := interviewService.CancelUpcomingForCandidate(context.Background(), 101, "withdrawn")
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, cancelled)
+	assert.Equal(t, cancelledCount+2, testutil.ToFloat64(cancelledTotal.WithLabelValues("candidate")))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCancelUpcomingForJob_Error(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+
+	// Mock data
+	upcoming := []*domain.Interview{{ID: 1, CandidateID: 101, JobID: 201}}
+
+	// Mock behavior
+	mockRepo.On("FindUpcomingByJob", mock.Anything, 201, mock.AnythingOfType("time.Time")).Return(upcoming, nil)
+	mockRepo.On("Cancel", mock.Anything, upcoming[0], "closed").Return(errors.New("database error"))
+
+	// Execute
+	cancelled, err := interviewService.CancelUpcomingForJob(context.Background(), 201, "closed")
+
+	// Assertions
+	assert.EqualError(t, err, "database error")
+	assert.Equal(t, 0, cancelled)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCancelUpcomingForJob_RollsBack(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	txManager := newTxManager(mockRepo)
+	interviewService := NewInterviewService(mockRepo, txManager, nil, nil)
+
+	// Mock data
+	upcoming := []*domain.Interview{{ID: 1, CandidateID: 101, JobID: 201}, {ID: 2, CandidateID: 102, JobID: 201}}
+	cancelledCount := testutil.ToFloat64(cancelledTotal.WithLabelValues("job"))
+
+	// Mock behavior
+	mockRepo.On("FindUpcomingByJob", mock.Anything, 201, mock.AnythingOfType("time.Time")).Return(upcoming, nil)
+	mockRepo.On("Cancel", mock.Anything, upcoming[0], "closed").Return(nil)
+	mockRepo.On("Cancel", mock.Anything, upcoming[1], "closed").Return(errors.New("database error"))
+
+	// Execute
+	cancelled, err := interviewService.CancelUpcomingForJob(context.Background(), 201, "closed")
+
+	// Assertions
+	assert.EqualError(t, err, "database error")
+	assert.Equal(t, 0, cancelled) // The first cancellation was rolled back with the second
+	assert.Equal(t, cancelledCount, testutil.ToFloat64(cancelledTotal.WithLabelValues("job")))
+	txManager.AssertNumberOfCalls(t, "WithinTx", 1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFlagOverdueInterviews(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+	now := mockInterviewDate()
+
+	// Mock behavior: interviews that started an interview length ago are over
+	mockRepo.On("FlagOverdue", mock.Anything, now.Add(-domain.DefaultInterviewDuration)).Return(2, nil)
+
+	// Execute
+	flagged, err := interviewService.FlagOverdueInterviews(context.Background(), now)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, flagged)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResolveAttention(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+
+	// Mock behavior
+	mockRepo.On("Resolve", mock.Anything, []int{1, 2}, domain.StatusNoShow).Return(2, nil)
+
+	// Execute
+	updated, err := interviewService.ResolveAttention(context.Background(), domain.AttentionResolution{InterviewIDs: []int{1, 2}, Status: domain.StatusNoShow})
+	_, statusErr := interviewService.ResolveAttention(context.Background(), domain.AttentionResolution{InterviewIDs: []int{1}, Status: domain.StatusCancelled})
+	_, idsErr := interviewService.ResolveAttention(context.Background(), domain.AttentionResolution{Status: domain.StatusCompleted})
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 2, updated)
+	assert.ErrorIs(t, statusErr, ErrInvalidResolution)
+	assert.ErrorIs(t, idsErr, ErrInvalidResolution)
+	mockRepo.AssertNumberOfCalls(t, "Resolve", 1)
+}
+
+func TestUpdateInterview(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+
+	// Mock data
+	stored := &domain.Interview{ID: 1, CandidateID: 101, JobID: 201, InterviewDate: mockInterviewDate(),
+		Status: domain.StatusScheduled, Panel: []domain.Panelist{{ID: 1, Name: "Alice"}}}
+	moved := mockInterviewDate().Add(24 * time.Hour)
+	update := &domain.Interview{ID: 1, InterviewDate: moved, Stage: "onsite"}
+
+	// Mock behavior
+	mockRepo.On("FindByID", mock.Anything, 1).Return(stored, nil)
+	mockRepo.On("Update", mock.Anything, stored).Return(nil)
+
+	// Execute
+	err := interviewService.UpdateInterview(context.Background(), update)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, moved, update.InterviewDate)
+	assert.Equal(t, "onsite", update.Stage)
+	assert.Equal(t, 101, update.CandidateID) // Kept from the stored interview
+	assert.Len(t, update.Panel, 1)           // Kept from the stored interview
 	mockRepo.AssertExpectations(t)
 }
 
+func TestCancelInterview_CountsCancellations(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+	scheduled := &domain.Interview{ID: 1, Status: domain.StatusScheduled}
+	cancelledCount := testutil.ToFloat64(cancelledTotal.WithLabelValues("api"))
+
+	// Mock behavior
+	mockRepo.On("FindByID", mock.Anything, 1).Return(scheduled, nil)
+	mockRepo.On("Cancel", mock.Anything, scheduled, "no longer needed").Return(nil)
+
+	// Execute
+	_, readErr := interviewService.GetInterviewByID(context.Background(), 1)
+	afterRead := testutil.ToFloat64(cancelledTotal.WithLabelValues("api"))
+	_, cancelErr := interviewService.CancelInterview(context.Background(), 1, "no longer needed")
+
+	// Assertions
+	assert.NoError(t, readErr)
+	assert.NoError(t, cancelErr)
+	assert.Equal(t, cancelledCount, afterRead) // Reads are not cancellations
+	assert.Equal(t, cancelledCount+1, testutil.ToFloat64(cancelledTotal.WithLabelValues("api")))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCancelInterview_NotScheduled(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockInterviewRepository)
+	interviewService := NewInterviewService(mockRepo, newTxManager(mockRepo), nil, nil)
+
+	// Mock behavior
+	mockRepo.On("FindByID", mock.Anything, 1).Return(&domain.Interview{ID: 1, Status: domain.StatusCompleted}, nil)
+	mockRepo.On("FindByID", mock.Anything, 2).Return(nil, repository.ErrNotFound)
+
+	// Execute
+	cancelledCount := testutil.ToFloat64(cancelledTotal.WithLabelValues("api"))
+	_, err := interviewService.CancelInterview(context.Background(), 1, "no longer needed")
+	_, missingErr := interviewService.CancelInterview(context.Background(), 2, "no longer needed")
+
+	// Assertions
+	assert.ErrorIs(t, err, repository.ErrNotScheduled)
+	assert.ErrorIs(t, missingErr, repository.ErrNotFound)
+	assert.Equal(t, cancelledCount, testutil.ToFloat64(cancelledTotal.WithLabelValues("api"))) // Failed cancellations are not counted
+	mockRepo.AssertNotCalled(t, "Cancel", mock.Anything, mock.Anything, mock.Anything)
+}
+
+// newTxManager provides a transaction manager running units of work against the mocked repository
+func newTxManager(repo repository.InterviewRepository) *repository.MockTxManager {
+	txManager := &repository.MockTxManager{Repositories: repository.Repositories{Interviews: repo}}
+	txManager.On("WithinTx", mock.Anything).Return(nil)
+	return txManager
+}
+
 // mockInterviewDate provides a mock interview date for testing
 func mockInterviewDate() time.Time {
 	date, _ := time.Parse("2006-01-02 15:04:05", "2024-12-30 15:00:00")
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/webhook"
+)
+
+// ErrInvalidSubscription is returned when a subscription request fails validation
+var ErrInvalidSubscription = errors.New("invalid webhook subscription")
+
+// ErrNotReplayable is returned when replaying a delivery that is not in the dead-letter list
+var ErrNotReplayable = errors.New("only dead deliveries can be replayed")
+
+// WebhookService defines methods for managing webhook subscriptions
+// This interface abstracts the business logic for subscriptions, their delivery log and the dead-letter list.
+type WebhookService interface {
+	// CreateSubscription validates and stores a new subscription
+	// A random secret is generated when none is provided.
+	// @param sub *domain.WebhookSubscription - The subscription to create
+	// @return error - A KindValidation *Error wrapping ErrInvalidSubscription if validation fails, or a storage error
+	CreateSubscription(sub *domain.WebhookSubscription) error
+
+	// ListSubscriptions retrieves all subscriptions without their secrets
+	// @return []*domain.WebhookSubscription - A slice containing all subscriptions
+	// @return error - An error if there is an issue retrieving the subscriptions
+	ListSubscriptions() ([]*domain.WebhookSubscription, error)
+
+	// DeleteSubscription removes a subscription and its delivery log
+	// @param id int - The ID of the subscription
+	// @return error - A KindNotFound *Error if the subscription does not exist
+	DeleteSubscription(id int) error
+
+	// ListDeliveries retrieves the delivery log of a subscription
+	// @param subscriptionID int - The ID of the subscription
+	// @return []*domain.WebhookDelivery - The deliveries, newest first
+	// @return error - A KindNotFound *Error if the subscription does not exist
+	ListDeliveries(subscriptionID int) ([]*domain.WebhookDelivery, error)
+
+	// ListDeadLetters retrieves deliveries that exhausted their retries
+	// @return []*domain.WebhookDelivery - The dead deliveries, newest first
+	// @return error - An error if there is an issue retrieving the deliveries
+	ListDeadLetters() ([]*domain.WebhookDelivery, error)
+
+	// ReplayDelivery schedules a dead delivery to be sent again with a fresh set of attempts
+	// @param id int - The ID of the delivery
+	// @return *domain.WebhookDelivery - The rescheduled delivery
+	// @return error - A KindNotFound *Error, or a KindConflict *Error wrapping ErrNotReplayable
+	ReplayDelivery(id int) (*domain.WebhookDelivery, error)
+}
+
+// resolver looks up the addresses of a host, implemented by *net.Resolver
+type resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+type webhookServiceImpl struct {
+	repo     repository.WebhookRepository // Dependency on the WebhookRepository
+	resolver resolver                     // Resolves subscription hosts, replaced in tests
+}
+
+// NewWebhookService creates a new WebhookService instance
+// This constructor initializes the service with the provided repository.
+// @param repo repository.WebhookRepository - The repository used for database operations
+// @return WebhookService - An instance of the service interface implementation
+func NewWebhookService(repo repository.WebhookRepository) WebhookService {
+	return &webhookServiceImpl{repo: repo, resolver: net.DefaultResolver}
+}
+
+// CreateSubscription validates and stores a new subscription
+// Only absolute http(s) URLs on public addresses and event types emitted by the service are accepted.
+// @param sub *domain.WebhookSubscription - The subscription to create
+// @return error - A KindValidation *Error wrapping ErrInvalidSubscription if validation fails, or a storage error
+func (s *webhookServiceImpl) CreateSubscription(sub *domain.WebhookSubscription) error {
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return invalid(ErrInvalidSubscription, "url", "must be an absolute http or https URL")
+	}
+	if !s.publicHost(u.Hostname()) {
+		return invalid(ErrInvalidSubscription, "url", "must not point to a loopback, link-local or private address")
+	}
+	if len(sub.EventTypes) == 0 {
+		return invalid(ErrInvalidSubscription, "event_types", "at least one event type is required")
+	}
+	for _, t := range sub.EventTypes {
+		if !t.Valid() {
+			return invalid(ErrInvalidSubscription, "event_types", "unknown event type %q", t)
+		}
+	}
+
+	if sub.Secret == "" {
+		if sub.Secret, err = webhook.GenerateSecret(); err != nil {
+			return err
+		}
+	}
+	sub.CreatedAt = time.Now().UTC()
+	return s.repo.CreateSubscription(sub)
+}
+
+// publicHost reports whether a host is an address, or resolves to addresses, the dispatcher may call
+// A host that cannot be resolved yet is accepted, since the dispatcher checks the address again on every request.
+// @param host string - The host of the subscription URL
+// @return bool - False if the host is or resolves to an address webhook.PublicAddress refuses
+func (s *webhookServiceImpl) publicHost(host string) bool {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return webhook.PublicAddress(addr)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	addrs, err := s.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return true
+	}
+	for _, addr := range addrs {
+		if !webhook.PublicAddress(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ListSubscriptions retrieves all subscriptions without their secrets
+// Secrets are only disclosed once, in the response to the creation request.
+// @return []*domain.WebhookSubscription - A slice containing all subscriptions
+// @return error - An error if there is an issue retrieving the subscriptions
+func (s *webhookServiceImpl) ListSubscriptions() ([]*domain.WebhookSubscription, error) {
+	subs, err := s.repo.FindAllSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		sub.Secret = ""
+	}
+	return subs, nil
+}
+
+// DeleteSubscription removes a subscription and its delivery log
+// @param id int - The ID of the subscription
+// @return error - A KindNotFound *Error if the subscription does not exist
+func (s *webhookServiceImpl) DeleteSubscription(id int) error {
+	return fromRepository(s.repo.DeleteSubscription(id), fmt.Sprintf("subscription %d", id))
+}
+
+// ListDeliveries retrieves the delivery log of a subscription
+// @param subscriptionID int - The ID of the subscription
+// @return []*domain.WebhookDelivery - The deliveries, newest first
+// @return error - A KindNotFound *Error if the subscription does not exist
+func (s *webhookServiceImpl) ListDeliveries(subscriptionID int) ([]*domain.WebhookDelivery, error) {
+	if _, err := s.repo.FindSubscriptionByID(subscriptionID); err != nil {
+		return nil, fromRepository(err, fmt.Sprintf("subscription %d", subscriptionID))
+	}
+	return s.repo.FindDeliveriesBySubscription(subscriptionID)
+}
+
+// ListDeadLetters retrieves deliveries that exhausted their retries
+// @return []*domain.WebhookDelivery - The dead deliveries, newest first
+// @return error - An error if there is an issue retrieving the deliveries
+func (s *webhookServiceImpl) ListDeadLetters() ([]*domain.WebhookDelivery, error) {
+	return s.repo.FindDeliveriesByStatus(domain.DeliveryDead)
+}
+
+// ReplayDelivery schedules a dead delivery to be sent again with a fresh set of attempts
+// The payload is left untouched so receivers can deduplicate on the event ID.
+// @param id int - The ID of the delivery
+// @return *domain.WebhookDelivery - The rescheduled delivery
+// @return error - A KindNotFound *Error, or a KindConflict *Error wrapping ErrNotReplayable
+func (s *webhookServiceImpl) ReplayDelivery(id int) (*domain.WebhookDelivery, error) {
+	delivery, err := s.repo.FindDeliveryByID(id)
+	if err != nil {
+		return nil, fromRepository(err, fmt.Sprintf("delivery %d", id))
+	}
+	if delivery.Status != domain.DeliveryDead {
+		return nil, conflict(ErrNotReplayable, "delivery %d is %s, %v", id, delivery.Status, ErrNotReplayable)
+	}
+
+	now := time.Now().UTC()
+	delivery.Status = domain.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+	if err := s.repo.UpdateDelivery(delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWebhookService is a mock implementation of WebhookService for testing
+type MockWebhookService struct {
+	mock.Mock
+}
+
+// CreateSubscription mocks the CreateSubscription method
+func (m *MockWebhookService) CreateSubscription(sub *domain.WebhookSubscription) error {
+	args := m.Called(sub)
+	return args.Error(0)
+}
+
+// ListSubscriptions mocks the ListSubscriptions method
+func (m *MockWebhookService) ListSubscriptions() ([]*domain.WebhookSubscription, error) {
+	args := m.Called()
+	if subs, ok := args.Get(0).([]*domain.WebhookSubscription); ok {
+		return subs, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// DeleteSubscription mocks the DeleteSubscription method
+func (m *MockWebhookService) DeleteSubscription(id int) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+// ListDeliveries mocks the ListDeliveries method
+func (m *MockWebhookService) ListDeliveries(subscriptionID int) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(subscriptionID)
+	if deliveries, ok := args.Get(0).([]*domain.WebhookDelivery); ok {
+		return deliveries, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ListDeadLetters mocks the ListDeadLetters method
+func (m *MockWebhookService) ListDeadLetters() ([]*domain.WebhookDelivery, error) {
+	args := m.Called()
+	if deliveries, ok := args.Get(0).([]*domain.WebhookDelivery); ok {
+		return deliveries, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ReplayDelivery mocks the ReplayDelivery method
+func (m *MockWebhookService) ReplayDelivery(id int) (*domain.WebhookDelivery, error) {
+	args := m.Called(id)
+	if delivery, ok := args.Get(0).(*domain.WebhookDelivery); ok {
+		return delivery, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
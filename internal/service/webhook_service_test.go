@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateSubscription_GeneratesSecret(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockWebhookRepository)
+	webhookService := newTestWebhookService(mockRepo)
+	sub := &domain.WebhookSubscription{
+		URL:        "https://ats.example.com/hooks",
+		EventTypes: []domain.EventType{domain.EventInterviewCreated, domain.EventInterviewRescheduled},
+	}
+
+	// Mock behavior
+	mockRepo.On("CreateSubscription", sub).Return(nil)
+
+	// Execute
+	err := webhookService.CreateSubscription(sub)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Len(t, sub.Secret, 64)
+	assert.False(t, sub.CreatedAt.IsZero())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateSubscription_Invalid(t *testing.T) {
+	tests := map[string]*domain.WebhookSubscription{
+		"relative url":       {URL: "/hooks", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		"unsupported scheme": {URL: "ftp://ats.example.com", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		"no event types":     {URL: "https://ats.example.com/hooks"},
+		"unknown event type": {URL: "https://ats.example.com/hooks", EventTypes: []domain.EventType{"interview.deleted"}},
+		"loopback address":   {URL: "http://127.0.0.1:3000/hooks", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		"metadata address":   {URL: "http://169.254.169.254/latest", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		"private IPv6":       {URL: "http://[fd00::1]/hooks", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		"localhost":          {URL: "http://localhost:8080/hooks", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		"private host":       {URL: "https://intranet.example.com/hooks", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+	}
+
+	for name, sub := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Setup
+			mockRepo := new(repository.MockWebhookRepository)
+			webhookService := newTestWebhookService(mockRepo)
+
+			// Execute
+			err := webhookService.CreateSubscription(sub)
+
+			// Assertions
+			assert.ErrorIs(t, err, ErrInvalidSubscription)
+			mockRepo.AssertNotCalled(t, "CreateSubscription", mock.Anything)
+		})
+	}
+}
+
+func TestListSubscriptions_HidesSecrets(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockWebhookRepository)
+	webhookService := newTestWebhookService(mockRepo)
+
+	// Mock behavior
+	mockRepo.On("FindAllSubscriptions").Return([]*domain.WebhookSubscription{{ID: 1, Secret: "s3cr3t"}}, nil)
+
+	// Execute
+	subs, err := webhookService.ListSubscriptions()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Empty(t, subs[0].Secret)
+}
+
+func TestReplayDelivery(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockWebhookRepository)
+	webhookService := newTestWebhookService(mockRepo)
+	delivery := &domain.WebhookDelivery{ID: 10, Status: domain.DeliveryDead, Attempts: 8, LastError: "timeout"}
+
+	// Mock behavior
+	mockRepo.On("FindDeliveryByID", 10).Return(delivery, nil)
+	mockRepo.On("UpdateDelivery", delivery).Return(nil)
+
+	// Execute
+	result, err := webhookService.ReplayDelivery(10)
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, domain.DeliveryPending, result.Status)
+	assert.Equal(t, 0, result.Attempts)
+	assert.False(t, result.NextAttemptAt.IsZero())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReplayDelivery_NotDead(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockWebhookRepository)
+	webhookService := newTestWebhookService(mockRepo)
+
+	// Mock behavior
+	mockRepo.On("FindDeliveryByID", 10).Return(&domain.WebhookDelivery{ID: 10, Status: domain.DeliverySucceeded}, nil)
+
+	// Execute
+	_, err := webhookService.ReplayDelivery(10)
+
+	// Assertions
+	assert.ErrorIs(t, err, ErrNotReplayable)
+	mockRepo.AssertNotCalled(t, "UpdateDelivery", mock.Anything)
+}
+
+// fakeResolver resolves the hosts it holds and fails for any other
+type fakeResolver map[string][]netip.Addr
+
+// LookupNetIP returns the addresses of a known host
+func (r fakeResolver) LookupNetIP(_ context.Context, _, host string) ([]netip.Addr, error) {
+	if addrs, ok := r[host]; ok {
+		return addrs, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+// newTestWebhookService provides a WebhookService that resolves hosts without DNS for testing
+func newTestWebhookService(repo repository.WebhookRepository) WebhookService {
+	return &webhookServiceImpl{repo: repo, resolver: fakeResolver{
+		"ats.example.com":      {netip.MustParseAddr("93.184.216.34")},
+		"intranet.example.com": {netip.MustParseAddr("93.184.216.35"), netip.MustParseAddr("10.0.0.8")},
+	}}
+}
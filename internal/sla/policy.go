@@ -0,0 +1,170 @@
+package sla
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+)
+
+// Policy holds the feedback deadlines, counted in business hours after an interview ends
+// Business hours are the working hours of Monday to Friday; nights and weekends do not count.
+type Policy struct {
+	Default    time.Duration            // Deadline for stages without their own entry
+	ByStage    map[string]time.Duration // Deadlines of specific stages
+	Escalation time.Duration            // Time past the deadline before the hiring manager is nudged
+	Hours      WorkingHours             // Part of each weekday counted as business time
+}
+
+// WorkingHours is the part of each weekday counted as business time
+// The zero value counts whole weekdays in UTC.
+type WorkingHours struct {
+	Start    time.Duration  // Start of the working day, as the time since midnight
+	End      time.Duration  // End of the working day, as the time since midnight; after Start and at most 24h
+	Location *time.Location // Time zone of Start and End, nil for UTC
+}
+
+// DefaultPolicy returns the policy used when none is configured
+// @return Policy - One working day of 09:00 to 17:00 UTC for every stage, escalated after another
+func DefaultPolicy() Policy {
+	return Policy{Default: 8 * time.Hour, ByStage: map[string]time.Duration{}, Escalation: 8 * time.Hour, Hours: DefaultWorkingHours()}
+}
+
+// DefaultWorkingHours returns the working hours used when none are configured
+// @return WorkingHours - 09:00 to 17:00 UTC
+func DefaultWorkingHours() WorkingHours {
+	return WorkingHours{Start: 9 * time.Hour, End: 17 * time.Hour, Location: time.UTC}
+}
+
+// ParseWorkingHours parses working hours such as "09:00-17:00" in an IANA time zone such as "Europe/Madrid"
+// @param value string - The start and end of the working day, separated by a dash; 24:00 ends at midnight
+// @param timezone string - The time zone of the working hours
+// @return WorkingHours - The parsed working hours
+// @return error - An error if a time or the time zone is invalid, or the day ends before it starts
+func ParseWorkingHours(value, timezone string) (WorkingHours, error) {
+	rawStart, rawEnd, ok := strings.Cut(value, "-")
+	if !ok {
+		return WorkingHours{}, fmt.Errorf("working hours %q must look like 09:00-17:00", value)
+	}
+	start, err := parseClock(rawStart)
+	if err != nil {
+		return WorkingHours{}, err
+	}
+	end, err := parseClock(rawEnd)
+	if err != nil {
+		return WorkingHours{}, err
+	}
+	if end <= start {
+		return WorkingHours{}, fmt.Errorf("working hours %q must end after they start", value)
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return WorkingHours{}, err
+	}
+	return WorkingHours{Start: start, End: end, Location: location}, nil
+}
+
+// parseClock parses a time of day such as "09:30" into the time since midnight
+func parseClock(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("time of day %q must look like 09:00", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ParsePolicy parses feedback deadlines such as "24h,onsite=48h"
+// An entry without a stage sets the default deadline.
+// @param value string - The comma-separated deadlines
+// @param escalation time.Duration - The time past the deadline before escalating
+// @return Policy - The parsed policy, starting from DefaultPolicy
+// @return error - An error if a deadline is not a positive duration
+func ParsePolicy(value string, escalation time.Duration) (Policy, error) {
+	policy := DefaultPolicy()
+	policy.Escalation = escalation
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		stage, raw, hasStage := strings.Cut(part, "=")
+		if !hasStage {
+			stage, raw = "", part
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil {
+			return Policy{}, err
+		}
+		if d <= 0 {
+			return Policy{}, fmt.Errorf("feedback deadline %s must be positive", part)
+		}
+		if stage = strings.TrimSpace(stage); stage == "" {
+			policy.Default = d
+		} else {
+			policy.ByStage[stage] = d
+		}
+	}
+	return policy, nil
+}
+
+// Deadline returns the time by which feedback on an interview is due
+// @param interview *domain.Interview - The interview the feedback is about
+// @return time.Time - The deadline, in business hours after the interview ends
+func (p Policy) Deadline(interview *domain.Interview) time.Time {
+	allowed, ok := p.ByStage[interview.Stage]
+	if !ok {
+		allowed = p.Default
+	}
+	return p.Hours.Add(interview.InterviewDate.Add(domain.DefaultInterviewDuration), allowed)
+}
+
+// EscalationDeadline returns the time after which missing feedback is escalated to the hiring manager
+// @param deadline time.Time - The feedback deadline
+// @return time.Time - The escalation time, in business hours after the deadline
+func (p Policy) EscalationDeadline(deadline time.Time) time.Time {
+	return p.Hours.Add(deadline, p.Escalation)
+}
+
+// Add adds business time to a time, counting only the working hours from Monday to Friday
+// Days are taken in the time zone of the working hours, so they follow its daylight saving changes.
+// @param start time.Time - The starting time
+// @param d time.Duration - The business time to add
+// @return time.Time - The resulting time in UTC; a start outside working hours counts from the next opening
+func (h WorkingHours) Add(start time.Time, d time.Duration) time.Time {
+	if h.End <= h.Start {
+		h.Start, h.End = 0, 24*time.Hour
+	}
+	location := h.Location
+	if location == nil {
+		location = time.UTC
+	}
+
+	t := start.In(location)
+	for {
+		year, month, day := t.Date()
+		if weekday := t.Weekday(); weekday != time.Saturday && weekday != time.Sunday {
+			opens := clockOn(year, month, day, h.Start, location)
+			closes := clockOn(year, month, day, h.End, location)
+			if t.Before(opens) {
+				t = opens
+			}
+			if t.Before(closes) {
+				if left := closes.Sub(t); d <= left {
+					return t.Add(d).UTC()
+				}
+				d -= closes.Sub(t)
+			}
+		}
+		t = clockOn(year, month, day+1, 0, location)
+	}
+}
+
+// clockOn returns the time of day on a date, e.g. 09:00 for 9h; time.Date normalizes 24h to the next midnight
+func clockOn(year int, month time.Month, day int, clock time.Duration, location *time.Location) time.Time {
+	return time.Date(year, month, day, 0, int(clock/time.Minute), 0, 0, location)
+}
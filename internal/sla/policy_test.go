@@ -0,0 +1,87 @@
+package sla
+
+import (
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkingHours_Add(t *testing.T) {
+	friday := time.Date(2024, time.December, 20, 15, 0, 0, 0, time.UTC)
+	saturday := time.Date(2024, time.December, 21, 10, 0, 0, 0, time.UTC)
+	office := DefaultWorkingHours()
+
+	// Whole weekdays: within the same week
+	assert.Equal(t, time.Date(2024, time.December, 17, 15, 0, 0, 0, time.UTC),
+		WorkingHours{}.Add(time.Date(2024, time.December, 16, 15, 0, 0, 0, time.UTC), 24*time.Hour))
+	// Whole weekdays: Friday afternoon skips the weekend
+	assert.Equal(t, time.Date(2024, time.December, 23, 15, 0, 0, 0, time.UTC), WorkingHours{}.Add(friday, 24*time.Hour))
+	// Whole weekdays: starting on a weekend counts from Monday
+	assert.Equal(t, time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC), WorkingHours{}.Add(saturday, 24*time.Hour))
+
+	// Working hours: the rest of the day carries over to the next morning
+	assert.Equal(t, time.Date(2024, time.December, 17, 11, 0, 0, 0, time.UTC),
+		office.Add(time.Date(2024, time.December, 16, 15, 0, 0, 0, time.UTC), 4*time.Hour))
+	// Working hours: ending exactly at closing time stays on the same day
+	assert.Equal(t, time.Date(2024, time.December, 16, 17, 0, 0, 0, time.UTC),
+		office.Add(time.Date(2024, time.December, 16, 15, 0, 0, 0, time.UTC), 2*time.Hour))
+	// Working hours: Friday afternoon continues on Monday morning
+	assert.Equal(t, time.Date(2024, time.December, 23, 13, 0, 0, 0, time.UTC), office.Add(friday, 6*time.Hour))
+	// Working hours: starting before opening, at night or on a weekend counts from the next opening
+	assert.Equal(t, time.Date(2024, time.December, 16, 10, 0, 0, 0, time.UTC),
+		office.Add(time.Date(2024, time.December, 16, 6, 0, 0, 0, time.UTC), time.Hour))
+	assert.Equal(t, time.Date(2024, time.December, 17, 10, 0, 0, 0, time.UTC),
+		office.Add(time.Date(2024, time.December, 16, 20, 0, 0, 0, time.UTC), time.Hour))
+	assert.Equal(t, time.Date(2024, time.December, 23, 17, 0, 0, 0, time.UTC), office.Add(saturday, 8*time.Hour))
+}
+
+func TestWorkingHours_AddInTimeZone(t *testing.T) {
+	// Setup
+	madrid, err := ParseWorkingHours("09:00-18:00", "Europe/Madrid")
+	assert.NoError(t, err)
+	friday := time.Date(2024, time.December, 20, 16, 0, 0, 0, time.UTC) // 17:00 in Madrid
+	beforeDST := time.Date(2024, time.March, 29, 16, 0, 0, 0, time.UTC) // Friday 17:00 CET, clocks go forward on Sunday
+
+	// Execute
+	weekend := madrid.Add(friday, 2*time.Hour)
+	dst := madrid.Add(beforeDST, 2*time.Hour)
+
+	// Assertions: 1h on Friday in Madrid and 1h from 09:00 on Monday
+	assert.Equal(t, time.Date(2024, time.December, 23, 9, 0, 0, 0, time.UTC), weekend)
+	assert.Equal(t, time.Date(2024, time.April, 1, 8, 0, 0, 0, time.UTC), dst) // 10:00 CEST
+}
+
+func TestParseWorkingHours(t *testing.T) {
+	// Execute
+	hours, err := ParseWorkingHours("08:30-24:00", "UTC")
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, WorkingHours{Start: 8*time.Hour + 30*time.Minute, End: 24 * time.Hour, Location: time.UTC}, hours)
+	for _, invalid := range [][2]string{{"9-17", "UTC"}, {"17:00-09:00", "UTC"}, {"09:00", "UTC"}, {"09:00-17:00", "Mars/Olympus"}} {
+		_, err := ParseWorkingHours(invalid[0], invalid[1])
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestPolicy_Deadline(t *testing.T) {
+	// Setup
+	policy, err := ParsePolicy("8h, onsite=16h", 8*time.Hour)
+	assert.NoError(t, err)
+	interview := &domain.Interview{InterviewDate: time.Date(2024, time.December, 16, 14, 0, 0, 0, time.UTC)} // Monday
+
+	// Execute
+	screening := policy.Deadline(interview)
+	interview.Stage = "onsite"
+	onsite := policy.Deadline(interview)
+
+	// Assertions: deadlines count from the end of the interview, in working days of 09:00 to 17:00
+	assert.Equal(t, time.Date(2024, time.December, 17, 15, 0, 0, 0, time.UTC), screening)
+	assert.Equal(t, time.Date(2024, time.December, 18, 15, 0, 0, 0, time.UTC), onsite)
+	assert.Equal(t, time.Date(2024, time.December, 19, 15, 0, 0, 0, time.UTC), policy.EscalationDeadline(onsite))
+
+	_, err = ParsePolicy("onsite=soon", time.Hour)
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Exporters accepted in OTEL_TRACES_EXPORTER
+const (
+	ExporterNone   = "none"   // Spans are not recorded; traceparent headers are still passed on
+	ExporterOTLP   = "otlp"   // Spans are sent to an OpenTelemetry collector over OTLP/HTTP
+	ExporterStdout = "stdout" // Spans are written as indented JSON, for local debugging
+)
+
+// ServiceName is reported as service.name unless OTEL_SERVICE_NAME overrides it
+const ServiceName = "interviews-service"
+
+// Config holds the tracing settings
+type Config struct {
+	Exporter    string  // ExporterNone, ExporterOTLP or ExporterStdout
+	Endpoint    string  // Base URL of the OTLP/HTTP collector, empty for the exporter's default
+	SampleRatio float64 // Fraction of new traces recorded; traces started by a caller follow its decision
+}
+
+// Setup installs the global tracer provider and the W3C trace context propagator
+// Incoming traceparent headers are honoured and passed on to outgoing calls even with ExporterNone, so traces
+// stay connected across the services that do record them.
+// @param ctx context.Context - Cancels the creation of the exporter
+// @param cfg Config - The tracing settings
+// @param stdout io.Writer - Where ExporterStdout writes spans
+// @return func(ctx context.Context) error - Flushes the spans still buffered and stops the exporter, to be called on shutdown
+// @return error - An error if the exporter is unknown or could not be created
+func Setup(ctx context.Context, cfg Config, stdout io.Writer) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		var opts []otlptracehttp.Option
+		if cfg.Endpoint != "" {
+			// Like OTEL_EXPORTER_OTLP_ENDPOINT, the endpoint is the base URL the signal path is appended to
+			opts = append(opts, otlptracehttp.WithEndpointURL(strings.TrimSuffix(cfg.Endpoint, "/")+"/v1/traces"))
+		}
+		exporter, err = otlptracehttp.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown traces exporter %q", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the %s traces exporter: %w", cfg.Exporter, err)
+	}
+
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES are detected last, so they win over the defaults
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", ServiceName)),
+		resource.WithTelemetrySDK(),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the service to the traces exporter: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
@@ -0,0 +1,56 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestSetup_Stdout(t *testing.T) {
+	// Setup
+	var out bytes.Buffer
+	ctx := context.Background()
+	shutdown, err := Setup(ctx, Config{Exporter: ExporterStdout, SampleRatio: 1}, &out)
+	require.NoError(t, err)
+
+	// Execute
+	_, span := otel.Tracer("test").Start(ctx, "InterviewService.GetInterviewByID")
+	span.End()
+	require.NoError(t, shutdown(ctx))
+
+	// Assertions
+	assert.Contains(t, out.String(), `"Name": "InterviewService.GetInterviewByID"`)
+	assert.Contains(t, out.String(), `"Value": "interviews-service"`)
+}
+
+func TestSetup_PropagatesTraceparent(t *testing.T) {
+	// Setup
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	_, err := Setup(context.Background(), Config{Exporter: ExporterNone}, nil)
+	require.NoError(t, err)
+	incoming := http.Header{"Traceparent": []string{traceparent}}
+	outgoing := http.Header{}
+
+	// Execute
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(incoming))
+	ctx, span := otel.Tracer("test").Start(ctx, "GET /v1/interviews")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(outgoing))
+	span.End()
+
+	// Assertions
+	assert.Equal(t, traceparent, outgoing.Get("Traceparent")) // Passed on unchanged while not recording
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	// Execute
+	_, err := Setup(context.Background(), Config{Exporter: "jaeger"}, nil)
+
+	// Assertions
+	assert.EqualError(t, err, `unknown traces exporter "jaeger"`)
+}
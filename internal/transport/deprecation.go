@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/pkg/problem"
+)
+
+// DeprecationPolicy describes how long deprecated routes keep being served
+type DeprecationPolicy struct {
+	DeprecatedAt time.Time // Time the routes were deprecated, sent in the Deprecation header
+	Sunset       time.Time // Time after which the routes answer 410 Gone, sent in the Sunset header
+	Successor    string    // Path prefix of the routes replacing them, e.g. /v1
+}
+
+// Deprecation holds the policy of deprecated routes so it can be replaced while they are served
+type Deprecation struct {
+	mu     sync.RWMutex      // Guards policy against SetPolicy during requests
+	policy DeprecationPolicy // Policy applied to requests
+}
+
+// NewDeprecation creates a new Deprecation instance starting with the given policy
+// @return *Deprecation - The holder, whose Middleware guards the deprecated routes
+func NewDeprecation(policy DeprecationPolicy) *Deprecation {
+	return &Deprecation{policy: policy}
+}
+
+// Policy returns the policy applied to requests
+func (d *Deprecation) Policy() DeprecationPolicy {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.policy
+}
+
+// SetPolicy replaces the policy, e.g. when the configuration is reloaded; requests in flight keep the previous one
+func (d *Deprecation) SetPolicy(policy DeprecationPolicy) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.policy = policy
+}
+
+// Middleware marks the routes it guards as deprecated
+// Responses carry the Deprecation (RFC 9745) and Sunset (RFC 8594) headers and a Link to the
+// successor route. Once the sunset has passed, requests are answered with 410 Gone.
+// @return gin.HandlerFunc - The middleware, to be registered before authentication
+func (d *Deprecation) Middleware(now func() time.Time) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		policy := d.Policy()
+		successor := policy.Successor + c.Request.URL.Path
+		c.Header("Deprecation", fmt.Sprintf("@%d", policy.DeprecatedAt.Unix()))
+		c.Header("Sunset", policy.Sunset.UTC().Format(http.TimeFormat))
+		c.Header("Link", fmt.Sprintf(`<%s>; rel="successor-version"`, successor))
+
+		if !now().Before(policy.Sunset) {
+			problem.Abort(c, problem.New(problem.TypeGone, http.StatusGone, "this route was removed, use "+successor))
+			return
+		}
+		c.Next()
+	}
+}
+
+// Deprecated marks the routes it guards as deprecated under a policy that never changes
+// @return gin.HandlerFunc - The middleware, to be registered before authentication
+func Deprecated(policy DeprecationPolicy, now func() time.Time) gin.HandlerFunc {
+	return NewDeprecation(policy).Middleware(now)
+}
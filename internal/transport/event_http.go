@@ -0,0 +1,64 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/consumer"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/pkg/problem"
+)
+
+// EventHandler handles events pushed by other services over HTTP
+type EventHandler struct {
+	consumer consumer.Consumer
+}
+
+// NewEventHandler creates a new EventHandler instance
+// @Summary Initialize the event handler
+// @Description Creates an instance of EventHandler to receive events from other services
+// @Tags Initialization
+// @Produce json
+func NewEventHandler(consumer consumer.Consumer) *EventHandler {
+	return &EventHandler{consumer: consumer}
+}
+
+// ReceiveEvent handles an event published by the candidates or jobs service
+// @Summary Receive an event from another service
+// @Description Accepts candidate.withdrawn (data.candidate_id) and job.closed (data.job_id) events and cancels the
+// @Description affected future interviews, notifying their participants. Delivering the same event twice is harmless.
+// @Description Only tokens whose role claim is service or admin are accepted.
+// @Tags Events
+// @Accept json
+// @Produce json
+// @Param request body domain.InboundEvent true "Event"
+// @Success 202 {object} map[string]interface{} "Event processed"
+// @Failure 400 {object} problem.Problem "Bad request"
+// @Failure 403 {object} problem.Problem "The token has neither the service nor the admin role"
+// @Failure 422 {object} problem.Problem "Unsupported event type"
+// @Failure 500 {object} problem.Problem "Failed to process event"
+// @Router /v1/events [post]
+func (h *EventHandler) ReceiveEvent(c *gin.Context) {
+	var event domain.InboundEvent
+	if !bindJSON(c, &event) {
+		return
+	}
+
+	cancelled, err := h.consumer.Handle(c.Request.Context(), event)
+	if err != nil {
+		switch {
+		case errors.Is(err, consumer.ErrInvalidEvent):
+			respondInvalidRequest(c, "", err.Error())
+		case errors.Is(err, consumer.ErrUnsupportedEvent):
+			p := problem.New(problem.TypeValidation, http.StatusUnprocessableEntity, err.Error())
+			p.Errors = []problem.FieldError{{Field: "type", Message: "unsupported event type"}}
+			problem.Abort(c, p)
+		default:
+			respondError(c, err, "failed to process event")
+		}
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "event processed", "cancelled_interviews": cancelled})
+}
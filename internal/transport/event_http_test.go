@@ -0,0 +1,116 @@
+package transport
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/poolcamacho/interviews-service/internal/consumer"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiveEvent(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "processed", expected: http.StatusAccepted},
+		{name: "invalid", err: fmt.Errorf("%w: missing job_id", consumer.ErrInvalidEvent), expected: http.StatusBadRequest},
+		{name: "unsupported", err: consumer.ErrUnsupportedEvent, expected: http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockConsumer := new(consumer.MockConsumer)
+			eventHandler := NewEventHandler(mockConsumer)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.Default()
+			router.POST("/events", eventHandler.ReceiveEvent)
+
+			// Mock behavior
+			mockConsumer.On("Handle", mock.Anything, mock.MatchedBy(func(e domain.InboundEvent) bool {
+				return e.Type == consumer.EventJobClosed
+			})).Return(2, tt.err)
+
+			// Prepare HTTP request
+			body := `{"id":"evt-1","type":"job.closed","data":{"job_id":201}}`
+			req := httptest.NewRequest(http.MethodPost, "/events", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			// Execute
+			router.ServeHTTP(rec, req)
+
+			// Assertions
+			assert.Equal(t, tt.expected, rec.Code)
+			if tt.err == nil {
+				assert.JSONEq(t, `{"message":"event processed","cancelled_interviews":2}`, rec.Body.String())
+			}
+			mockConsumer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReceiveEvent_RequiresServiceRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		claims   jwt.MapClaims
+		expected int
+	}{
+		{name: "service", claims: jwt.MapClaims{"sub": "jobs-service", "role": "service"}, expected: http.StatusAccepted},
+		{name: "admin", claims: jwt.MapClaims{"sub": "ops", "role": "admin"}, expected: http.StatusAccepted},
+		{name: "recruiter", claims: jwt.MapClaims{"sub": "recruiter", "role": "recruiter"}, expected: http.StatusForbidden},
+		{name: "no role", claims: jwt.MapClaims{"sub": "recruiter"}, expected: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			const secret = "events-test-secret"
+			mockConsumer := new(consumer.MockConsumer)
+			handlers := Handlers{
+				Interviews:   NewInterviewHandler(new(service.MockInterviewService)),
+				InterviewsV2: NewInterviewHandlerV2(new(service.MockInterviewService)),
+				Events:       NewEventHandler(mockConsumer),
+			}
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			RegisterV1(router.Group("/v1", jwtUtil.AuthMiddleware(secret)), handlers)
+			token, err := jwtUtil.GenerateToken(secret, tt.claims)
+			require.NoError(t, err)
+
+			// Mock behavior
+			mockConsumer.On("Handle", mock.Anything, mock.Anything).Return(1, nil)
+
+			// Prepare HTTP request
+			body := `{"id":"evt-1","type":"job.closed","data":{"job_id":201}}`
+			req := httptest.NewRequest(http.MethodPost, "/v1/events", bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+token)
+			rec := httptest.NewRecorder()
+
+			// Execute
+			router.ServeHTTP(rec, req)
+
+			// Assertions
+			assert.Equal(t, tt.expected, rec.Code)
+			if tt.expected == http.StatusForbidden {
+				assert.Contains(t, rec.Body.String(), "/problems/forbidden")
+				mockConsumer.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
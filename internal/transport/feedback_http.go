@@ -0,0 +1,137 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+)
+
+// defaultReportPeriod is the period covered by the SLA report when no start is given
+const defaultReportPeriod = 30 * 24 * time.Hour
+
+// FeedbackHandler handles HTTP requests for interviewer feedback and its SLA
+type FeedbackHandler struct {
+	service service.FeedbackService
+}
+
+// NewFeedbackHandler creates a new FeedbackHandler instance
+// @Summary Initialize the feedback handler
+// @Description Creates an instance of FeedbackHandler to manage feedback endpoints
+// @Tags Initialization
+// @Produce json
+func NewFeedbackHandler(service service.FeedbackService) *FeedbackHandler {
+	return &FeedbackHandler{service: service}
+}
+
+// SubmitFeedback handles the submission of a panelist's feedback
+// @Summary Submit interviewer feedback
+// @Description Store the feedback of a panelist. The time of the first submission is used for the SLA.
+// @Tags Feedback
+// @Accept json
+// @Produce json
+// @Param id path int true "Interview ID"
+// @Param panelist_id path int true "Panelist ID"
+// @Param request body domain.FeedbackSubmission true "Feedback"
+// @Success 200 {object} map[string]string "Feedback submitted"
+// @Failure 400 {object} problem.Problem "Invalid ID or malformed request body"
+// @Failure 404 {object} problem.Problem "Panelist not found"
+// @Failure 422 {object} problem.Problem "Empty feedback"
+// @Failure 500 {object} problem.Problem "Failed to submit feedback"
+// @Router /v1/interviews/{id}/panel/{panelist_id}/feedback [post]
+func (h *FeedbackHandler) SubmitFeedback(c *gin.Context) {
+	interviewID, ok := pathID(c)
+	if !ok {
+		return
+	}
+	panelistID, ok := pathInt(c, "panelist_id")
+	if !ok {
+		return
+	}
+
+	var submission domain.FeedbackSubmission
+	if !bindJSON(c, &submission) {
+		return
+	}
+
+	if err := h.service.SubmitFeedback(interviewID, panelistID, submission.Feedback); err != nil {
+		respondError(c, err, "failed to submit feedback")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "feedback submitted successfully"})
+}
+
+// ListOverdueFeedback handles the retrieval of feedback missing past its deadline
+// @Summary List overdue feedback
+// @Description Retrieve the feedback missing past its SLA deadline, grouped by interviewer
+// @Tags Feedback
+// @Produce json
+// @Success 200 {array} domain.FeedbackAssignment "Overdue feedback"
+// @Failure 500 {object} problem.Problem "Failed to fetch overdue feedback"
+// @Router /v1/feedback/overdue [get]
+func (h *FeedbackHandler) ListOverdueFeedback(c *gin.Context) {
+	overdue, err := h.service.OverdueFeedback(time.Now().UTC())
+	if err != nil {
+		respondError(c, err, "failed to fetch overdue feedback")
+		return
+	}
+	c.JSON(http.StatusOK, overdue)
+}
+
+// GetSLAReport handles the feedback SLA compliance report
+// @Summary Feedback SLA compliance report
+// @Description Summarise on-time, late, overdue and pending feedback per interviewer and per job for interviews
+// @Description held in [from, to). Dates are RFC 3339 or YYYY-MM-DD; the default period is the last 30 days.
+// @Tags Feedback
+// @Produce json
+// @Param from query string false "Start of the period"
+// @Param to query string false "End of the period"
+// @Success 200 {object} domain.SLAReport "SLA report"
+// @Failure 400 {object} problem.Problem "Invalid period"
+// @Failure 500 {object} problem.Problem "Failed to build report"
+// @Router /v1/reports/feedback-sla [get]
+func (h *FeedbackHandler) GetSLAReport(c *gin.Context) {
+	now := time.Now().UTC()
+	to, ok := queryTime(c, "to", now)
+	if !ok {
+		return
+	}
+	from, ok := queryTime(c, "from", to.Add(-defaultReportPeriod))
+	if !ok {
+		return
+	}
+	if !from.Before(to) {
+		respondInvalidRequest(c, "from", "must be before to")
+		return
+	}
+
+	report, err := h.service.Report(from, to, now)
+	if err != nil {
+		respondError(c, err, "failed to build report")
+		return
+	}
+	c.JSON(http.StatusOK, report)
+}
+
+// queryTime parses the named RFC 3339 or YYYY-MM-DD query parameter, returning fallback when it is absent
+// A 400 response is written when the parameter is malformed.
+// @return time.Time - The parsed time in UTC
+// @return bool - False if the response has already been written
+func queryTime(c *gin.Context, name string, fallback time.Time) (time.Time, bool) {
+	value := c.Query(name)
+	if value == "" {
+		return fallback, true
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), true
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		respondInvalidRequest(c, name, "expected RFC 3339 or YYYY-MM-DD")
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSubmitFeedback(t *testing.T) {
+	// Setup
+	mockFeedbackService := new(service.MockFeedbackService)
+	feedbackHandler := NewFeedbackHandler(mockFeedbackService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/interviews/:id/panel/:panelist_id/feedback", feedbackHandler.SubmitFeedback)
+
+	// Mock behavior
+	mockFeedbackService.On("SubmitFeedback", 7, 1, "Strong candidate").Return(nil)
+	mockFeedbackService.On("SubmitFeedback", 7, 9, "Strong candidate").Return(&service.Error{Kind: service.KindNotFound, Message: "panelist 9 of interview 7 not found"})
+
+	// Execute and assert
+	for path, code := range map[string]int{
+		"/interviews/7/panel/1/feedback":   http.StatusOK,
+		"/interviews/7/panel/9/feedback":   http.StatusNotFound,
+		"/interviews/7/panel/abc/feedback": http.StatusBadRequest,
+	} {
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(`{"feedback":"Strong candidate"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		assert.Equal(t, code, rec.Code, path)
+	}
+	mockFeedbackService.AssertExpectations(t)
+}
+
+func TestGetSLAReport(t *testing.T) {
+	// Setup
+	mockFeedbackService := new(service.MockFeedbackService)
+	feedbackHandler := NewFeedbackHandler(mockFeedbackService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.GET("/reports/feedback-sla", feedbackHandler.GetSLAReport)
+
+	// Mock data
+	from := time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+	report := &domain.SLAReport{From: from, To: to, ByInterviewer: []domain.SLAEntry{{Key: "alice@example.com", OnTime: 3, ComplianceRate: 1}}}
+
+	// Mock behavior
+	mockFeedbackService.On("Report", from, to, mock.AnythingOfType("time.Time")).Return(report, nil)
+
+	// Execute
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/reports/feedback-sla?from=2024-12-01&to=2024-12-31", nil))
+	badRec := httptest.NewRecorder()
+	router.ServeHTTP(badRec, httptest.NewRequest(http.MethodGet, "/reports/feedback-sla?from=yesterday", nil))
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"key":"alice@example.com"`)
+	assert.Equal(t, http.StatusBadRequest, badRec.Code)
+	mockFeedbackService.AssertExpectations(t)
+}
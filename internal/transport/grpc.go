@@ -0,0 +1,254 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/poolcamacho/interviews-service/internal/client"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+	pb "github.com/poolcamacho/interviews-service/pkg/pb/interviewv1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// watchBuffer is the number of events buffered for each WatchInterviews stream
+const watchBuffer = 64
+
+// InterviewServer serves the interview API over gRPC
+// It shares the service layer with the HTTP handlers.
+type InterviewServer struct {
+	pb.UnimplementedInterviewServiceServer
+
+	service service.InterviewService // Business logic shared with the HTTP API
+	events  *event.Broadcaster       // Source of the events streamed by WatchInterviews
+}
+
+// NewInterviewServer creates a new InterviewServer instance
+// @return *InterviewServer - A server streaming the events published to events
+func NewInterviewServer(service service.InterviewService, events *event.Broadcaster) *InterviewServer {
+	return &InterviewServer{service: service, events: events}
+}
+
+// NewGRPCServer creates a gRPC server exposing the interview API
+// Every call must carry a JWT signed with secretKey in the "authorization: Bearer <token>" metadata. Unary calls are
+// tagged with the ID in the x-request-id metadata, or a new one, in the logger of their context.
+// @return *grpc.Server - The server, ready to be started with Serve
+func NewGRPCServer(server *InterviewServer, secretKey string) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			if err := authenticate(ctx, secretKey); err != nil {
+				return nil, err
+			}
+			return handler(grpcRequestContext(ctx), req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := authenticate(ss.Context(), secretKey); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	pb.RegisterInterviewServiceServer(s, server)
+	return s
+}
+
+// authenticate validates the bearer token found in the incoming metadata
+// @return error - An Unauthenticated status if the token is missing or invalid
+func authenticate(ctx context.Context, secretKey string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return status.Error(codes.Unauthenticated, "authorization metadata is missing")
+	}
+
+	parts := strings.Split(values[0], " ")
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return status.Error(codes.Unauthenticated, "invalid authorization metadata format")
+	}
+	if _, err := jwtUtil.ValidateToken(secretKey, parts[1]); err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	return nil
+}
+
+// ListInterviews returns every interview
+func (s *InterviewServer) ListInterviews(ctx context.Context, _ *pb.ListInterviewsRequest) (*pb.ListInterviewsResponse, error) {
+	interviews, err := s.service.GetAllInterviews(ctx)
+	if err != nil {
+		return nil, grpcError(ctx, err, "failed to fetch interviews")
+	}
+
+	resp := &pb.ListInterviewsResponse{Interviews: make([]*pb.Interview, 0, len(interviews))}
+	for _, interview := range interviews {
+		resp.Interviews = append(resp.Interviews, toProtoInterview(interview))
+	}
+	return resp, nil
+}
+
+// GetInterview returns a single interview with its panel
+func (s *InterviewServer) GetInterview(ctx context.Context, req *pb.GetInterviewRequest) (*pb.Interview, error) {
+	interview, err := s.service.GetInterviewByID(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, grpcError(ctx, err, "failed to fetch interview")
+	}
+	return toProtoInterview(interview), nil
+}
+
+// CreateInterview schedules a new interview
+func (s *InterviewServer) CreateInterview(ctx context.Context, req *pb.CreateInterviewRequest) (*pb.Interview, error) {
+	if req.GetCandidateId() == 0 || req.GetJobId() == 0 || req.GetInterviewDate() == nil {
+		return nil, status.Error(codes.InvalidArgument, "candidate_id, job_id, and interview_date are required")
+	}
+
+	interview := &domain.Interview{
+		CandidateID:    int(req.GetCandidateId()),
+		JobID:          int(req.GetJobId()),
+		InterviewDate:  req.GetInterviewDate().AsTime(),
+		Feedback:       req.GetFeedback(),
+		Stage:          req.GetStage(),
+		CandidateEmail: req.GetCandidateEmail(),
+	}
+	for _, panelist := range req.GetPanel() {
+		interview.Panel = append(interview.Panel, domain.Panelist{Name: panelist.GetName(), Email: panelist.GetEmail()})
+	}
+
+	if err := s.service.AddInterview(ctx, interview); err != nil {
+		return nil, grpcError(ctx, err, "failed to create interview")
+	}
+	return toProtoInterview(interview), nil
+}
+
+// UpdateInterview changes the fields set in the request
+func (s *InterviewServer) UpdateInterview(ctx context.Context, req *pb.UpdateInterviewRequest) (*pb.Interview, error) {
+	interview, err := s.service.GetInterviewByID(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, grpcError(ctx, err, "failed to fetch interview")
+	}
+
+	if req.InterviewDate != nil {
+		interview.InterviewDate = req.GetInterviewDate().AsTime()
+	}
+	if req.Feedback != nil {
+		interview.Feedback = req.GetFeedback()
+	}
+	if req.Stage != nil {
+		interview.Stage = req.GetStage()
+	}
+	if req.CandidateEmail != nil {
+		interview.CandidateEmail = req.GetCandidateEmail()
+	}
+
+	if err := s.service.UpdateInterview(ctx, interview); err != nil {
+		return nil, grpcError(ctx, err, "failed to update interview")
+	}
+	return toProtoInterview(interview), nil
+}
+
+// CancelInterview cancels a scheduled interview
+func (s *InterviewServer) CancelInterview(ctx context.Context, req *pb.CancelInterviewRequest) (*pb.Interview, error) {
+	interview, err := s.service.CancelInterview(ctx, int(req.GetId()), req.GetReason())
+	if err != nil {
+		return nil, grpcError(ctx, err, "failed to cancel interview")
+	}
+	return toProtoInterview(interview), nil
+}
+
+// WatchInterviews streams the interview events matching the request until the client goes away
+// Events are delivered at least once; a client that falls behind misses events rather than
+// slowing down the others. Streams end with Unavailable when the server shuts down, so clients
+// reconnect to another replica.
+func (s *InterviewServer) WatchInterviews(req *pb.WatchInterviewsRequest, stream pb.InterviewService_WatchInterviewsServer) error {
+	events, unsubscribe := s.events.Subscribe(watchBuffer)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "server is shutting down")
+			}
+			if req.GetCandidateId() != 0 && int64(e.Interview.CandidateID) != req.GetCandidateId() {
+				continue
+			}
+			if req.GetJobId() != 0 && int64(e.Interview.JobID) != req.GetJobId() {
+				continue
+			}
+			if err := stream.Send(toProtoEvent(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// grpcError maps service and repository errors to gRPC status codes
+// Unexpected errors are logged and reported to the client as message.
+// @return error - The status error returned to the client
+func grpcError(ctx context.Context, err error, message string) error {
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Error(codes.NotFound, "interview not found")
+	case errors.Is(err, repository.ErrNotScheduled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrInvalidReference):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, client.ErrUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	logger.FromContext(ctx).Error("gRPC call failed", "message", message, "error", err)
+	return status.Error(codes.Internal, message)
+}
+
+// toProtoInterview converts an interview to its protobuf message
+func toProtoInterview(interview *domain.Interview) *pb.Interview {
+	msg := &pb.Interview{
+		Id:                 int64(interview.ID),
+		CandidateId:        int64(interview.CandidateID),
+		JobId:              int64(interview.JobID),
+		InterviewDate:      timestamppb.New(interview.InterviewDate),
+		Feedback:           interview.Feedback,
+		Stage:              interview.Stage,
+		CandidateEmail:     interview.CandidateEmail,
+		Status:             string(interview.Status),
+		CancellationReason: interview.CancellationReason,
+	}
+	for _, panelist := range interview.Panel {
+		p := &pb.Panelist{
+			Id:          int64(panelist.ID),
+			InterviewId: int64(panelist.InterviewID),
+			Name:        panelist.Name,
+			Email:       panelist.Email,
+			Feedback:    panelist.Feedback,
+		}
+		if panelist.FeedbackSubmittedAt != nil {
+			p.FeedbackSubmittedAt = timestamppb.New(*panelist.FeedbackSubmittedAt)
+		}
+		msg.Panel = append(msg.Panel, p)
+	}
+	return msg
+}
+
+// toProtoEvent converts an interview event to its protobuf message
+func toProtoEvent(e domain.Event) *pb.InterviewEvent {
+	msg := &pb.InterviewEvent{
+		Id:         e.ID,
+		Type:       string(e.Type),
+		Interview:  toProtoInterview(&e.Interview),
+		Reason:     e.Reason,
+		OccurredAt: timestamppb.New(e.OccurredAt),
+	}
+	if e.PreviousDate != nil {
+		msg.PreviousDate = timestamppb.New(*e.PreviousDate)
+	}
+	return msg
+}
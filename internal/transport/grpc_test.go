@@ -0,0 +1,237 @@
+package transport
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/event"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+	pb "github.com/poolcamacho/interviews-service/pkg/pb/interviewv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const grpcTestSecret = "test-secret"
+
+func TestGRPC_RequiresToken(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	grpcClient := newGRPCTestClient(t, mockService, event.NewBroadcaster())
+
+	// Execute
+	_, missingErr := grpcClient.ListInterviews(context.Background(), &pb.ListInterviewsRequest{})
+	badCtx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer not-a-token")
+	_, invalidErr := grpcClient.ListInterviews(badCtx, &pb.ListInterviewsRequest{})
+
+	// Assertions
+	assert.Equal(t, codes.Unauthenticated, status.Code(missingErr))
+	assert.Equal(t, codes.Unauthenticated, status.Code(invalidErr))
+	mockService.AssertNotCalled(t, "GetAllInterviews", mock.Anything)
+}
+
+func TestGRPC_ListInterviews(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	grpcClient := newGRPCTestClient(t, mockService, event.NewBroadcaster())
+
+	// Mock data
+	date := time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC)
+	interviews := []*domain.Interview{
+		{ID: 1, CandidateID: 101, JobID: 201, InterviewDate: date, Status: domain.StatusScheduled,
+			Panel: []domain.Panelist{{ID: 1, InterviewID: 1, Name: "Alice", Email: "alice@example.com"}}},
+	}
+
+	// Mock behavior
+	mockService.On("GetAllInterviews", mock.Anything).Return(interviews, nil)
+
+	// Execute
+	resp, err := grpcClient.ListInterviews(authContext(t), &pb.ListInterviewsRequest{})
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, resp.Interviews, 1)
+	assert.Equal(t, int64(101), resp.Interviews[0].CandidateId)
+	assert.Equal(t, date, resp.Interviews[0].InterviewDate.AsTime())
+	assert.Equal(t, "scheduled", resp.Interviews[0].Status)
+	assert.Equal(t, "alice@example.com", resp.Interviews[0].Panel[0].Email)
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPC_CreateInterview(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	grpcClient := newGRPCTestClient(t, mockService, event.NewBroadcaster())
+
+	// Mock data
+	date := time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC)
+	req := &pb.CreateInterviewRequest{CandidateId: 101, JobId: 201, InterviewDate: timestamppb.New(date),
+		Panel: []*pb.Panelist{{Name: "Alice", Email: "alice@example.com"}}}
+
+	// Mock behavior
+	mockService.On("AddInterview", mock.Anything, mock.MatchedBy(func(i *domain.Interview) bool {
+		return i.CandidateID == 101 && i.JobID == 201 && i.InterviewDate.Equal(date) && len(i.Panel) == 1
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*domain.Interview).ID = 7
+	}).Return(nil).Once()
+	mockService.On("AddInterview", mock.Anything, mock.Anything).Return(service.ErrInvalidReference).Once()
+
+	// Execute
+	created, err := grpcClient.CreateInterview(authContext(t), req)
+	_, invalidErr := grpcClient.CreateInterview(authContext(t), req)
+	_, missingErr := grpcClient.CreateInterview(authContext(t), &pb.CreateInterviewRequest{CandidateId: 101})
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), created.Id)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(invalidErr))
+	assert.Equal(t, codes.InvalidArgument, status.Code(missingErr))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPC_UpdateInterview_OnlyChangesSetFields(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	grpcClient := newGRPCTestClient(t, mockService, event.NewBroadcaster())
+
+	// Mock data
+	date := time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC)
+	current := &domain.Interview{ID: 7, CandidateID: 101, JobID: 201, InterviewDate: date, Feedback: "Good", Stage: "onsite"}
+
+	// Mock behavior
+	mockService.On("GetInterviewByID", mock.Anything, 7).Return(current, nil)
+	mockService.On("UpdateInterview", mock.Anything, mock.MatchedBy(func(i *domain.Interview) bool {
+		return i.ID == 7 && i.InterviewDate.Equal(date) && i.Feedback == "" && i.Stage == "onsite"
+	})).Return(nil)
+	mockService.On("GetInterviewByID", mock.Anything, 8).Return(nil, repository.ErrNotFound)
+
+	// Execute
+	updated, err := grpcClient.UpdateInterview(authContext(t), &pb.UpdateInterviewRequest{Id: 7, Feedback: proto.String("")})
+	_, notFoundErr := grpcClient.UpdateInterview(authContext(t), &pb.UpdateInterviewRequest{Id: 8})
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, "onsite", updated.Stage)
+	assert.Empty(t, updated.Feedback)
+	assert.Equal(t, codes.NotFound, status.Code(notFoundErr))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPC_CancelInterview(t *testing.T) {
+	// Setup
+	mockService := new(service.MockInterviewService)
+	grpcClient := newGRPCTestClient(t, mockService, event.NewBroadcaster())
+
+	// Mock behavior
+	mockService.On("CancelInterview", mock.Anything, 7, "Position filled").
+		Return(&domain.Interview{ID: 7, Status: domain.StatusCancelled, CancellationReason: "Position filled"}, nil)
+	mockService.On("CancelInterview", mock.Anything, 8, "Position filled").Return(nil, repository.ErrNotScheduled)
+
+	// Execute
+	cancelled, err := grpcClient.CancelInterview(authContext(t), &pb.CancelInterviewRequest{Id: 7, Reason: "Position filled"})
+	_, notScheduledErr := grpcClient.CancelInterview(authContext(t), &pb.CancelInterviewRequest{Id: 8, Reason: "Position filled"})
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, "cancelled", cancelled.Status)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(notScheduledErr))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPC_WatchInterviews_FiltersEvents(t *testing.T) {
+	// Setup
+	broadcaster := event.NewBroadcaster()
+	grpcClient := newGRPCTestClient(t, new(service.MockInterviewService), broadcaster)
+	ctx, cancel := context.WithCancel(authContext(t))
+	defer cancel()
+
+	// Execute
+	stream, err := grpcClient.WatchInterviews(ctx, &pb.WatchInterviewsRequest{CandidateId: 101})
+	require.NoError(t, err)
+	received, err := recvWithin(t, stream, broadcaster)
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, "match", received.Id)
+	assert.Equal(t, "interview.cancelled", received.Type)
+	assert.Equal(t, int64(2), received.Interview.Id)
+	assert.Equal(t, "Withdrew", received.Reason)
+}
+
+func TestGRPC_WatchInterviews_EndsOnShutdown(t *testing.T) {
+	// Setup
+	broadcaster := event.NewBroadcaster()
+	grpcClient := newGRPCTestClient(t, new(service.MockInterviewService), broadcaster)
+	ctx, cancel := context.WithCancel(authContext(t))
+	defer cancel()
+	stream, err := grpcClient.WatchInterviews(ctx, &pb.WatchInterviewsRequest{CandidateId: 101})
+	require.NoError(t, err)
+	_, err = recvWithin(t, stream, broadcaster) // The stream is subscribed once it received an event
+	require.NoError(t, err)
+
+	// Execute
+	broadcaster.Close()
+	_, err = stream.Recv()
+
+	// Assertions
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+// recvWithin keeps publishing an unmatched and a matched event until the stream, which subscribes asynchronously, receives one
+func recvWithin(t *testing.T, stream pb.InterviewService_WatchInterviewsClient, broadcaster *event.Broadcaster) (*pb.InterviewEvent, error) {
+	t.Helper()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(10 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = broadcaster.Publish(domain.Event{ID: "other", Type: domain.EventInterviewCreated, Interview: domain.Interview{ID: 1, CandidateID: 102}})
+				_ = broadcaster.Publish(domain.Event{ID: "match", Type: domain.EventInterviewCancelled, Interview: domain.Interview{ID: 2, CandidateID: 101}, Reason: "Withdrew"})
+			}
+		}
+	}()
+	return stream.Recv()
+}
+
+// newGRPCTestClient serves the interview API over an in-memory connection
+func newGRPCTestClient(t *testing.T, interviewService service.InterviewService, broadcaster *event.Broadcaster) pb.InterviewServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	server := NewGRPCServer(NewInterviewServer(interviewService, broadcaster), grpcTestSecret)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewInterviewServiceClient(conn)
+}
+
+// authContext provides a context carrying a valid bearer token
+func authContext(t *testing.T) context.Context {
+	t.Helper()
+	token, err := jwtUtil.GenerateToken(grpcTestSecret, jwt.MapClaims{"sub": "recruiter", "exp": time.Now().Add(time.Hour).Unix()})
+	require.NoError(t, err)
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
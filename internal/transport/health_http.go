@@ -0,0 +1,75 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/health"
+)
+
+// HealthHandler handles the liveness and readiness probes
+type HealthHandler struct {
+	registry *health.Registry
+}
+
+// NewHealthHandler creates a new HealthHandler instance
+// @Summary Initialize the health handler
+// @Description Creates an instance of HealthHandler reporting the checks of a registry
+// @Tags Initialization
+// @Produce json
+func NewHealthHandler(registry *health.Registry) *HealthHandler {
+	return &HealthHandler{registry: registry}
+}
+
+// Livez reports that the process is running
+// Dependencies are deliberately not checked: restarting the service would not bring a database back.
+// @Summary Liveness probe
+// @Description Returns 200 while the process can serve requests, whatever the state of its dependencies
+// @Tags Health
+// @Produce json
+// @Success 200 {object} map[string]string "Process is alive"
+// @Router /livez [get]
+func (h *HealthHandler) Livez(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": health.StatusOK})
+}
+
+// Readyz reports whether the service can handle requests
+// @Summary Readiness probe
+// @Description Runs the dependency checks (database ping, applied migrations, downstream services), caching their
+// @Description results for a few seconds. Returns 503 when a required check fails or the service is shutting down;
+// @Description failing downstream services only turn the status to degraded. Add ?verbose to list each check with
+// @Description its status, latency and error.
+// @Tags Health
+// @Produce json
+// @Param verbose query string false "List every check"
+// @Success 200 {object} map[string]interface{} "Ready, status ok or degraded"
+// @Failure 503 {object} map[string]interface{} "Not ready, status fail"
+// @Router /readyz [get]
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	report := h.registry.Check(c.Request.Context())
+	code := http.StatusOK
+	if !report.Ready() {
+		code = http.StatusServiceUnavailable
+	}
+	if _, verbose := c.GetQuery("verbose"); verbose {
+		c.JSON(code, report)
+		return
+	}
+	c.JSON(code, gin.H{"status": report.Status})
+}
+
+// HealthCheck reports readiness in the format of the former health check
+// @Summary Check service health
+// @Description Deprecated alias of /readyz kept for existing monitors: returns healthy, or unhealthy with 503
+// @Tags Health
+// @Produce json
+// @Success 200 {object} map[string]string "Service is healthy"
+// @Failure 503 {object} map[string]string "Service is unhealthy"
+// @Router /health [get]
+func (h *HealthHandler) HealthCheck(c *gin.Context) {
+	if !h.registry.Check(c.Request.Context()).Ready() {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"status": "unhealthy"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "healthy"})
+}
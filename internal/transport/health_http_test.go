@@ -0,0 +1,91 @@
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthProbes(t *testing.T) {
+	passing := func(ctx context.Context) error { return nil }
+	failing := func(ctx context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name     string
+		database health.Check
+		jobs     health.Check
+		path     string
+		expected int
+		body     string
+	}{
+		{name: "live with failing database", database: failing, jobs: passing, path: "/livez", expected: http.StatusOK, body: `{"status":"ok"}`},
+		{name: "ready", database: passing, jobs: passing, path: "/readyz", expected: http.StatusOK, body: `{"status":"ok"}`},
+		{name: "degraded is ready", database: passing, jobs: failing, path: "/readyz", expected: http.StatusOK, body: `{"status":"degraded"}`},
+		{name: "not ready", database: failing, jobs: passing, path: "/readyz", expected: http.StatusServiceUnavailable, body: `{"status":"fail"}`},
+		{name: "healthy", database: passing, jobs: failing, path: "/health", expected: http.StatusOK, body: `{"status":"healthy"}`},
+		{name: "unhealthy", database: failing, jobs: passing, path: "/health", expected: http.StatusServiceUnavailable, body: `{"status":"unhealthy"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			registry := health.NewRegistry(health.DefaultConfig())
+			registry.Register("database", tt.database)
+			registry.RegisterOptional("jobs-service", tt.jobs)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.Default()
+			RegisterHealth(router, NewHealthHandler(registry))
+
+			// Prepare HTTP request
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+
+			// Execute
+			router.ServeHTTP(rec, req)
+
+			// Assertions
+			assert.Equal(t, tt.expected, rec.Code)
+			assert.JSONEq(t, tt.body, rec.Body.String())
+		})
+	}
+}
+
+func TestReadyz_Verbose(t *testing.T) {
+	// Setup
+	registry := health.NewRegistry(health.DefaultConfig())
+	registry.Register("database", func(ctx context.Context) error { return nil })
+	registry.RegisterOptional("jobs-service", func(ctx context.Context) error { return errors.New("connection refused") })
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	RegisterHealth(router, NewHealthHandler(registry))
+
+	// Prepare HTTP request
+	req := httptest.NewRequest(http.MethodGet, "/readyz?verbose", nil)
+	rec := httptest.NewRecorder()
+
+	// Execute
+	router.ServeHTTP(rec, req)
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var report health.Report
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.Equal(t, health.StatusDegraded, report.Status)
+	require.Len(t, report.Checks, 2)
+	assert.Equal(t, "database", report.Checks[0].Name)
+	assert.Equal(t, health.StatusOK, report.Checks[0].Status)
+	assert.Equal(t, "jobs-service", report.Checks[1].Name)
+	assert.Equal(t, health.StatusFail, report.Checks[1].Status)
+	assert.Equal(t, "connection refused", report.Checks[1].Error)
+	assert.Contains(t, rec.Body.String(), `"latency_ms"`)
+}
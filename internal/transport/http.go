@@ -22,29 +22,18 @@ func NewInterviewHandler(service service.InterviewService) *InterviewHandler {
 	return &InterviewHandler{service: service}
 }
 
-// HealthCheck provides a simple health status of the service
-// @Summary Check service health
-// @Description Returns the health status of the interview service
-// @Tags Health
-// @Produce json
-// @Success 200 {object} map[string]string "Service is healthy"
-// @Router /health [get]
-func (h *InterviewHandler) HealthCheck(c *gin.Context) {
-	c.JSON(http.StatusOK, gin.H{"status": "healthy"})
-}
-
 // GetInterviews handles the retrieval of all interviews
 // @Summary Get all interviews
 // @Description Retrieve a list of all interviews in the system
 // @Tags Interviews
 // @Produce json
 // @Success 200 {array} domain.Interview "List of interviews"
-// @Failure 500 {object} map[string]string "Failed to fetch interviews"
-// @Router /interviews [get]
+// @Failure 500 {object} problem.Problem "Failed to fetch interviews"
+// @Router /v1/interviews [get]
 func (h *InterviewHandler) GetInterviews(c *gin.Context) {
-	interviews, err := h.service.GetAllInterviews()
+	interviews, err := h.service.GetAllInterviews(c.Request.Context())
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch interviews"})
+		respondError(c, err, "failed to fetch interviews")
 		return
 	}
 	c.JSON(http.StatusOK, interviews)
@@ -52,33 +41,77 @@ func (h *InterviewHandler) GetInterviews(c *gin.Context) {
 
 // CreateInterview handles the creation of a new interview
 // @Summary Create a new interview
-// @Description Add a new interview by providing candidate_id, job_id, interview_date, and feedback
+// @Description Add a new interview by providing candidate_id, job_id, interview_date, and feedback.
+// @Description Participants listed in candidate_email and panel are notified by email with a calendar invite.
+// @Description The candidate must be active in the candidates service and the job open in the jobs service.
 // @Tags Interviews
 // @Accept json
 // @Produce json
 // @Param request body domain.Interview true "Interview Creation Request"
 // @Success 201 {object} map[string]string "Interview created successfully"
-// @Failure 400 {object} map[string]string "Bad request"
-// @Failure 500 {object} map[string]string "Failed to create interview"
-// @Router /interviews [post]
+// @Failure 400 {object} problem.Problem "Malformed request body or missing fields"
+// @Failure 422 {object} problem.Problem "Unknown or inactive candidate or job"
+// @Failure 500 {object} problem.Problem "Failed to create interview"
+// @Failure 503 {object} problem.Problem "Candidates or jobs service unavailable"
+// @Router /v1/interviews [post]
 func (h *InterviewHandler) CreateInterview(c *gin.Context) {
 	var interview domain.Interview
-	if err := c.ShouldBindJSON(&interview); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	if !bindJSON(c, &interview) {
 		return
 	}
 
-	// Validate required fields
-	if interview.CandidateID == 0 || interview.JobID == 0 || interview.InterviewDate.IsZero() {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "candidate_id, job_id, and interview_date are required"})
+	// Call the service to add the interview
+	if err := h.service.AddInterview(c.Request.Context(), &interview); err != nil {
+		respondError(c, err, "failed to create interview")
 		return
 	}
 
-	// Call the service to add the interview
-	if err := h.service.AddInterview(&interview); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to create interview"})
+	c.JSON(http.StatusCreated, gin.H{"message": "interview created successfully"})
+}
+
+// GetInterviewsNeedingAttention handles the retrieval of interviews flagged as needing attention
+// @Summary List interviews needing attention
+// @Description Retrieve interviews that ended without an outcome or feedback, oldest first
+// @Tags Interviews
+// @Produce json
+// @Success 200 {array} domain.Interview "List of interviews needing attention"
+// @Failure 500 {object} problem.Problem "Failed to fetch interviews"
+// @Router /v1/interviews/attention [get]
+func (h *InterviewHandler) GetInterviewsNeedingAttention(c *gin.Context) {
+	interviews, err := h.service.GetInterviewsNeedingAttention(c.Request.Context())
+	if err != nil {
+		respondError(c, err, "failed to fetch interviews")
 		return
 	}
+	if interviews == nil {
+		interviews = []*domain.Interview{}
+	}
+	c.JSON(http.StatusOK, interviews)
+}
 
-	c.JSON(http.StatusCreated, gin.H{"message": "interview created successfully"})
+// ResolveAttention handles marking flagged interviews as completed or no-show in bulk
+// @Summary Resolve interviews needing attention
+// @Description Record completed or no_show for the given interviews. Interviews that are no longer flagged are skipped.
+// @Tags Interviews
+// @Accept json
+// @Produce json
+// @Param request body domain.AttentionResolution true "Interviews and outcome"
+// @Success 200 {object} map[string]int "Number of interviews updated"
+// @Failure 400 {object} problem.Problem "Malformed request body"
+// @Failure 422 {object} problem.Problem "Invalid status or no interviews given"
+// @Failure 500 {object} problem.Problem "Failed to resolve interviews"
+// @Router /v1/interviews/attention/resolve [post]
+func (h *InterviewHandler) ResolveAttention(c *gin.Context) {
+	var resolution domain.AttentionResolution
+	if !bindJSON(c, &resolution) {
+		return
+	}
+
+	updated, err := h.service.ResolveAttention(c.Request.Context(), resolution)
+	if err != nil {
+		respondError(c, err, "failed to resolve interviews")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"updated": updated})
 }
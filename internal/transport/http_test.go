@@ -3,38 +3,20 @@ package transport
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/client"
 	"github.com/poolcamacho/interviews-service/internal/domain"
 	"github.com/poolcamacho/interviews-service/internal/service"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
-func TestHealthCheck(t *testing.T) {
-	// Setup
-	mockInterviewService := new(service.MockInterviewService)
-	interviewHandler := NewInterviewHandler(mockInterviewService)
-
-	gin.SetMode(gin.TestMode)
-	router := gin.Default()
-	router.GET("/health", interviewHandler.HealthCheck)
-
-	// Prepare HTTP request
-	req := httptest.NewRequest(http.MethodGet, "/health", nil)
-	rec := httptest.NewRecorder()
-
-	// Execute
-	router.ServeHTTP(rec, req)
-
-	// Assertions
-	assert.Equal(t, http.StatusOK, rec.Code)
-	assert.JSONEq(t, `{"status":"healthy"}`, rec.Body.String())
-}
-
 func TestGetInterviews(t *testing.T) {
 	// Setup
 	mockInterviewService := new(service.MockInterviewService)
@@ -63,7 +45,7 @@ func TestGetInterviews(t *testing.T) {
 	}
 
 	// Mock behavior
-	mockInterviewService.On("GetAllInterviews").Return(interviews, nil)
+	mockInterviewService.On("GetAllInterviews", mock.Anything).Return(interviews, nil)
 
 	// Prepare HTTP request
 	req := httptest.NewRequest(http.MethodGet, "/interviews", nil)
@@ -97,7 +79,7 @@ func TestCreateInterview(t *testing.T) {
 	}
 
 	// Mock behavior
-	mockInterviewService.On("AddInterview", newInterview).Return(nil)
+	mockInterviewService.On("AddInterview", mock.Anything, newInterview).Return(nil)
 
 	// Prepare HTTP request
 	body, _ := json.Marshal(newInterview)
@@ -114,6 +96,52 @@ func TestCreateInterview(t *testing.T) {
 	mockInterviewService.AssertExpectations(t)
 }
 
+func TestCreateInterview_InvalidReference(t *testing.T) {
+	// Setup
+	mockInterviewService := new(service.MockInterviewService)
+	interviewHandler := NewInterviewHandler(mockInterviewService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/interviews", interviewHandler.CreateInterview)
+
+	// Mock behavior
+	mockInterviewService.On("AddInterview", mock.Anything, mock.Anything).
+		Return(&service.Error{Kind: service.KindValidation, Message: "invalid reference: candidate 101 is withdrawn",
+			Fields: []service.FieldError{{Field: "candidate_id", Message: "candidate 101 is withdrawn"}},
+			Err:    service.ErrInvalidReference}).Once()
+	mockInterviewService.On("AddInterview", mock.Anything, mock.Anything).
+		Return(&service.Error{Kind: service.KindUnavailable, Message: "could not look up job, try again later",
+			Err: fmt.Errorf("looking up job 201: %w", client.ErrUnavailable)}).Once()
+
+	body := `{"candidate_id": 101, "job_id": 201, "interview_date": "2024-12-30T14:00:00Z"}`
+
+	// Execute and assert: unknown or inactive references are rejected with 422
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/interviews", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "/problems/validation-error",
+		"title": "Validation failed",
+		"status": 422,
+		"detail": "invalid reference: candidate 101 is withdrawn",
+		"instance": "/interviews",
+		"errors": [{"field": "candidate_id", "message": "candidate 101 is withdrawn"}]
+	}`, rec.Body.String())
+
+	// Execute and assert: an unreachable dependency is reported as 503
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/interviews", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"type":"/problems/unavailable"`)
+	mockInterviewService.AssertExpectations(t)
+}
+
 func TestCreateInterview_BadRequest(t *testing.T) {
 	// Setup
 	mockInterviewService := new(service.MockInterviewService)
@@ -138,6 +166,65 @@ func TestCreateInterview_BadRequest(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 	assert.Contains(t, rec.Body.String(), "error") // Check if the response contains an error message
 
+	// Every missing field is reported under its JSON name
+	assert.JSONEq(t, `{
+		"type": "/problems/validation-error",
+		"title": "Validation failed",
+		"status": 400,
+		"detail": "the request body has invalid fields",
+		"instance": "/interviews",
+		"errors": [
+			{"field": "candidate_id", "message": "is required"},
+			{"field": "job_id", "message": "is required"},
+			{"field": "interview_date", "message": "is required"}
+		]
+	}`, rec.Body.String())
+
 	// Ensure the service method is NOT called
-	mockInterviewService.AssertNotCalled(t, "AddInterview")
+	mockInterviewService.AssertNotCalled(t, "AddInterview", mock.Anything, mock.Anything)
+}
+
+func TestResolveAttention(t *testing.T) {
+	// Setup
+	mockInterviewService := new(service.MockInterviewService)
+	interviewHandler := NewInterviewHandler(mockInterviewService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.GET("/interviews/attention", interviewHandler.GetInterviewsNeedingAttention)
+	router.POST("/interviews/attention/resolve", interviewHandler.ResolveAttention)
+
+	// Mock data
+	flagged := []*domain.Interview{{ID: 1, CandidateID: 101, JobID: 201, Status: domain.StatusNeedsAttention}}
+	resolution := domain.AttentionResolution{InterviewIDs: []int{1}, Status: domain.StatusNoShow}
+
+	// Mock behavior
+	mockInterviewService.On("GetInterviewsNeedingAttention", mock.Anything).Return(flagged, nil)
+	mockInterviewService.On("ResolveAttention", mock.Anything, resolution).Return(1, nil)
+	mockInterviewService.On("ResolveAttention", mock.Anything, domain.AttentionResolution{InterviewIDs: []int{1}, Status: "archived"}).
+		Return(0, &service.Error{Kind: service.KindValidation, Message: "invalid resolution: bad status", Err: service.ErrInvalidResolution})
+
+	// Execute and assert: list the queue
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/interviews/attention", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"needs_attention"`)
+
+	// Execute and assert: resolve in bulk
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/interviews/attention/resolve",
+		bytes.NewBufferString(`{"interview_ids":[1],"status":"no_show"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"updated":1}`, rec.Body.String())
+
+	// Execute and assert: invalid outcomes are rejected
+	rec = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/interviews/attention/resolve",
+		bytes.NewBufferString(`{"interview_ids":[1],"status":"archived"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	mockInterviewService.AssertExpectations(t)
 }
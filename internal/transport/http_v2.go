@@ -0,0 +1,223 @@
+package transport
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+)
+
+// InterviewV2 is the version 2 representation of an interview
+// Related entities are nested objects and the schedule carries its end time, so clients
+// no longer have to derive them.
+type InterviewV2 struct {
+	ID                 int               `json:"id"`                            // Unique identifier for the interview
+	Candidate          CandidateRefV2    `json:"candidate"`                     // The candidate being interviewed
+	Job                JobRefV2          `json:"job"`                           // The job the candidate is interviewed for
+	Schedule           ScheduleV2        `json:"schedule"`                      // When the interview takes place
+	Stage              string            `json:"stage"`                         // Hiring stage, e.g. screening or onsite
+	Status             string            `json:"status"`                        // Lifecycle status
+	Feedback           string            `json:"feedback"`                      // Overall feedback or notes about the interview
+	CancellationReason string            `json:"cancellation_reason,omitempty"` // Reason given when the interview was cancelled
+	Panel              []PanelistV2      `json:"panel"`                         // Interviewers taking part in the interview
+	FeedbackSummary    FeedbackSummaryV2 `json:"feedback_summary"`              // Progress of the panel's feedback
+	Links              map[string]string `json:"links"`                         // Related resources, keyed by relation
+}
+
+// CandidateRefV2 identifies the candidate of an interview
+type CandidateRefV2 struct {
+	ID    int    `json:"id"`              // ID of the candidate in the candidates service
+	Email string `json:"email,omitempty"` // Address used to notify the candidate
+}
+
+// JobRefV2 identifies the job of an interview
+type JobRefV2 struct {
+	ID int `json:"id"` // ID of the job in the jobs service
+}
+
+// ScheduleV2 describes when an interview takes place
+type ScheduleV2 struct {
+	StartsAt        time.Time `json:"starts_at"`        // Start of the interview
+	EndsAt          time.Time `json:"ends_at"`          // Expected end of the interview
+	DurationMinutes int       `json:"duration_minutes"` // Expected length of the interview
+}
+
+// PanelistV2 is an interviewer and the state of their feedback
+type PanelistV2 struct {
+	ID       int             `json:"id"`       // Unique identifier for the panelist
+	Name     string          `json:"name"`     // Display name of the interviewer
+	Email    string          `json:"email"`    // Address used to notify the interviewer
+	Feedback PanelFeedbackV2 `json:"feedback"` // Feedback submitted by the interviewer
+}
+
+// PanelFeedbackV2 is the feedback of a single interviewer
+type PanelFeedbackV2 struct {
+	Submitted   bool       `json:"submitted"`              // Whether the interviewer submitted feedback
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"` // Time the feedback was submitted
+	Text        string     `json:"text,omitempty"`         // The feedback itself
+}
+
+// FeedbackSummaryV2 counts the panel's feedback
+type FeedbackSummaryV2 struct {
+	Submitted int `json:"submitted"` // Interviewers who submitted feedback
+	Pending   int `json:"pending"`   // Interviewers yet to submit feedback
+}
+
+// InterviewListV2 is the version 2 envelope of interview lists
+type InterviewListV2 struct {
+	Data  []InterviewV2 `json:"data"`  // The interviews
+	Count int           `json:"count"` // Number of interviews in data
+}
+
+// NewInterviewV2 builds the version 2 representation of an interview
+// @return InterviewV2 - The representation sent to version 2 clients
+func NewInterviewV2(interview *domain.Interview) InterviewV2 {
+	v2 := InterviewV2{
+		ID:        interview.ID,
+		Candidate: CandidateRefV2{ID: interview.CandidateID, Email: interview.CandidateEmail},
+		Job:       JobRefV2{ID: interview.JobID},
+		Schedule: ScheduleV2{
+			StartsAt:        interview.InterviewDate,
+			EndsAt:          interview.InterviewDate.Add(domain.DefaultInterviewDuration),
+			DurationMinutes: int(domain.DefaultInterviewDuration / time.Minute),
+		},
+		Stage:              interview.Stage,
+		Status:             string(interview.Status),
+		Feedback:           interview.Feedback,
+		CancellationReason: interview.CancellationReason,
+		Panel:              make([]PanelistV2, 0, len(interview.Panel)),
+		Links:              map[string]string{"self": fmt.Sprintf("/v2/interviews/%d", interview.ID)},
+	}
+
+	for _, panelist := range interview.Panel {
+		feedback := PanelFeedbackV2{
+			Submitted:   panelist.FeedbackSubmittedAt != nil,
+			SubmittedAt: panelist.FeedbackSubmittedAt,
+			Text:        panelist.Feedback,
+		}
+		if feedback.Submitted {
+			v2.FeedbackSummary.Submitted++
+		} else {
+			v2.FeedbackSummary.Pending++
+		}
+		v2.Panel = append(v2.Panel, PanelistV2{ID: panelist.ID, Name: panelist.Name, Email: panelist.Email, Feedback: feedback})
+	}
+	return v2
+}
+
+// newInterviewListV2 wraps interviews in the version 2 list envelope
+// @return InterviewListV2 - The envelope, with an empty data array when there are no interviews
+func newInterviewListV2(interviews []*domain.Interview) InterviewListV2 {
+	list := InterviewListV2{Data: make([]InterviewV2, 0, len(interviews))}
+	for _, interview := range interviews {
+		list.Data = append(list.Data, NewInterviewV2(interview))
+	}
+	list.Count = len(list.Data)
+	return list
+}
+
+// InterviewHandlerV2 handles version 2 HTTP requests for interviews
+type InterviewHandlerV2 struct {
+	service service.InterviewService
+}
+
+// NewInterviewHandlerV2 creates a new InterviewHandlerV2 instance
+// @Summary Initialize the version 2 interview handler
+// @Description Creates an instance of InterviewHandlerV2 to manage version 2 interview endpoints
+// @Tags Initialization
+// @Produce json
+func NewInterviewHandlerV2(service service.InterviewService) *InterviewHandlerV2 {
+	return &InterviewHandlerV2{service: service}
+}
+
+// GetInterviews handles the retrieval of all interviews
+// @Summary Get all interviews
+// @Description Retrieve every interview with its schedule, panel and feedback progress
+// @Tags Interviews v2
+// @Produce json
+// @Success 200 {object} InterviewListV2 "List of interviews"
+// @Failure 500 {object} problem.Problem "Failed to fetch interviews"
+// @Router /v2/interviews [get]
+func (h *InterviewHandlerV2) GetInterviews(c *gin.Context) {
+	interviews, err := h.service.GetAllInterviews(c.Request.Context())
+	if err != nil {
+		respondError(c, err, "failed to fetch interviews")
+		return
+	}
+	c.JSON(http.StatusOK, newInterviewListV2(interviews))
+}
+
+// GetInterview handles the retrieval of a single interview
+// @Summary Get an interview
+// @Description Retrieve an interview with its schedule, panel and feedback progress
+// @Tags Interviews v2
+// @Produce json
+// @Param id path int true "Interview ID"
+// @Success 200 {object} InterviewV2 "The interview"
+// @Failure 400 {object} problem.Problem "Invalid interview ID"
+// @Failure 404 {object} problem.Problem "Interview not found"
+// @Failure 500 {object} problem.Problem "Failed to fetch interview"
+// @Router /v2/interviews/{id} [get]
+func (h *InterviewHandlerV2) GetInterview(c *gin.Context) {
+	id, ok := pathInt(c, "id")
+	if !ok {
+		return
+	}
+
+	interview, err := h.service.GetInterviewByID(c.Request.Context(), id)
+	if err != nil {
+		respondError(c, err, "failed to fetch interview")
+		return
+	}
+	c.JSON(http.StatusOK, NewInterviewV2(interview))
+}
+
+// CreateInterview handles the creation of a new interview
+// @Summary Create a new interview
+// @Description Add a new interview; the request body is the same as in version 1. Responds with the created
+// @Description interview and its location.
+// @Tags Interviews v2
+// @Accept json
+// @Produce json
+// @Param request body domain.Interview true "Interview Creation Request"
+// @Success 201 {object} InterviewV2 "The created interview"
+// @Failure 400 {object} problem.Problem "Malformed request body"
+// @Failure 422 {object} problem.Problem "Missing fields, or unknown or inactive candidate or job"
+// @Failure 500 {object} problem.Problem "Failed to create interview"
+// @Failure 503 {object} problem.Problem "Candidates or jobs service unavailable"
+// @Router /v2/interviews [post]
+func (h *InterviewHandlerV2) CreateInterview(c *gin.Context) {
+	var interview domain.Interview
+	if !bindJSON(c, &interview) {
+		return
+	}
+
+	if err := h.service.AddInterview(c.Request.Context(), &interview); err != nil {
+		respondError(c, err, "failed to create interview")
+		return
+	}
+
+	representation := NewInterviewV2(&interview)
+	c.Header("Location", representation.Links["self"])
+	c.JSON(http.StatusCreated, representation)
+}
+
+// GetInterviewsNeedingAttention handles the retrieval of interviews flagged as needing attention
+// @Summary List interviews needing attention
+// @Description Retrieve interviews that ended without an outcome or feedback, oldest first
+// @Tags Interviews v2
+// @Produce json
+// @Success 200 {object} InterviewListV2 "List of interviews needing attention"
+// @Failure 500 {object} problem.Problem "Failed to fetch interviews"
+// @Router /v2/interviews/attention [get]
+func (h *InterviewHandlerV2) GetInterviewsNeedingAttention(c *gin.Context) {
+	interviews, err := h.service.GetInterviewsNeedingAttention(c.Request.Context())
+	if err != nil {
+		respondError(c, err, "failed to fetch interviews")
+		return
+	}
+	c.JSON(http.StatusOK, newInterviewListV2(interviews))
+}
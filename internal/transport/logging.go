@@ -0,0 +1,120 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+	"github.com/poolcamacho/interviews-service/pkg/problem"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader carries the ID of a request, accepted from the caller and echoed in the response
+const RequestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength bounds the request IDs accepted from callers, so they cannot flood the logs
+const maxRequestIDLength = 128
+
+// RequestID tags every request with an ID and hands it a logger carrying that ID
+// The X-Request-ID header of the caller is kept when it is a reasonable ID, otherwise a UUID is generated. The ID
+// is echoed in the response and, with the trace ID when the request is traced, added to every line logged through
+// logger.FromContext while serving the request. It must come after Tracing to pick up the trace ID.
+// @return gin.HandlerFunc - The middleware
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := requestID(c.GetHeader(RequestIDHeader))
+		c.Header(RequestIDHeader, id)
+		c.Request = c.Request.WithContext(withRequestLogger(c.Request.Context(), id))
+		c.Next()
+	}
+}
+
+// AccessLog logs every request once it has been served
+// Server errors are logged at the error level; probes and metric scrapes at the debug level, so they only show up
+// when LOG_LEVEL is debug. Query strings are left out, as they may carry tokens.
+// @return gin.HandlerFunc - The middleware
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		started := time.Now()
+		c.Next()
+
+		level := slog.LevelInfo
+		switch {
+		case c.Writer.Status() >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case probePaths[c.Request.URL.Path]:
+			level = slog.LevelDebug
+		}
+		logger.FromContext(c.Request.Context()).LogAttrs(c.Request.Context(), level, "Request served",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", c.Writer.Status()),
+			slog.Int("bytes", c.Writer.Size()),
+			slog.Float64("duration_ms", float64(time.Since(started).Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+		)
+	}
+}
+
+// Recovery answers requests whose handler panicked with a 500 problem and logs the panic with its stack
+// @return gin.HandlerFunc - The middleware
+func Recovery() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			if recovered == http.ErrAbortHandler {
+				panic(recovered) // Aborts the response on purpose, handled by net/http
+			}
+			logger.FromContext(c.Request.Context()).Error("Recovered from panic",
+				"panic", fmt.Sprint(recovered), "stack", string(debug.Stack()))
+			problem.Abort(c, problem.New(problem.TypeInternal, http.StatusInternalServerError, "the request could not be completed"))
+		}()
+		c.Next()
+	}
+}
+
+// grpcRequestContext tags a gRPC call like RequestID tags HTTP requests, from the x-request-id metadata
+// @return context.Context - The context of the call, carrying a logger with the request ID
+func grpcRequestContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var id string
+	if values := md.Get(RequestIDHeader); len(values) > 0 {
+		id = values[0]
+	}
+	return withRequestLogger(ctx, requestID(id))
+}
+
+// requestID returns the ID given by the caller, or a new one when it is missing or not a reasonable ID
+// @return string - The ID of the request
+func requestID(given string) string {
+	if given == "" || len(given) > maxRequestIDLength {
+		return uuid.NewString()
+	}
+	for _, r := range given {
+		if r < '!' || r > '~' { // Printable ASCII only, so IDs cannot break log lines or headers
+			return uuid.NewString()
+		}
+	}
+	return given
+}
+
+// withRequestLogger returns a copy of ctx carrying a logger annotated with the request ID and the trace ID
+// @return context.Context - The context carrying the logger
+func withRequestLogger(ctx context.Context, id string) context.Context {
+	l := logger.FromContext(ctx).With(slog.String("request_id", id))
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		l = l.With(slog.String("trace_id", span.TraceID().String()))
+	}
+	return logger.WithContext(ctx, l)
+}
@@ -0,0 +1,122 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLoggedRouter returns a router logging to out at the info level through RequestID, AccessLog and Recovery
+func newLoggedRouter(out *bytes.Buffer) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context(), logger.New(out, slog.LevelInfo)))
+	}, RequestID(), AccessLog(), Recovery())
+	return router
+}
+
+// logLines decodes the JSON lines logged to out
+func logLines(t *testing.T, out *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		lines = append(lines, entry)
+	}
+	return lines
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		given    string
+		wantKept bool
+	}{
+		{name: "given by the caller", given: "req-4bf92f3577b3", wantKept: true},
+		{name: "missing", given: "", wantKept: false},
+		{name: "with a line break", given: "req-1\n{\"level\":\"ERROR\"}", wantKept: false},
+		{name: "too long", given: strings.Repeat("a", maxRequestIDLength+1), wantKept: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			var out bytes.Buffer
+			router := newLoggedRouter(&out)
+			router.GET("/v1/interviews/:id", func(c *gin.Context) {
+				logger.FromContext(c.Request.Context()).Info("Handling request")
+				c.Status(http.StatusOK)
+			})
+			request := httptest.NewRequest(http.MethodGet, "/v1/interviews/7?token=secret", nil)
+			request.Header.Set(RequestIDHeader, tt.given)
+			recorder := httptest.NewRecorder()
+
+			// Execute
+			router.ServeHTTP(recorder, request)
+
+			// Assertions
+			id := recorder.Header().Get(RequestIDHeader)
+			if tt.wantKept {
+				assert.Equal(t, tt.given, id)
+			} else {
+				assert.NoError(t, uuid.Validate(id))
+			}
+			lines := logLines(t, &out)
+			require.Len(t, lines, 2)
+			assert.Equal(t, id, lines[0]["request_id"]) // Lines logged by handlers carry the ID
+			assert.Equal(t, "Request served", lines[1]["msg"])
+			assert.Equal(t, id, lines[1]["request_id"])
+			assert.Equal(t, "/v1/interviews/:id", lines[1]["route"])
+			assert.Equal(t, "/v1/interviews/7", lines[1]["path"]) // The query string is left out
+			assert.Equal(t, float64(http.StatusOK), lines[1]["status"])
+		})
+	}
+}
+
+func TestAccessLog_Probes(t *testing.T) {
+	// Setup
+	var out bytes.Buffer
+	router := newLoggedRouter(&out)
+	router.GET("/livez", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// Execute
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/livez", nil))
+
+	// Assertions
+	assert.Empty(t, out.String()) // Logged at the debug level only
+}
+
+func TestRecovery(t *testing.T) {
+	// Setup
+	var out bytes.Buffer
+	router := newLoggedRouter(&out)
+	router.GET("/v1/interviews", func(c *gin.Context) { panic("nil map") })
+	recorder := httptest.NewRecorder()
+
+	// Execute
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/v1/interviews", nil))
+
+	// Assertions
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+	lines := logLines(t, &out)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "Recovered from panic", lines[0]["msg"])
+	assert.Equal(t, "nil map", lines[0]["panic"])
+	assert.Equal(t, "ERROR", lines[1]["level"]) // Server errors are logged as errors
+}
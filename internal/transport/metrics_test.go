@@ -0,0 +1,31 @@
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Metrics())
+	router.GET("/v1/webhooks/:id", func(c *gin.Context) { c.Status(http.StatusNoContent) })
+	found := requestsTotal.WithLabelValues(http.MethodGet, "/v1/webhooks/:id", "204")
+	unmatched := requestsTotal.WithLabelValues(http.MethodGet, unmatchedRoute, "404")
+	foundCount, unmatchedCount := testutil.ToFloat64(found), testutil.ToFloat64(unmatched)
+
+	// Execute
+	for _, path := range []string{"/v1/webhooks/1", "/v1/webhooks/2", "/wp-login.php"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	// Assertions
+	assert.Equal(t, foundCount+2, testutil.ToFloat64(found)) // Counted by route template, not by path
+	assert.Equal(t, unmatchedCount+1, testutil.ToFloat64(unmatched))
+}
@@ -0,0 +1,155 @@
+package transport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+	"github.com/poolcamacho/interviews-service/pkg/problem"
+)
+
+// problemTypes maps the kinds of service errors to their problem type and status
+var problemTypes = map[service.Kind]struct {
+	Type   string
+	Status int
+}{
+	service.KindNotFound:    {problem.TypeNotFound, http.StatusNotFound},
+	service.KindConflict:    {problem.TypeConflict, http.StatusConflict},
+	service.KindValidation:  {problem.TypeValidation, http.StatusUnprocessableEntity},
+	service.KindForbidden:   {problem.TypeForbidden, http.StatusForbidden},
+	service.KindUnavailable: {problem.TypeUnavailable, http.StatusServiceUnavailable},
+}
+
+// respondError writes the problem matching an error returned by a service
+// Errors that are not a *service.Error are logged and reported as a 500 with the fallback detail,
+// so internal messages never reach clients.
+func respondError(c *gin.Context, err error, fallback string) {
+	var serviceErr *service.Error
+	if errors.As(err, &serviceErr) {
+		if mapping, ok := problemTypes[serviceErr.Kind]; ok {
+			p := problem.New(mapping.Type, mapping.Status, serviceErr.Message)
+			for _, field := range serviceErr.Fields {
+				p.Errors = append(p.Errors, problem.FieldError{Field: field.Field, Message: field.Message})
+			}
+			problem.Abort(c, p)
+			return
+		}
+	}
+
+	logger.FromContext(c.Request.Context()).Error("Request failed", "message", fallback, "error", err)
+	problem.Abort(c, problem.New(problem.TypeInternal, http.StatusInternalServerError, fallback))
+}
+
+// respondInvalidRequest writes a 400 problem about a request that could not be parsed
+// The field is omitted from the problem when empty.
+func respondInvalidRequest(c *gin.Context, field, message string) {
+	p := problem.New(problem.TypeInvalidRequest, http.StatusBadRequest, message)
+	if field != "" {
+		p.Detail = field + ": " + message
+		p.Errors = []problem.FieldError{{Field: field, Message: message}}
+	}
+	problem.Abort(c, p)
+}
+
+// unprocessableBindingsKey is the context key marking routes that answer invalid body fields with a 422
+const unprocessableBindingsKey = "unprocessable_bindings"
+
+// unprocessableBindings makes bindJSON answer invalid body fields with a 422 on the routes it guards
+// Version 1 answered them with a 400 and keeps doing so for its existing clients.
+func unprocessableBindings(c *gin.Context) {
+	c.Set(unprocessableBindingsKey, true)
+	c.Next()
+}
+
+// bindJSON decodes and validates the request body into obj, writing a problem when it is invalid
+// Malformed JSON and values of the wrong type are answered with a 400; fields failing their binding
+// rules with a problem listing every invalid field under its JSON name, a 400 on version 1 and a 422
+// on the routes guarded by unprocessableBindings.
+// @return bool - False if the response has already been written
+func bindJSON(c *gin.Context, obj interface{}) bool {
+	registerJSONFieldNames()
+
+	err := c.ShouldBindJSON(obj)
+	if err == nil {
+		return true
+	}
+
+	var validationErrs validator.ValidationErrors
+	var typeErr *json.UnmarshalTypeError
+	var timeErr *time.ParseError
+	switch {
+	case errors.As(err, &validationErrs):
+		status := http.StatusBadRequest
+		if c.GetBool(unprocessableBindingsKey) {
+			status = http.StatusUnprocessableEntity
+		}
+		p := problem.New(problem.TypeValidation, status, "the request body has invalid fields")
+		for _, fe := range validationErrs {
+			p.Errors = append(p.Errors, problem.FieldError{Field: fieldPath(fe), Message: validationMessage(fe)})
+		}
+		problem.Abort(c, p)
+	case errors.As(err, &typeErr):
+		respondInvalidRequest(c, typeErr.Field, fmt.Sprintf("must be of type %s", typeErr.Type))
+	case errors.As(err, &timeErr):
+		respondInvalidRequest(c, "", fmt.Sprintf("invalid time %q: expected RFC 3339, e.g. 2024-12-30T15:00:00Z", timeErr.Value))
+	default:
+		respondInvalidRequest(c, "", "malformed JSON body: "+err.Error())
+	}
+	return false
+}
+
+// registerNamesOnce guards the registration of the validator tag name function
+var registerNamesOnce sync.Once
+
+// registerJSONFieldNames makes the validator report fields by their JSON name
+func registerJSONFieldNames() {
+	registerNamesOnce.Do(func() {
+		v, ok := binding.Validator.Engine().(*validator.Validate)
+		if !ok {
+			return
+		}
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.SplitN(field.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	})
+}
+
+// fieldPath returns the path of an invalid field without the name of the root struct, e.g. panel[0].email
+func fieldPath(fe validator.FieldError) string {
+	namespace := fe.Namespace()
+	if i := strings.Index(namespace, "."); i >= 0 {
+		return namespace[i+1:]
+	}
+	return namespace
+}
+
+// validationMessage describes a failed binding rule
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "oneof":
+		return "must be one of: " + fe.Param()
+	case "min":
+		return "must be at least " + fe.Param()
+	case "max":
+		return "must be at most " + fe.Param()
+	}
+	return "failed the " + fe.Tag() + " rule"
+}
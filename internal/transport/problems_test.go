@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		unprocessable bool // Whether the route is guarded by unprocessableBindings, as on version 2
+		expected      string
+	}{
+		{
+			name: "wrong type",
+			body: `{"candidate_id": "101", "job_id": 201, "interview_date": "2024-12-30T14:00:00Z"}`,
+			expected: `{"type":"/problems/invalid-request","title":"Invalid request","status":400,"instance":"/interviews",
+				"detail":"candidate_id: must be of type int","errors":[{"field":"candidate_id","message":"must be of type int"}]}`,
+		},
+		{
+			name: "invalid time",
+			body: `{"candidate_id": 101, "job_id": 201, "interview_date": "tomorrow"}`,
+			expected: `{"type":"/problems/invalid-request","title":"Invalid request","status":400,"instance":"/interviews",
+				"detail":"invalid time \"tomorrow\": expected RFC 3339, e.g. 2024-12-30T15:00:00Z"}`,
+		},
+		{
+			name: "missing field",
+			body: `{"candidate_id": 101, "interview_date": "2024-12-30T14:00:00Z"}`,
+			expected: `{"type":"/problems/validation-error","title":"Validation failed","status":400,"instance":"/interviews",
+				"detail":"the request body has invalid fields","errors":[{"field":"job_id","message":"is required"}]}`,
+		},
+		{
+			name:          "missing field on version 2",
+			body:          `{"candidate_id": 101, "interview_date": "2024-12-30T14:00:00Z"}`,
+			unprocessable: true,
+			expected: `{"type":"/problems/validation-error","title":"Validation failed","status":422,"instance":"/interviews",
+				"detail":"the request body has invalid fields","errors":[{"field":"job_id","message":"is required"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			if tt.unprocessable {
+				router.Use(unprocessableBindings)
+			}
+			router.POST("/interviews", func(c *gin.Context) {
+				var interview domain.Interview
+				if bindJSON(c, &interview) {
+					c.Status(http.StatusNoContent)
+				}
+			})
+
+			// Execute
+			req := httptest.NewRequest(http.MethodPost, "/interviews", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			// Assertions
+			assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+			assert.JSONEq(t, tt.expected, rec.Body.String())
+		})
+	}
+}
+
+func TestRespondError_HidesUnexpectedErrors(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/interviews", func(c *gin.Context) {
+		respondError(c, errors.New("dial tcp 10.0.0.1:3306: connection refused"), "failed to fetch interviews")
+	})
+
+	// Execute
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/interviews", nil))
+
+	// Assertions
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.JSONEq(t, `{"type":"/problems/internal-error","title":"Internal server error","status":500,
+		"detail":"failed to fetch interviews","instance":"/interviews"}`, rec.Body.String())
+}
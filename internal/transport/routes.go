@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"github.com/gin-gonic/gin"
+	jwtUtil "github.com/poolcamacho/interviews-service/pkg/jwt"
+)
+
+// eventRoles are the role claims allowed to push events; other services call with a service token
+var eventRoles = []string{"service", "admin"}
+
+// Handlers groups the HTTP handlers mounted under each API version
+type Handlers struct {
+	Interviews   *InterviewHandler   // Version 1 interview endpoints
+	InterviewsV2 *InterviewHandlerV2 // Version 2 interview endpoints
+	Webhooks     *WebhookHandler     // Webhook subscriptions, identical in every version; nil to leave them out
+	Feedback     *FeedbackHandler    // Interviewer feedback and its SLA, identical in every version; nil to leave them out
+	Events       *EventHandler       // Inbound events from other services, identical in every version
+}
+
+// RegisterV1 mounts the version 1 routes on a group
+// The unversioned routes are the same routes mounted at the root behind Deprecated.
+func RegisterV1(g *gin.RouterGroup, h Handlers) {
+	g.GET("/interviews", h.Interviews.GetInterviews)
+	g.POST("/interviews", h.Interviews.CreateInterview)
+	g.GET("/interviews/attention", h.Interviews.GetInterviewsNeedingAttention)
+	g.POST("/interviews/attention/resolve", h.Interviews.ResolveAttention)
+	registerShared(g, h)
+}
+
+// RegisterV2 mounts the version 2 routes on a group
+// Interviews use the InterviewV2 representation; the other resources are unchanged from version 1,
+// except that invalid body fields are answered with a 422 instead of a 400.
+func RegisterV2(g *gin.RouterGroup, h Handlers) {
+	g.Use(unprocessableBindings)
+	g.GET("/interviews", h.InterviewsV2.GetInterviews)
+	g.POST("/interviews", h.InterviewsV2.CreateInterview)
+	g.GET("/interviews/attention", h.InterviewsV2.GetInterviewsNeedingAttention)
+	g.POST("/interviews/attention/resolve", h.Interviews.ResolveAttention)
+	g.GET("/interviews/:id", h.InterviewsV2.GetInterview)
+	registerShared(g, h)
+}
+
+// RegisterHealth mounts the liveness and readiness probes, which are unversioned and unauthenticated
+func RegisterHealth(r gin.IRoutes, h *HealthHandler) {
+	r.GET("/livez", h.Livez)
+	r.GET("/readyz", h.Readyz)
+	r.GET("/health", h.HealthCheck)
+}
+
+// registerShared mounts the routes whose representation is the same in every version
+// Feedback and webhook routes are skipped when their handler is nil, as with in-memory storage.
+func registerShared(g *gin.RouterGroup, h Handlers) {
+	// Interviewer feedback and its SLA
+	if h.Feedback != nil {
+		g.POST("/interviews/:id/panel/:panelist_id/feedback", h.Feedback.SubmitFeedback)
+		g.GET("/feedback/overdue", h.Feedback.ListOverdueFeedback)
+		g.GET("/reports/feedback-sla", h.Feedback.GetSLAReport)
+	}
+
+	// Webhook subscriptions
+	if h.Webhooks != nil {
+		g.POST("/webhooks", h.Webhooks.CreateSubscription)
+		g.GET("/webhooks", h.Webhooks.ListSubscriptions)
+		g.DELETE("/webhooks/:id", h.Webhooks.DeleteSubscription)
+		g.GET("/webhooks/:id/deliveries", h.Webhooks.ListDeliveries)
+		g.GET("/webhooks/dead-letters", h.Webhooks.ListDeadLetters)
+		g.POST("/webhooks/deliveries/:id/replay", h.Webhooks.ReplayDelivery)
+	}
+
+	// Inbound events from the candidates and jobs services, which cancel interviews and so are not open to every user
+	g.POST("/events", jwtUtil.RequireRole(eventRoles...), h.Events.ReceiveEvent)
+}
@@ -0,0 +1,224 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/consumer"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/health"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVersionedRoutes_LegacyAliasIsDeprecated(t *testing.T) {
+	// Setup
+	mockInterviewService := new(service.MockInterviewService)
+	policy := DeprecationPolicy{
+		DeprecatedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+		Sunset:       time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC),
+		Successor:    "/v1",
+	}
+	now := time.Date(2026, time.December, 1, 0, 0, 0, 0, time.UTC)
+	router := newVersionedRouter(mockInterviewService, policy, func() time.Time { return now })
+
+	// Mock behavior
+	mockInterviewService.On("GetAllInterviews", mock.Anything).Return([]*domain.Interview{{ID: 1}}, nil)
+
+	// Execute
+	legacy := serve(router, "/interviews")
+	v1 := serve(router, "/v1/interviews")
+	now = policy.Sunset
+	gone := serve(router, "/interviews")
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, legacy.Code)
+	assert.Equal(t, "@1792368000", legacy.Header().Get("Deprecation"))
+	assert.Equal(t, "Fri, 30 Apr 2027 00:00:00 GMT", legacy.Header().Get("Sunset"))
+	assert.Equal(t, `</v1/interviews>; rel="successor-version"`, legacy.Header().Get("Link"))
+	assert.Equal(t, v1.Body.String(), legacy.Body.String())
+
+	assert.Equal(t, http.StatusOK, v1.Code)
+	assert.Empty(t, v1.Header().Get("Deprecation"))
+
+	assert.Equal(t, http.StatusGone, gone.Code)
+	assert.Equal(t, "application/problem+json", gone.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"type":"/problems/gone","title":"Gone","status":410,
+		"detail":"this route was removed, use /v1/interviews","instance":"/interviews"}`, gone.Body.String())
+	mockInterviewService.AssertNumberOfCalls(t, "GetAllInterviews", 2)
+}
+
+func TestDeprecation_SetPolicy(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	now := time.Date(2027, time.May, 1, 0, 0, 0, 0, time.UTC)
+	deprecation := NewDeprecation(DeprecationPolicy{
+		DeprecatedAt: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC),
+		Sunset:       time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC),
+		Successor:    "/v1",
+	})
+	router := gin.New()
+	router.GET("/interviews", deprecation.Middleware(func() time.Time { return now }), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// Execute
+	gone := serve(router, "/interviews")
+	extended := deprecation.Policy()
+	extended.Sunset = time.Date(2027, time.October, 31, 0, 0, 0, 0, time.UTC)
+	deprecation.SetPolicy(extended)
+	served := serve(router, "/interviews")
+
+	// Assertions
+	assert.Equal(t, http.StatusGone, gone.Code)
+	assert.Equal(t, http.StatusOK, served.Code)
+	assert.Equal(t, "Sun, 31 Oct 2027 00:00:00 GMT", served.Header().Get("Sunset"))
+}
+
+func TestVersionedRoutes_HealthProbes(t *testing.T) {
+	// Setup
+	mockInterviewService := new(service.MockInterviewService)
+	router := newVersionedRouter(mockInterviewService, DeprecationPolicy{Successor: "/v1"}, time.Now)
+	registry := health.NewRegistry(health.DefaultConfig())
+	registry.Register("database", func(ctx context.Context) error { return nil })
+	RegisterHealth(router, NewHealthHandler(registry))
+
+	for path, body := range map[string]string{
+		"/health": `{"status":"healthy"}`,
+		"/readyz": `{"status":"ok"}`,
+		"/livez":  `{"status":"ok"}`,
+	} {
+		t.Run(path, func(t *testing.T) {
+			// Execute
+			rec := serve(router, path)
+
+			// Assertions: the probes stay outside of the versions and the deprecated alias
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, body, rec.Body.String())
+			assert.Empty(t, rec.Header().Get("Deprecation"))
+		})
+	}
+}
+
+func TestVersionedRoutes_V2Representation(t *testing.T) {
+	// Setup
+	mockInterviewService := new(service.MockInterviewService)
+	router := newVersionedRouter(mockInterviewService, DeprecationPolicy{Successor: "/v1"}, time.Now)
+
+	// Mock data
+	submittedAt := time.Date(2024, time.December, 30, 17, 0, 0, 0, time.UTC)
+	interview := &domain.Interview{
+		ID: 7, CandidateID: 101, JobID: 201, CandidateEmail: "candidate@example.com", Stage: "onsite",
+		InterviewDate: time.Date(2024, time.December, 30, 15, 0, 0, 0, time.UTC),
+		Status:        domain.StatusScheduled,
+		Panel: []domain.Panelist{
+			{ID: 1, InterviewID: 7, Name: "Alice", Email: "alice@example.com", Feedback: "Strong hire", FeedbackSubmittedAt: &submittedAt},
+			{ID: 2, InterviewID: 7, Name: "Bob", Email: "bob@example.com"},
+		},
+	}
+
+	// Mock behavior
+	mockInterviewService.On("GetInterviewByID", mock.Anything, 7).Return(interview, nil)
+	mockInterviewService.On("GetInterviewByID", mock.Anything, 8).Return(nil, &service.Error{Kind: service.KindNotFound, Message: "interview 8 not found"})
+
+	// Execute
+	found := serve(router, "/v2/interviews/7")
+	missing := serve(router, "/v2/interviews/8")
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, found.Code)
+	assert.JSONEq(t, `{
+		"id": 7,
+		"candidate": {"id": 101, "email": "candidate@example.com"},
+		"job": {"id": 201},
+		"schedule": {"starts_at": "2024-12-30T15:00:00Z", "ends_at": "2024-12-30T16:00:00Z", "duration_minutes": 60},
+		"stage": "onsite",
+		"status": "scheduled",
+		"feedback": "",
+		"panel": [
+			{"id": 1, "name": "Alice", "email": "alice@example.com",
+				"feedback": {"submitted": true, "submitted_at": "2024-12-30T17:00:00Z", "text": "Strong hire"}},
+			{"id": 2, "name": "Bob", "email": "bob@example.com", "feedback": {"submitted": false}}
+		],
+		"feedback_summary": {"submitted": 1, "pending": 1},
+		"links": {"self": "/v2/interviews/7"}
+	}`, found.Body.String())
+	assert.Equal(t, http.StatusNotFound, missing.Code)
+	mockInterviewService.AssertExpectations(t)
+}
+
+func TestVersionedRoutes_MissingFieldsStatus(t *testing.T) {
+	// Setup
+	mockInterviewService := new(service.MockInterviewService)
+	router := newVersionedRouter(mockInterviewService, DeprecationPolicy{Successor: "/v1"}, time.Now)
+	post := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(`{"candidate_id": 101}`))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Execute
+	v1, v2 := post("/v1/interviews"), post("/v2/interviews")
+
+	// Assertions: version 1 keeps answering with a 400
+	assert.Equal(t, http.StatusBadRequest, v1.Code)
+	assert.Equal(t, http.StatusUnprocessableEntity, v2.Code)
+	mockInterviewService.AssertNotCalled(t, "AddInterview", mock.Anything, mock.Anything)
+}
+
+func TestVersionedRoutes_WithoutFeedbackAndWebhooks(t *testing.T) {
+	// Setup
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockInterviewService := new(service.MockInterviewService)
+	RegisterV1(router.Group("/v1"), Handlers{
+		Interviews:   NewInterviewHandler(mockInterviewService),
+		InterviewsV2: NewInterviewHandlerV2(mockInterviewService),
+		Events:       NewEventHandler(consumer.NewInterviewConsumer(mockInterviewService)),
+	})
+
+	// Mock behavior
+	mockInterviewService.On("GetAllInterviews", mock.Anything).Return([]*domain.Interview{}, nil)
+
+	// Execute
+	interviews := serve(router, "/v1/interviews")
+	webhooks := serve(router, "/v1/webhooks")
+	overdue := serve(router, "/v1/feedback/overdue")
+
+	// Assertions
+	assert.Equal(t, http.StatusOK, interviews.Code)
+	assert.Equal(t, http.StatusNotFound, webhooks.Code)
+	assert.Equal(t, http.StatusNotFound, overdue.Code)
+}
+
+// newVersionedRouter mounts every API version the way main does, without authentication
+func newVersionedRouter(interviewService service.InterviewService, policy DeprecationPolicy, now func() time.Time) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	handlers := Handlers{
+		Interviews:   NewInterviewHandler(interviewService),
+		InterviewsV2: NewInterviewHandlerV2(interviewService),
+		Webhooks:     NewWebhookHandler(new(service.MockWebhookService)),
+		Feedback:     NewFeedbackHandler(new(service.MockFeedbackService)),
+		Events:       NewEventHandler(consumer.NewInterviewConsumer(interviewService)),
+	}
+	RegisterV1(router.Group("/v1"), handlers)
+	RegisterV2(router.Group("/v2"), handlers)
+	RegisterV1(router.Group("", Deprecated(policy, now)), handlers)
+	return router
+}
+
+// serve performs a GET request against the router
+func serve(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	return rec
+}
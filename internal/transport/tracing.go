@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+)
+
+// probePaths are polled by probes and scrapers every few seconds; they are not traced and only logged at the debug
+// level, so they do not drown the real requests
+var probePaths = map[string]bool{"/livez": true, "/readyz": true, "/health": true, "/metrics": true}
+
+// Tracing starts a server span for every request, continuing the trace of the traceparent header when there is one
+// The span travels in the request context, so the service, repository and client spans of the request are its
+// children. service is the name reported on the spans. Like Metrics, it must be added before the routes are registered.
+// @return gin.HandlerFunc - The middleware
+func Tracing(service string) gin.HandlerFunc {
+	return otelgin.Middleware(service, otelgin.WithFilter(func(r *http.Request) bool {
+		return !probePaths[r.URL.Path]
+	}))
+}
@@ -0,0 +1,179 @@
+package transport
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+)
+
+// WebhookHandler handles HTTP requests for webhook subscriptions
+type WebhookHandler struct {
+	service service.WebhookService
+}
+
+// NewWebhookHandler creates a new WebhookHandler instance
+// @Summary Initialize the webhook handler
+// @Description Creates an instance of WebhookHandler to manage webhook endpoints
+// @Tags Initialization
+// @Produce json
+func NewWebhookHandler(service service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: service}
+}
+
+// CreateSubscription handles the creation of a webhook subscription
+// @Summary Create a webhook subscription
+// @Description Subscribe a URL to interview events. Payloads are signed with HMAC-SHA256 over "<X-Webhook-Timestamp>.<body>"
+// @Description using the subscription secret and sent in the X-Webhook-Signature header. A secret is generated when omitted
+// @Description and is only returned in this response.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param request body domain.WebhookSubscription true "Subscription Data"
+// @Success 201 {object} domain.WebhookSubscription "Subscription created"
+// @Failure 400 {object} problem.Problem "Malformed request body"
+// @Failure 422 {object} problem.Problem "Invalid URL or event types"
+// @Failure 500 {object} problem.Problem "Failed to create subscription"
+// @Router /v1/webhooks [post]
+func (h *WebhookHandler) CreateSubscription(c *gin.Context) {
+	var sub domain.WebhookSubscription
+	if !bindJSON(c, &sub) {
+		return
+	}
+
+	if err := h.service.CreateSubscription(&sub); err != nil {
+		respondError(c, err, "failed to create subscription")
+		return
+	}
+
+	c.JSON(http.StatusCreated, sub)
+}
+
+// ListSubscriptions handles the retrieval of all webhook subscriptions
+// @Summary List webhook subscriptions
+// @Description Retrieve all webhook subscriptions; secrets are not included
+// @Tags Webhooks
+// @Produce json
+// @Success 200 {array} domain.WebhookSubscription "List of subscriptions"
+// @Failure 500 {object} problem.Problem "Failed to fetch subscriptions"
+// @Router /v1/webhooks [get]
+func (h *WebhookHandler) ListSubscriptions(c *gin.Context) {
+	subs, err := h.service.ListSubscriptions()
+	if err != nil {
+		respondError(c, err, "failed to fetch subscriptions")
+		return
+	}
+	c.JSON(http.StatusOK, subs)
+}
+
+// DeleteSubscription handles the removal of a webhook subscription
+// @Summary Delete a webhook subscription
+// @Description Remove a subscription together with its delivery log
+// @Tags Webhooks
+// @Param id path int true "Subscription ID"
+// @Success 204 "Subscription deleted"
+// @Failure 400 {object} problem.Problem "Invalid subscription ID"
+// @Failure 404 {object} problem.Problem "Subscription not found"
+// @Failure 500 {object} problem.Problem "Failed to delete subscription"
+// @Router /v1/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteSubscription(c *gin.Context) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteSubscription(id); err != nil {
+		respondError(c, err, "failed to delete subscription")
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListDeliveries handles the retrieval of a subscription's delivery log
+// @Summary List deliveries of a webhook subscription
+// @Description Retrieve every delivery made to a subscription with its status, attempts and last error
+// @Tags Webhooks
+// @Produce json
+// @Param id path int true "Subscription ID"
+// @Success 200 {array} domain.WebhookDelivery "Delivery log"
+// @Failure 400 {object} problem.Problem "Invalid subscription ID"
+// @Failure 404 {object} problem.Problem "Subscription not found"
+// @Failure 500 {object} problem.Problem "Failed to fetch deliveries"
+// @Router /v1/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *gin.Context) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	deliveries, err := h.service.ListDeliveries(id)
+	if err != nil {
+		respondError(c, err, "failed to fetch deliveries")
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// ListDeadLetters handles the retrieval of the dead-letter list
+// @Summary List dead webhook deliveries
+// @Description Retrieve deliveries that exhausted their retries and can be replayed
+// @Tags Webhooks
+// @Produce json
+// @Success 200 {array} domain.WebhookDelivery "Dead deliveries"
+// @Failure 500 {object} problem.Problem "Failed to fetch deliveries"
+// @Router /v1/webhooks/dead-letters [get]
+func (h *WebhookHandler) ListDeadLetters(c *gin.Context) {
+	deliveries, err := h.service.ListDeadLetters()
+	if err != nil {
+		respondError(c, err, "failed to fetch deliveries")
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// ReplayDelivery handles replaying a dead delivery
+// @Summary Replay a dead webhook delivery
+// @Description Schedule a dead delivery to be sent again with the original payload and a fresh set of attempts
+// @Tags Webhooks
+// @Produce json
+// @Param id path int true "Delivery ID"
+// @Success 202 {object} domain.WebhookDelivery "Delivery scheduled"
+// @Failure 400 {object} problem.Problem "Invalid delivery ID"
+// @Failure 404 {object} problem.Problem "Delivery not found"
+// @Failure 409 {object} problem.Problem "Delivery is not dead"
+// @Failure 500 {object} problem.Problem "Failed to replay delivery"
+// @Router /v1/webhooks/deliveries/{id}/replay [post]
+func (h *WebhookHandler) ReplayDelivery(c *gin.Context) {
+	id, ok := pathID(c)
+	if !ok {
+		return
+	}
+
+	delivery, err := h.service.ReplayDelivery(id)
+	if err != nil {
+		respondError(c, err, "failed to replay delivery")
+		return
+	}
+	c.JSON(http.StatusAccepted, delivery)
+}
+
+// pathID parses the :id path parameter, writing a 400 problem when it is invalid
+// @return int - The parsed ID
+// @return bool - False if the response has already been written
+func pathID(c *gin.Context) (int, bool) {
+	return pathInt(c, "id")
+}
+
+// pathInt parses the named positive integer path parameter, writing a 400 problem when it is invalid
+// @return int - The parsed value
+// @return bool - False if the response has already been written
+func pathInt(c *gin.Context, name string) (int, bool) {
+	value, err := strconv.Atoi(c.Param(name))
+	if err != nil || value <= 0 {
+		respondInvalidRequest(c, name, "must be a positive integer")
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,135 @@
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateSubscription(t *testing.T) {
+	// Setup
+	mockWebhookService := new(service.MockWebhookService)
+	webhookHandler := NewWebhookHandler(mockWebhookService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/webhooks", webhookHandler.CreateSubscription)
+
+	// Mock behavior
+	mockWebhookService.On("CreateSubscription", mock.AnythingOfType("*domain.WebhookSubscription")).Run(func(args mock.Arguments) {
+		sub := args.Get(0).(*domain.WebhookSubscription)
+		sub.ID = 1
+		sub.Secret = "generated"
+	}).Return(nil)
+
+	// Prepare HTTP request
+	body := `{"url":"https://ats.example.com/hooks","event_types":["interview.created"]}`
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	// Execute
+	router.ServeHTTP(rec, req)
+
+	// Assertions
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"secret":"generated"`)
+	mockWebhookService.AssertExpectations(t)
+}
+
+func TestCreateSubscription_Invalid(t *testing.T) {
+	// Setup
+	mockWebhookService := new(service.MockWebhookService)
+	webhookHandler := NewWebhookHandler(mockWebhookService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.POST("/webhooks", webhookHandler.CreateSubscription)
+
+	// Mock behavior
+	mockWebhookService.On("CreateSubscription", mock.Anything).
+		Return(&service.Error{Kind: service.KindValidation, Message: "invalid webhook subscription", Err: service.ErrInvalidSubscription})
+
+	// Prepare HTTP request
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewBufferString(`{"url":"/hooks"}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+
+	// Execute
+	router.ServeHTTP(rec, req)
+
+	// Assertions
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+}
+
+func TestReplayDelivery(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "replayed", expected: http.StatusAccepted},
+		{name: "not found", err: &service.Error{Kind: service.KindNotFound}, expected: http.StatusNotFound},
+		{name: "not dead", err: &service.Error{Kind: service.KindConflict, Err: service.ErrNotReplayable}, expected: http.StatusConflict},
+		{name: "failed", err: errors.New("connection reset"), expected: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			mockWebhookService := new(service.MockWebhookService)
+			webhookHandler := NewWebhookHandler(mockWebhookService)
+
+			gin.SetMode(gin.TestMode)
+			router := gin.Default()
+			router.POST("/webhooks/deliveries/:id/replay", webhookHandler.ReplayDelivery)
+
+			// Mock behavior
+			if tt.err != nil {
+				mockWebhookService.On("ReplayDelivery", 10).Return(nil, tt.err)
+			} else {
+				mockWebhookService.On("ReplayDelivery", 10).Return(&domain.WebhookDelivery{ID: 10, Status: domain.DeliveryPending}, nil)
+			}
+
+			// Prepare HTTP request
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/deliveries/10/replay", nil)
+			rec := httptest.NewRecorder()
+
+			// Execute
+			router.ServeHTTP(rec, req)
+
+			// Assertions
+			assert.Equal(t, tt.expected, rec.Code)
+			mockWebhookService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListDeliveries_InvalidID(t *testing.T) {
+	// Setup
+	mockWebhookService := new(service.MockWebhookService)
+	webhookHandler := NewWebhookHandler(mockWebhookService)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.Default()
+	router.GET("/webhooks/:id/deliveries", webhookHandler.ListDeliveries)
+
+	// Prepare HTTP request
+	req := httptest.NewRequest(http.MethodGet, "/webhooks/abc/deliveries", nil)
+	rec := httptest.NewRecorder()
+
+	// Execute
+	router.ServeHTTP(rec, req)
+
+	// Assertions
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	mockWebhookService.AssertNotCalled(t, "ListDeliveries", mock.Anything)
+}
@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when an endpoint is not on a public address
+// Subscriptions are created through the API, so without this check anyone allowed to create one could make the
+// service call loopback, cloud metadata or internal network endpoints on their behalf.
+var ErrForbiddenAddress = errors.New("webhook endpoints must be on a public address")
+
+// sharedAddressSpace is the carrier-grade NAT range, which some clouds use for internal services
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// PublicAddress reports whether the Dispatcher may call an endpoint on an address
+// Loopback, link-local, private, shared, multicast and unspecified addresses are refused.
+// @param addr netip.Addr - The address of the endpoint
+// @return bool - Whether the address is a public unicast address
+func PublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// NewClient creates the http.Client the Dispatcher calls endpoints with
+// The address is checked when each connection is dialled, after DNS resolution and for every redirect, so a host
+// that resolved to a public address when the subscription was created cannot later point the service at an
+// internal one. Proxies are not used, since they would dial the endpoint past the check.
+// @param timeout time.Duration - The time limit of each request
+// @return *http.Client - A client that refuses to connect to non-public addresses
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: refuseNonPublic}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// refuseNonPublic is a net.Dialer Control function that fails the dial to a non-public address
+// @param network string - The network of the connection
+// @param address string - The resolved address being dialled, as host:port
+// @param c syscall.RawConn - The socket, unused
+// @return error - ErrForbiddenAddress if the address is not public
+func refuseNonPublic(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !PublicAddress(addrPort.Addr()) {
+		return fmt.Errorf("%w, got %s", ErrForbiddenAddress, addrPort.Addr())
+	}
+	return nil
+}
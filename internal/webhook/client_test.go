@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicAddress(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::1":   true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"fd00::1":              false,
+		"100.100.100.200":      false,
+		"0.0.0.0":              false,
+		"::ffff:127.0.0.1":     false,
+		"::ffff:93.184.216.34": true,
+	}
+
+	for address, public := range tests {
+		t.Run(address, func(t *testing.T) {
+			// Execute
+			got := PublicAddress(netip.MustParseAddr(address))
+
+			// Assertions
+			assert.Equal(t, public, got)
+		})
+	}
+}
+
+func TestNewClient_RefusesNonPublicAddresses(t *testing.T) {
+	// Setup
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { called = true }))
+	defer server.Close()
+	client := NewClient(time.Second)
+
+	// Execute
+	_, err := client.Post(server.URL, "application/json", nil)
+
+	// Assertions
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+	assert.False(t, called)
+}
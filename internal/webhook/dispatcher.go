@@ -0,0 +1,338 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+)
+
+// Header names set on every webhook request
+const (
+	HeaderEvent     = "X-Webhook-Event"     // Type of the delivered event
+	HeaderDelivery  = "X-Webhook-Delivery"  // Identifier of the delivery, stable across retries
+	HeaderTimestamp = "X-Webhook-Timestamp" // Unix time at which the request was signed
+	HeaderSignature = "X-Webhook-Signature" // HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription secret
+)
+
+// Config holds the retry and polling settings of a Dispatcher
+type Config struct {
+	MaxAttempts  int           // Attempts before a delivery is moved to the dead-letter list
+	BaseBackoff  time.Duration // Delay before the first retry, doubled on every further retry
+	MaxBackoff   time.Duration // Upper bound for the delay between retries
+	PollInterval time.Duration // How often due deliveries are looked up
+	BatchSize    int           // Maximum number of deliveries attempted per poll
+}
+
+// DefaultConfig returns the settings used when none are configured
+// Eight attempts with a 30s base backoff keep retrying for a little over an hour.
+// @return Config - The default settings
+func DefaultConfig() Config {
+	return Config{
+		MaxAttempts:  8,
+		BaseBackoff:  30 * time.Second,
+		MaxBackoff:   time.Hour,
+		PollInterval: 5 * time.Second,
+		BatchSize:    50,
+	}
+}
+
+// Payload is the JSON body posted to subscribers
+type Payload struct {
+	ID           string           `json:"id"`                      // Identifier of the event, shared by all its deliveries
+	Type         domain.EventType `json:"type"`                    // Type of the event
+	OccurredAt   time.Time        `json:"occurred_at"`             // Time at which the change happened
+	Data         domain.Interview `json:"data"`                    // Snapshot of the interview after the change
+	PreviousDate *time.Time       `json:"previous_date,omitempty"` // Interview date before a reschedule
+	Reason       string           `json:"reason,omitempty"`        // Reason given for a cancellation
+}
+
+// Dispatcher delivers interview events to webhook subscriptions
+// Publish records one delivery per interested subscription; a background loop then sends
+// due deliveries, retrying failures with exponential backoff until they succeed or are
+// moved to the dead-letter list. Due deliveries are not claimed, so when a lock is given only the
+// replica holding it sends them; any replica may still record deliveries through Publish.
+type Dispatcher struct {
+	repo   repository.WebhookRepository // Storage for subscriptions and deliveries
+	client *http.Client                 // Client used to call the endpoints
+	lock   leader.Lock                  // Elects the sending replica, nil when running a single instance
+	cfg    Config                       // Retry and polling settings
+	now    func() time.Time             // Clock, replaced in tests
+	ctx    context.Context              // Cancelled by Stop to abort the request in flight
+	cancel context.CancelFunc           // Cancels ctx
+	stop   chan struct{}                // Closed to stop the background loop
+	done   chan struct{}                // Closed once the background loop has exited
+	once   sync.Once                    // Guards stop against double close
+}
+
+// NewDispatcher creates a new Dispatcher instance
+// @param repo repository.WebhookRepository - The repository storing subscriptions and deliveries
+// @param client *http.Client - The client used to call the endpoints, should carry a timeout
+// @param lock leader.Lock - The lock electing the sending replica, nil to always send
+// @param cfg Config - The retry and polling settings
+// @return *Dispatcher - A dispatcher that is not yet running
+func NewDispatcher(repo repository.WebhookRepository, client *http.Client, lock leader.Lock, cfg Config) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{
+		repo:   repo,
+		client: client,
+		lock:   lock,
+		cfg:    cfg,
+		now:    time.Now,
+		ctx:    ctx,
+		cancel: cancel,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+// Publish records a pending delivery for every subscription interested in the event
+// @param event domain.Event - The event to deliver
+// @return error - An error if the subscriptions could not be loaded or a delivery could not be saved
+func (d *Dispatcher) Publish(event domain.Event) error {
+	subs, err := d.repo.FindAllSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	// Reuse the event ID so receivers can deduplicate events relayed more than once
+	eventID := event.ID
+	if eventID == "" {
+		if eventID, err = newEventID(); err != nil {
+			return err
+		}
+	}
+	payload, err := json.Marshal(Payload{
+		ID:           eventID,
+		Type:         event.Type,
+		OccurredAt:   event.OccurredAt,
+		Data:         event.Interview,
+		PreviousDate: event.PreviousDate,
+		Reason:       event.Reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	now := d.now().UTC()
+	for _, sub := range subs {
+		if !sub.Accepts(event.Type) {
+			continue
+		}
+		delivery := &domain.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        eventID,
+			EventType:      event.Type,
+			Payload:        payload,
+			Status:         domain.DeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+		if err := d.repo.CreateDelivery(delivery); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start runs the delivery loop in the background until Stop is called
+func (d *Dispatcher) Start() {
+	go func() {
+		defer close(d.done)
+		ticker := time.NewTicker(d.cfg.PollInterval)
+		defer ticker.Stop()
+		for {
+			if _, err := d.ProcessDue(); err != nil {
+				slog.Error("Failed to process due webhook deliveries", "component", "webhook", "error", err)
+			}
+			select {
+			case <-d.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the delivery loop to exit, aborts the request in flight and gives up leadership
+// The rest of the current batch is left due and is sent by whichever replica leads next.
+func (d *Dispatcher) Stop() {
+	d.once.Do(func() {
+		close(d.stop)
+		d.cancel()
+	})
+	<-d.done
+	if d.lock != nil {
+		if err := d.lock.Release(); err != nil {
+			slog.Error("Failed to release the leader lock", "component", "webhook", "error", err)
+		}
+	}
+}
+
+// ProcessDue attempts every delivery whose next attempt is due, if this replica is the leader
+// @return int - The number of deliveries attempted
+// @return error - An error if leadership or the due deliveries could not be checked
+func (d *Dispatcher) ProcessDue() (int, error) {
+	if d.lock != nil {
+		leading, err := d.lock.Acquire()
+		if err != nil || !leading {
+			return 0, err
+		}
+	}
+
+	deliveries, err := d.repo.FindDueDeliveries(d.now().UTC(), d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	// Subscriptions are cached per batch since most deliveries share a handful of endpoints
+	subs := make(map[int]*domain.WebhookSubscription)
+	attempted := 0
+	for _, delivery := range deliveries {
+		if d.ctx.Err() != nil {
+			break // Stopping; the remaining deliveries stay due
+		}
+		sub, ok := subs[delivery.SubscriptionID]
+		if !ok {
+			sub, err = d.repo.FindSubscriptionByID(delivery.SubscriptionID)
+			if err != nil {
+				slog.Error("Failed to load webhook subscription", "component", "webhook", "subscription_id", delivery.SubscriptionID, "error", err)
+				continue
+			}
+			subs[delivery.SubscriptionID] = sub
+		}
+
+		d.attempt(sub, delivery)
+		if d.ctx.Err() != nil {
+			break // The request was aborted by Stop and does not count as an attempt
+		}
+		attempted++
+		if err := d.repo.UpdateDelivery(delivery); err != nil {
+			slog.Error("Failed to save webhook delivery", "component", "webhook", "delivery_id", delivery.ID, "error", err)
+		}
+	}
+	return attempted, nil
+}
+
+// attempt sends a delivery once and records the outcome on it
+// @param sub *domain.WebhookSubscription - The subscription the delivery belongs to
+// @param delivery *domain.WebhookDelivery - The delivery to send, updated in place
+func (d *Dispatcher) attempt(sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery) {
+	now := d.now().UTC()
+	delivery.Attempts++
+	delivery.UpdatedAt = now
+
+	code, err := d.send(sub, delivery, now)
+	delivery.ResponseCode = code
+	if err == nil {
+		delivery.Status = domain.DeliverySucceeded
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.cfg.MaxAttempts {
+		delivery.Status = domain.DeliveryDead
+		slog.Warn("Webhook delivery moved to the dead-letter list", "component", "webhook",
+			"delivery_id", delivery.ID, "subscription_id", sub.ID, "attempts", delivery.Attempts, "error", err)
+		return
+	}
+	delivery.NextAttemptAt = now.Add(Backoff(delivery.Attempts, d.cfg.BaseBackoff, d.cfg.MaxBackoff))
+}
+
+// send performs the signed HTTP request for a delivery
+// @return int - The response status code, 0 if no response was received
+// @return error - An error if the request failed or the response was not 2xx
+func (d *Dispatcher) send(sub *domain.WebhookSubscription, delivery *domain.WebhookDelivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(d.ctx, http.MethodPost, sub.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := now.Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "interviews-service-webhooks/1.0")
+	req.Header.Set(HeaderEvent, string(delivery.EventType))
+	req.Header.Set(HeaderDelivery, strconv.Itoa(delivery.ID))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) // Drain so the connection can be reused
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign computes the signature header value for a payload
+// Receivers verify it by computing the same HMAC over "<timestamp>.<body>" and
+// comparing in constant time; the timestamp lets them reject replayed requests.
+// @param secret string - The subscription secret
+// @param timestamp int64 - The Unix time sent in the timestamp header
+// @param body []byte - The request body
+// @return string - The signature in the form "sha256=<hex>"
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns the delay before the next attempt
+// The delay doubles with every attempt, is capped at max, and is jittered by up to 20%
+// so that many failing deliveries do not retry in lockstep.
+// @param attempts int - The number of attempts made so far
+// @param base time.Duration - The delay after the first attempt
+// @param max time.Duration - The upper bound for the delay
+// @return time.Duration - The delay before the next attempt
+func Backoff(attempts int, base, max time.Duration) time.Duration {
+	delay := float64(base) * math.Pow(2, float64(attempts-1))
+	if delay > float64(max) {
+		delay = float64(max)
+	}
+	jitter := delay * 0.2 * mathrand.Float64()
+	return time.Duration(delay - jitter)
+}
+
+// GenerateSecret returns a random secret suitable for signing payloads
+// @return string - A 64 character hex string
+// @return error - An error if the system random source fails
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// newEventID returns a random identifier for an event
+func newEventID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,237 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/poolcamacho/interviews-service/internal/domain"
+	"github.com/poolcamacho/interviews-service/internal/leader"
+	"github.com/poolcamacho/interviews-service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublish_CreatesDeliveriesForMatchingSubscriptions(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockWebhookRepository)
+	dispatcher := newTestDispatcher(mockRepo, http.DefaultClient)
+
+	subs := []*domain.WebhookSubscription{
+		{ID: 1, URL: "https://ats.example.com/hooks", EventTypes: []domain.EventType{domain.EventInterviewCreated}},
+		{ID: 2, URL: "https://analytics.example.com/hooks", EventTypes: []domain.EventType{domain.EventInterviewCancelled}},
+	}
+
+	// Mock behavior
+	mockRepo.On("FindAllSubscriptions").Return(subs, nil)
+	var created *domain.WebhookDelivery
+	mockRepo.On("CreateDelivery", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*domain.WebhookDelivery)
+	}).Return(nil).Once()
+
+	// Execute
+	err := dispatcher.Publish(domain.Event{
+		Type:       domain.EventInterviewCreated,
+		Interview:  domain.Interview{ID: 7, CandidateID: 101, JobID: 201},
+		OccurredAt: testNow,
+	})
+
+	// Assertions
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	require.NotNil(t, created)
+	assert.Equal(t, 1, created.SubscriptionID)
+	assert.Equal(t, domain.DeliveryPending, created.Status)
+	assert.Equal(t, testNow, created.NextAttemptAt)
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(created.Payload, &payload))
+	assert.Equal(t, created.EventID, payload.ID)
+	assert.Equal(t, domain.EventInterviewCreated, payload.Type)
+	assert.Equal(t, 7, payload.Data.ID)
+}
+
+func TestProcessDue_SignsAndMarksSucceeded(t *testing.T) {
+	// Setup
+	var gotSignature, gotTimestamp, gotEvent string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(HeaderSignature)
+		gotTimestamp = r.Header.Get(HeaderTimestamp)
+		gotEvent = r.Header.Get(HeaderEvent)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	mockRepo := new(repository.MockWebhookRepository)
+	dispatcher := newTestDispatcher(mockRepo, server.Client())
+	delivery := mockDelivery()
+
+	// Mock behavior
+	mockRepo.On("FindDueDeliveries", testNow, 50).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockRepo.On("FindSubscriptionByID", 1).Return(&domain.WebhookSubscription{ID: 1, URL: server.URL, Secret: "s3cr3t"}, nil)
+	mockRepo.On("UpdateDelivery", delivery).Return(nil)
+
+	// Execute
+	processed, err := dispatcher.ProcessDue()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Equal(t, domain.DeliverySucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusNoContent, delivery.ResponseCode)
+	assert.Equal(t, string(domain.EventInterviewCreated), gotEvent)
+	assert.Equal(t, strconv.FormatInt(testNow.Unix(), 10), gotTimestamp)
+	assert.Equal(t, Sign("s3cr3t", testNow.Unix(), delivery.Payload), gotSignature)
+	assert.JSONEq(t, string(delivery.Payload), string(gotBody))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestProcessDue_FailureSchedulesRetry(t *testing.T) {
+	// Setup
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	mockRepo := new(repository.MockWebhookRepository)
+	dispatcher := newTestDispatcher(mockRepo, server.Client())
+	delivery := mockDelivery()
+	delivery.Attempts = 2
+
+	// Mock behavior
+	mockRepo.On("FindDueDeliveries", testNow, 50).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockRepo.On("FindSubscriptionByID", 1).Return(&domain.WebhookSubscription{ID: 1, URL: server.URL}, nil)
+	mockRepo.On("UpdateDelivery", delivery).Return(nil)
+
+	// Execute
+	_, err := dispatcher.ProcessDue()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, domain.DeliveryPending, delivery.Status)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, http.StatusServiceUnavailable, delivery.ResponseCode)
+	assert.Equal(t, "unexpected response status 503", delivery.LastError)
+	// Third attempt waits 4x the base backoff, minus up to 20% jitter
+	assert.WithinRange(t, delivery.NextAttemptAt, testNow.Add(96*time.Second), testNow.Add(120*time.Second))
+}
+
+func TestProcessDue_MovesToDeadLetterAfterMaxAttempts(t *testing.T) {
+	// Setup
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	mockRepo := new(repository.MockWebhookRepository)
+	dispatcher := newTestDispatcher(mockRepo, server.Client())
+	delivery := mockDelivery()
+	delivery.Attempts = DefaultConfig().MaxAttempts - 1
+
+	// Mock behavior
+	mockRepo.On("FindDueDeliveries", testNow, 50).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockRepo.On("FindSubscriptionByID", 1).Return(&domain.WebhookSubscription{ID: 1, URL: server.URL}, nil)
+	mockRepo.On("UpdateDelivery", delivery).Return(nil)
+
+	// Execute
+	_, err := dispatcher.ProcessDue()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Equal(t, domain.DeliveryDead, delivery.Status)
+	assert.Equal(t, DefaultConfig().MaxAttempts, delivery.Attempts)
+}
+
+func TestProcessDue_SkipsWithoutLeadership(t *testing.T) {
+	// Setup
+	mockRepo := new(repository.MockWebhookRepository)
+	mockLock := new(leader.MockLock)
+	dispatcher := NewDispatcher(mockRepo, http.DefaultClient, mockLock, DefaultConfig())
+
+	// Mock behavior
+	mockLock.On("Acquire").Return(false, nil)
+
+	// Execute
+	attempted, err := dispatcher.ProcessDue()
+
+	// Assertions
+	assert.NoError(t, err)
+	assert.Zero(t, attempted)
+	mockRepo.AssertNotCalled(t, "FindDueDeliveries", mock.Anything, mock.Anything)
+}
+
+func TestStop_AbortsDeliveryInFlight(t *testing.T) {
+	// Setup
+	started, release := make(chan struct{}, 2), make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release // An endpoint that does not answer until the test ends
+	}))
+	defer server.Close()
+	defer close(release)
+	mockRepo := new(repository.MockWebhookRepository)
+	dispatcher := newTestDispatcher(mockRepo, server.Client())
+	first, second := mockDelivery(), mockDelivery()
+	second.ID = 11
+
+	// Mock behavior
+	mockRepo.On("FindDueDeliveries", testNow, 50).Return([]*domain.WebhookDelivery{first, second}, nil)
+	mockRepo.On("FindSubscriptionByID", 1).Return(&domain.WebhookSubscription{ID: 1, URL: server.URL, Secret: "s3cret"}, nil)
+
+	// Execute
+	dispatcher.Start()
+	<-started
+	stopped := make(chan struct{})
+	go func() {
+		dispatcher.Stop()
+		close(stopped)
+	}()
+
+	// Assertions
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop waited for the endpoint to answer")
+	}
+	assert.Len(t, started, 0) // The second delivery was not sent
+	mockRepo.AssertNotCalled(t, "UpdateDelivery", mock.Anything)
+}
+
+func TestBackoff_IsCapped(t *testing.T) {
+	// Execute
+	delay := Backoff(30, time.Second, time.Minute)
+
+	// Assertions
+	assert.LessOrEqual(t, delay, time.Minute)
+	assert.GreaterOrEqual(t, delay, 48*time.Second)
+}
+
+var testNow = time.Date(2024, time.December, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestDispatcher provides a dispatcher with a fixed clock for testing
+func newTestDispatcher(repo repository.WebhookRepository, client *http.Client) *Dispatcher {
+	d := NewDispatcher(repo, client, nil, DefaultConfig())
+	d.now = func() time.Time { return testNow }
+	return d
+}
+
+// mockDelivery provides a pending delivery for testing
+func mockDelivery() *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             10,
+		SubscriptionID: 1,
+		EventID:        "abc123",
+		EventType:      domain.EventInterviewCreated,
+		Payload:        json.RawMessage(`{"id":"abc123","type":"interview.created"}`),
+		Status:         domain.DeliveryPending,
+		NextAttemptAt:  testNow,
+	}
+}
@@ -0,0 +1,126 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrOpen is returned when a call is rejected because the circuit is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State represents the state of a circuit breaker
+type State int
+
+const (
+	Closed   State = iota // Calls go through and failures are counted
+	Open                  // Calls are rejected until the open timeout elapses
+	HalfOpen              // A single trial call is allowed to probe the dependency
+)
+
+// Breaker is a consecutive-failure circuit breaker
+// @Description Stops calling a failing dependency after a number of consecutive failures,
+// rejecting calls with ErrOpen for a cool-down period before letting a trial call through.
+// A successful trial closes the circuit again; a failed one re-opens it.
+type Breaker struct {
+	mu          sync.Mutex
+	state       State
+	failures    int              // Consecutive failures while closed
+	openedAt    time.Time        // Time at which the circuit last opened
+	trial       bool             // Whether a half-open trial call is in flight
+	threshold   int              // Consecutive failures that open the circuit
+	openTimeout time.Duration    // Cool-down before a trial call is allowed
+	now         func() time.Time // Clock, replaced in tests
+}
+
+// New creates a new circuit breaker
+// @Description Creates a closed circuit breaker.
+// @Param threshold int The number of consecutive failures that open the circuit.
+// @Param openTimeout time.Duration The time the circuit stays open before a trial call is allowed.
+// @Return *Breaker A pointer to the circuit breaker.
+func New(threshold int, openTimeout time.Duration) *Breaker {
+	if threshold < 1 {
+		threshold = 1
+	}
+	return &Breaker{threshold: threshold, openTimeout: openTimeout, now: time.Now}
+}
+
+// Execute runs fn if the circuit allows it and records the outcome
+// @Description Runs the given function unless the circuit is open. Errors for which
+// isFailure returns false (e.g. a 404 from a healthy service) do not count as failures.
+// @Param fn func() error The call to protect.
+// @Param isFailure func(error) bool Reports whether an error returned by fn means the dependency is unhealthy; nil treats every error as a failure.
+// @Return error ErrOpen if the call was rejected, otherwise the error returned by fn.
+func (b *Breaker) Execute(fn func() error, isFailure func(error) bool) error {
+	if !b.allow() {
+		return ErrOpen
+	}
+
+	err := fn()
+	failed := err != nil && (isFailure == nil || isFailure(err))
+	b.record(failed)
+	return err
+}
+
+// State returns the current state of the circuit
+// @Description Returns the state, moving an open circuit to half-open once the open timeout has elapsed.
+// @Return State The current state.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == Open && b.now().Sub(b.openedAt) >= b.openTimeout {
+		return HalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a call may proceed, starting a trial call when the cool-down is over
+func (b *Breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case Open:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.state = HalfOpen
+		b.trial = true
+		return true
+	case HalfOpen:
+		if b.trial {
+			return false // Only one trial call at a time
+		}
+		b.trial = true
+		return true
+	}
+	return true
+}
+
+// record updates the state with the outcome of a call
+func (b *Breaker) record(failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == HalfOpen {
+		b.trial = false
+		if failed {
+			b.state = Open
+			b.openedAt = b.now()
+			return
+		}
+		b.state = Closed
+		b.failures = 0
+		return
+	}
+
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.state = Open
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,49 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker_OpensAndRecovers(t *testing.T) {
+	// Setup
+	now := time.Date(2024, time.December, 30, 14, 0, 0, 0, time.UTC)
+	breaker := New(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+	failing := func() error { return errors.New("boom") }
+	succeeding := func() error { return nil }
+
+	// Execute and assert: consecutive failures open the circuit
+	assert.Error(t, breaker.Execute(failing, nil))
+	assert.Equal(t, Closed, breaker.State())
+	assert.Error(t, breaker.Execute(failing, nil))
+	assert.Equal(t, Open, breaker.State())
+	assert.ErrorIs(t, breaker.Execute(succeeding, nil), ErrOpen)
+
+	// A failed trial re-opens the circuit
+	now = now.Add(time.Minute)
+	assert.Equal(t, HalfOpen, breaker.State())
+	assert.Error(t, breaker.Execute(failing, nil))
+	assert.Equal(t, Open, breaker.State())
+
+	// A successful trial closes it
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.Execute(succeeding, nil))
+	assert.Equal(t, Closed, breaker.State())
+}
+
+func TestBreaker_IgnoresNonFailures(t *testing.T) {
+	// Setup
+	breaker := New(1, time.Minute)
+	notFound := errors.New("not found")
+
+	// Execute
+	err := breaker.Execute(func() error { return notFound }, func(err error) bool { return !errors.Is(err, notFound) })
+
+	// Assertions
+	assert.ErrorIs(t, err, notFound)
+	assert.Equal(t, Closed, breaker.State())
+}
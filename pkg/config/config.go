@@ -1,39 +1,437 @@
 package config
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
 	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/poolcamacho/interviews-service/pkg/logger"
+	"gopkg.in/yaml.v3"
+)
+
+// Environments accepted in APP_ENV
+const (
+	Development = "development" // Local development, secrets only need to be present
+	Production  = "production"  // Deployed service, secrets must not be defaults or placeholders
 )
 
+// redacted replaces secrets and passwords in the output of Redacted
+const redacted = "xxxxx"
+
+// minSecretLength is the shortest JWT_SECRET_KEY accepted in production, 256 bits of hex or base64
+const minSecretLength = 32
+
 // Config holds application configuration values
-// @Description Contains all configuration values required by the application,
-// such as database connection details, JWT secret key, and server port.
+// @Description Contains all configuration values required by the application, such as database connection details,
+// JWT secret key, and server port. Each field is read from the YAML key and the environment variable in its tags;
+// fields tagged config:"secret" are redacted when printed and cannot be set by flag, and fields tagged
+// config:"reload" are applied without a restart when the configuration is reloaded.
 type Config struct {
-	DatabaseURL  string // URL for the database connection
-	JWTSecretKey string // Secret key used for JWT token generation
-	Port         string // Port on which the server will run
+	Environment  string `yaml:"app_env" env:"APP_ENV"`                               // "development" or "production"; production refuses missing or default secrets
+	Storage      string `yaml:"storage" env:"STORAGE"`                               // Where interviews are kept, "database" for DATABASE_URL or "memory" for local development without a database
+	DatabaseURL  string `yaml:"database_url" env:"DATABASE_URL" config:"secret"`     // URL for the database connection
+	QueryTimeout string `yaml:"db_query_timeout" env:"DB_QUERY_TIMEOUT"`             // Maximum duration of a database call, e.g. "5s"; "0" to only rely on request cancellation
+	AutoMigrate  string `yaml:"db_auto_migrate" env:"DB_AUTO_MIGRATE"`               // "true" to apply pending schema migrations on startup
+	JWTSecretKey string `yaml:"jwt_secret_key" env:"JWT_SECRET_KEY" config:"secret"` // Secret key used for JWT token generation
+	Port         string `yaml:"port" env:"PORT"`                                     // Port on which the server will run
+	GRPCPort     string `yaml:"grpc_port" env:"GRPC_PORT"`                           // Port on which the gRPC server will run, empty to disable it
+	SMTPHost     string `yaml:"smtp_host" env:"SMTP_HOST"`                           // Host of the SMTP server used for notifications, empty to disable email
+	SMTPPort     string `yaml:"smtp_port" env:"SMTP_PORT"`                           // Port of the SMTP server
+	SMTPUsername string `yaml:"smtp_username" env:"SMTP_USERNAME"`                   // Username for SMTP authentication, empty to disable authentication
+	SMTPPassword string `yaml:"smtp_password" env:"SMTP_PASSWORD" config:"secret"`   // Password for SMTP authentication
+	MailFrom     string `yaml:"mail_from" env:"MAIL_FROM"`                           // Sender address of notification emails
+
+	HTTPReadTimeout     string `yaml:"http_read_timeout" env:"HTTP_READ_TIMEOUT"`         // Maximum duration for reading an HTTP request, body included
+	HTTPWriteTimeout    string `yaml:"http_write_timeout" env:"HTTP_WRITE_TIMEOUT"`       // Maximum duration of an HTTP request from the end of its headers to the end of the response
+	HTTPIdleTimeout     string `yaml:"http_idle_timeout" env:"HTTP_IDLE_TIMEOUT"`         // How long an idle keep-alive connection is kept open
+	ShutdownGracePeriod string `yaml:"shutdown_grace_period" env:"SHUTDOWN_GRACE_PERIOD"` // How long after SIGTERM requests are drained and workers stopped before the process exits anyway
+	ShutdownDelay       string `yaml:"shutdown_delay" env:"SHUTDOWN_DELAY"`               // Part of the grace period spent failing readiness before connections are drained
+
+	CandidatesServiceURL string `yaml:"candidates_service_url" env:"CANDIDATES_SERVICE_URL"` // Base URL of the candidates service, empty to skip candidate validation
+	JobsServiceURL       string `yaml:"jobs_service_url" env:"JOBS_SERVICE_URL"`             // Base URL of the jobs service, empty to skip job validation
+	ServiceToken         string `yaml:"service_token" env:"SERVICE_TOKEN" config:"secret"`   // Bearer token sent to the candidates and jobs services
+
+	LogLevel string `yaml:"log_level" env:"LOG_LEVEL" config:"reload"` // Lowest level logged: "debug", "info", "warn" or "error"
+
+	TracesExporter    string `yaml:"traces_exporter" env:"OTEL_TRACES_EXPORTER"`        // Where spans are sent: "none", "otlp" or "stdout" for local debugging
+	OTLPEndpoint      string `yaml:"otlp_endpoint" env:"OTEL_EXPORTER_OTLP_ENDPOINT"`   // Base URL of the OTLP/HTTP collector, e.g. http://otel-collector:4318; empty for http://localhost:4318
+	TracesSampleRatio string `yaml:"traces_sample_ratio" env:"OTEL_TRACES_SAMPLER_ARG"` // Fraction of new traces recorded, from 0 to 1; requests carrying a traceparent follow the caller's decision
+
+	ReminderOffsets string `yaml:"reminder_offsets" env:"REMINDER_OFFSETS" config:"reload"` // Comma-separated durations before an interview at which reminders are sent, empty to disable
+
+	FeedbackSLA          string `yaml:"feedback_sla" env:"FEEDBACK_SLA" config:"reload"`                     // Feedback deadlines in business hours, e.g. "8h,onsite=16h"
+	FeedbackEscalation   string `yaml:"feedback_escalation" env:"FEEDBACK_ESCALATION" config:"reload"`       // Business hours past the deadline before the hiring manager is nudged
+	FeedbackWorkingHours string `yaml:"feedback_working_hours" env:"FEEDBACK_WORKING_HOURS" config:"reload"` // Working hours of Monday to Friday counted as business hours, e.g. "09:00-17:00"
+	FeedbackTimezone     string `yaml:"feedback_timezone" env:"FEEDBACK_TIMEZONE" config:"reload"`           // IANA time zone of the working hours, e.g. "Europe/Madrid"
+
+	LegacyAPIDeprecatedAt string `yaml:"legacy_api_deprecated_at" env:"LEGACY_API_DEPRECATED_AT" config:"reload"` // Date (YYYY-MM-DD) the unversioned routes were deprecated
+	LegacyAPISunset       string `yaml:"legacy_api_sunset" env:"LEGACY_API_SUNSET" config:"reload"`               // Date (YYYY-MM-DD) after which the unversioned routes answer 410 Gone
 }
 
-// Load reads configuration from environment variables
-// @Description Loads configuration values from environment variables.
-// If an environment variable is not set, it uses a default fallback value.
-// @Return *Config A pointer to the loaded Config structure.
-func Load() *Config {
+// knownSecrets holds the SHA-256 hashes of secrets that were published with the source code
+// Production refuses them even though they are no longer defaults, since anyone with the repository has them.
+var knownSecrets = map[string]bool{
+	"8bc45af45d7f2bf4b9411a735e79c7c7c7412cfab9d48860cf9e16432e5219b5": true, // Former JWT_SECRET_KEY default
+	"43d95e3caf6c01ad185f269554f6a7a2b62d56d1e99bc0703f6cbb61ad5c64df": true, // Password of the former DATABASE_URL default
+}
+
+// placeholderSecrets are example values from the documentation that production refuses
+var placeholderSecrets = map[string]bool{
+	"changeme":              true,
+	"secret":                true,
+	"password":              true,
+	"tu-secreto-jwt":        true,
+	"token-jwt-de-servicio": true,
+}
+
+// Defaults returns the configuration used for settings that no source sets
+// @Description There are deliberately no defaults for DATABASE_URL and JWT_SECRET_KEY, they must always be configured.
+// @Return *Config A pointer to the default Config structure.
+func Defaults() *Config {
 	return &Config{
-		DatabaseURL:  getEnv("DATABASE_URL", "admin_db:dadgic-qafkuh-Hipto0@tcp(talent-management-db.cne4yyyawn11.us-east-1.rds.amazonaws.com:3306)/talent_management_db"),
-		JWTSecretKey: getEnv("JWT_SECRET_KEY", "d18aa05bbce170dc073b548f721170fee6e8085e8f10b10548854a489b93afb8"),
-		Port:         getEnv("PORT", "3000"),
+		Environment:  Development,
+		Storage:      "database",
+		QueryTimeout: "5s",
+		AutoMigrate:  "false",
+		Port:         "3000",
+		GRPCPort:     "9090",
+		SMTPPort:     "25",
+		MailFrom:     "no-reply@interviews-service.local",
+
+		HTTPReadTimeout:     "15s",
+		HTTPWriteTimeout:    "30s",
+		HTTPIdleTimeout:     "120s",
+		ShutdownGracePeriod: "30s",
+		ShutdownDelay:       "0s",
+
+		LogLevel: "info",
+
+		TracesExporter:    "none",
+		TracesSampleRatio: "1",
+
+		ReminderOffsets: "24h,1h",
+
+		FeedbackSLA:          "8h",
+		FeedbackEscalation:   "8h",
+		FeedbackWorkingHours: "09:00-17:00",
+		FeedbackTimezone:     "UTC",
+
+		LegacyAPIDeprecatedAt: "2026-10-19",
+		LegacyAPISunset:       "2027-04-30",
+	}
+}
+
+// Flags holds the settings given on the command line of a command
+// @Description Created by RegisterFlags and read by Load once the flag set has been parsed.
+type Flags struct {
+	File   string            // Path of the YAML file given with -config, empty to use CONFIG_FILE
+	values map[string]string // Settings given with their own flag, keyed by environment variable name
+}
+
+// RegisterFlags adds -config and one flag per setting to a flag set
+// @Description Flags are named after the YAML key with dashes, e.g. -database-url, and override every other source.
+// Secrets have no flag since command lines are visible to other processes; use the environment, a _FILE variable
+// or the YAML file instead.
+// @Param flags *flag.FlagSet The flag set of the command.
+// @Return *Flags The settings given on the command line once flags has been parsed.
+func RegisterFlags(flags *flag.FlagSet) *Flags {
+	f := &Flags{values: map[string]string{}}
+	flags.StringVar(&f.File, "config", "", "YAML configuration file, overrides CONFIG_FILE")
+	forEachSetting(&Config{}, func(field reflect.StructField, _ reflect.Value) {
+		if isSecret(field) {
+			return
+		}
+		env := field.Tag.Get("env")
+		name := strings.ReplaceAll(field.Tag.Get("yaml"), "_", "-")
+		flags.Func(name, "overrides "+env, func(value string) error {
+			f.values[env] = value
+			return nil
+		})
+	})
+	return f
+}
+
+// Load reads the configuration from its sources
+// @Description Starts from Defaults, then applies the YAML file named by the -config flag or CONFIG_FILE, then
+// environment variables, then flags. Every variable can instead be read from a file named by the variable with a
+// _FILE suffix, e.g. JWT_SECRET_KEY_FILE, as Docker and Kubernetes mount secrets; setting both is an error. The
+// result is not validated, see Validate.
+// @Param flags *Flags The settings given on the command line, nil when the command has none.
+// @Return *Config A pointer to the loaded Config structure.
+// @Return error An error if the YAML file or a _FILE variable cannot be read.
+func Load(flags *Flags) (*Config, error) {
+	cfg := Defaults()
+
+	file := os.Getenv("CONFIG_FILE")
+	if flags != nil && flags.File != "" {
+		file = flags.File
+	}
+	if file != "" {
+		if err := cfg.readFile(file); err != nil {
+			return nil, err
+		}
+	}
+
+	var errs []error
+	forEachSetting(cfg, func(field reflect.StructField, value reflect.Value) {
+		env := field.Tag.Get("env")
+		if v, ok, err := lookupEnv(env); err != nil {
+			errs = append(errs, err)
+		} else if ok {
+			value.SetString(v)
+		}
+		if flags != nil {
+			if v, ok := flags.values[env]; ok {
+				value.SetString(v)
+			}
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// readFile applies the settings of a YAML file, keys that the file does not set keep their value
+func (c *Config) readFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && err != io.EOF {
+		return fmt.Errorf("invalid configuration file %s: %w", path, err)
+	}
+	return nil
+}
+
+// lookupEnv reads an environment variable or the file named by its _FILE variant
+// A single trailing newline is removed from the file, as editors and echo add one.
+func lookupEnv(key string) (string, bool, error) {
+	value, ok := os.LookupEnv(key)
+	path, fromFile := os.LookupEnv(key + "_FILE")
+	switch {
+	case ok && fromFile:
+		return "", false, fmt.Errorf("%s and %s_FILE are both set, use only one", key, key)
+	case fromFile:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to read %s_FILE: %w", key, err)
+		}
+		return strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r"), true, nil
 	}
+	return value, ok, nil
 }
 
-// getEnv retrieves the value of the environment variable named by the key
-// @Description Retrieves the value of an environment variable. If the variable
-// is not set, it returns a default fallback value.
-// @Param key string The name of the environment variable to retrieve.
-// @Param fallback string The default value to return if the variable is not set.
-// @Return string The value of the environment variable or the fallback value.
-func getEnv(key, fallback string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
+// Validate checks that the configuration can be used to run the service
+// @Description Checks that every setting parses and that required settings are present. In production it also
+// refuses secrets that are shorter than 32 characters, documentation placeholders, or values that were once
+// published as defaults.
+// @Return error An error listing every invalid setting, nil when the configuration is valid.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
 	}
-	return fallback
+
+	if c.Environment != Development && c.Environment != Production {
+		invalid("APP_ENV must be %s or %s, got %q", Development, Production, c.Environment)
+	}
+	switch c.Storage {
+	case "database":
+		if c.DatabaseURL == "" {
+			invalid("DATABASE_URL is required when STORAGE is database")
+		}
+	case "memory":
+	default:
+		invalid("STORAGE must be database or memory, got %q", c.Storage)
+	}
+	if d, err := time.ParseDuration(c.QueryTimeout); err != nil || d < 0 {
+		invalid("DB_QUERY_TIMEOUT must be a duration such as 5s, got %q", c.QueryTimeout)
+	}
+	if _, err := strconv.ParseBool(c.AutoMigrate); err != nil {
+		invalid("DB_AUTO_MIGRATE must be true or false, got %q", c.AutoMigrate)
+	}
+	if c.JWTSecretKey == "" {
+		invalid("JWT_SECRET_KEY is required")
+	}
+	for env, port := range map[string]string{"PORT": c.Port, "GRPC_PORT": c.GRPCPort, "SMTP_PORT": c.SMTPPort} {
+		if n, err := strconv.Atoi(port); (port != "" || env == "PORT") && (err != nil || n < 0 || n > 65535) {
+			invalid("%s must be a port number, got %q", env, port)
+		}
+	}
+	if _, err := time.ParseDuration(c.FeedbackEscalation); err != nil {
+		invalid("FEEDBACK_ESCALATION must be a duration such as 24h, got %q", c.FeedbackEscalation)
+	}
+	timeouts := map[string]string{"HTTP_READ_TIMEOUT": c.HTTPReadTimeout, "HTTP_WRITE_TIMEOUT": c.HTTPWriteTimeout,
+		"HTTP_IDLE_TIMEOUT": c.HTTPIdleTimeout, "SHUTDOWN_GRACE_PERIOD": c.ShutdownGracePeriod, "SHUTDOWN_DELAY": c.ShutdownDelay}
+	for env, timeout := range timeouts {
+		if d, err := time.ParseDuration(timeout); err != nil || d < 0 {
+			invalid("%s must be a duration such as 30s, got %q", env, timeout)
+		}
+	}
+	grace, graceErr := time.ParseDuration(c.ShutdownGracePeriod)
+	delay, delayErr := time.ParseDuration(c.ShutdownDelay)
+	if graceErr == nil && delayErr == nil && delay >= grace {
+		invalid("SHUTDOWN_DELAY must be shorter than SHUTDOWN_GRACE_PERIOD to leave time to drain")
+	}
+	if _, err := logger.ParseLevel(c.LogLevel); err != nil {
+		invalid("LOG_LEVEL must be debug, info, warn or error, got %q", c.LogLevel)
+	}
+	switch c.TracesExporter {
+	case "none", "otlp", "stdout":
+	default:
+		invalid("OTEL_TRACES_EXPORTER must be none, otlp or stdout, got %q", c.TracesExporter)
+	}
+	if ratio, err := strconv.ParseFloat(c.TracesSampleRatio, 64); err != nil || ratio < 0 || ratio > 1 {
+		invalid("OTEL_TRACES_SAMPLER_ARG must be a ratio between 0 and 1, got %q", c.TracesSampleRatio)
+	}
+	for env, date := range map[string]string{"LEGACY_API_DEPRECATED_AT": c.LegacyAPIDeprecatedAt, "LEGACY_API_SUNSET": c.LegacyAPISunset} {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			invalid("%s must be a date such as 2027-04-30, got %q", env, date)
+		}
+	}
+
+	if c.Environment == Production {
+		if c.JWTSecretKey != "" && (len(c.JWTSecretKey) < minSecretLength || isDefaultSecret(c.JWTSecretKey)) {
+			invalid("JWT_SECRET_KEY must be a random value of at least %d characters in production", minSecretLength)
+		}
+		if password := dsnPassword(c.DatabaseURL); password != "" && isDefaultSecret(password) {
+			invalid("DATABASE_URL uses a password published with the source code or a placeholder, rotate it")
+		}
+		if isDefaultSecret(c.ServiceToken) {
+			invalid("SERVICE_TOKEN is a placeholder")
+		}
+		if c.SMTPUsername != "" && (c.SMTPPassword == "" || isDefaultSecret(c.SMTPPassword)) {
+			invalid("SMTP_PASSWORD is required in production when SMTP_USERNAME is set")
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// isDefaultSecret reports whether a secret is a documentation placeholder or was published as a default
+func isDefaultSecret(value string) bool {
+	if placeholderSecrets[strings.ToLower(value)] {
+		return true
+	}
+	sum := sha256.Sum256([]byte(value))
+	return knownSecrets[hex.EncodeToString(sum[:])]
+}
+
+// Redacted returns a copy of the configuration that is safe to print
+// @Description Secrets are replaced with xxxxx, except that only the password of DATABASE_URL is replaced so the
+// host and database name stay visible. Secrets that are not set stay empty.
+// @Return *Config A pointer to the redacted copy.
+func (c *Config) Redacted() *Config {
+	copied := *c
+	forEachSetting(&copied, func(field reflect.StructField, value reflect.Value) {
+		if isSecret(field) && value.String() != "" {
+			value.SetString(redacted)
+		}
+	})
+	copied.DatabaseURL = redactDSN(c.DatabaseURL)
+	return &copied
+}
+
+// redactDSN replaces the password of a database URL or MySQL DSN
+// A MySQL DSN such as user:password@tcp(host) also parses as a URL with the scheme "user", so only DSNs with
+// "://" are treated as URLs. DSNs that cannot be parsed are replaced entirely, since where their password is cannot be told.
+func redactDSN(dsn string) string {
+	if dsn == "" {
+		return ""
+	}
+	if u, err := url.Parse(dsn); err == nil && strings.Contains(dsn, "://") {
+		return u.Redacted()
+	}
+	if m, err := mysql.ParseDSN(dsn); err == nil {
+		if m.Passwd != "" {
+			m.Passwd = redacted
+		}
+		return m.FormatDSN()
+	}
+	return redacted
+}
+
+// dsnPassword returns the password of a database URL or MySQL DSN, empty when it has none or cannot be parsed
+func dsnPassword(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && strings.Contains(dsn, "://") {
+		password, _ := u.User.Password()
+		return password
+	}
+	if m, err := mysql.ParseDSN(dsn); err == nil {
+		return m.Passwd
+	}
+	return ""
+}
+
+// Diff lists the settings whose values differ between two configurations
+// @Param other *Config The configuration to compare with.
+// @Return []string The environment variable names of the settings that differ, in declaration order.
+func (c *Config) Diff(other *Config) []string {
+	var changed []string
+	theirs := reflect.ValueOf(other).Elem()
+	forEachSetting(c, func(field reflect.StructField, value reflect.Value) {
+		if value.String() != theirs.FieldByIndex(field.Index).String() {
+			changed = append(changed, field.Tag.Get("env"))
+		}
+	})
+	return changed
+}
+
+// Reload loads and validates the configuration again, keeping the settings that need a restart
+// @Description Used on SIGHUP: the YAML file and _FILE variables are read again, while the environment and flags
+// of a running process do not change. Only settings tagged config:"reload" take their new value; the others keep
+// the value of c and are listed so the caller can report that a restart is needed to apply them.
+// @Param flags *Flags The settings given on the command line at startup, nil when there were none.
+// @Return *Config The configuration to apply.
+// @Return []string The environment variable names of changed settings that need a restart.
+// @Return error An error if the new configuration cannot be read or is invalid; c stays in effect.
+func (c *Config) Reload(flags *Flags) (*Config, []string, error) {
+	loaded, err := Load(flags)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := loaded.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var restart []string
+	next := *c
+	current := reflect.ValueOf(&next).Elem()
+	forEachSetting(loaded, func(field reflect.StructField, value reflect.Value) {
+		if value.String() == current.FieldByIndex(field.Index).String() {
+			return
+		}
+		if field.Tag.Get("config") == "reload" {
+			current.FieldByIndex(field.Index).SetString(value.String())
+		} else {
+			restart = append(restart, field.Tag.Get("env"))
+		}
+	})
+	return &next, restart, nil
+}
+
+// forEachSetting calls fn with every field of a configuration and its settable value
+func forEachSetting(cfg *Config, fn func(field reflect.StructField, value reflect.Value)) {
+	v := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		fn(v.Type().Field(i), v.Field(i))
+	}
+}
+
+// isSecret reports whether a field holds a secret
+func isSecret(field reflect.StructField) bool {
+	return field.Tag.Get("config") == "secret"
 }
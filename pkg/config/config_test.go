@@ -0,0 +1,324 @@
+package config
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// productionSecret is long and random enough for production
+const productionSecret = "0f8b6c1d2e3a4b5c6d7e8f9a0b1c2d3e4f5a6b7c8d9e0f1a"
+
+func TestLoad_Layers(t *testing.T) {
+	// Setup
+	clearEnv(t)
+	file := writeFile(t, "config.yaml", `port: 4000
+grpc_port: 9191
+database_url: admin:secret@tcp(db:3306)/interviews
+mail_from: file@example.com
+`)
+	t.Setenv("CONFIG_FILE", file)
+	t.Setenv("GRPC_PORT", "9292")
+	t.Setenv("MAIL_FROM", "env@example.com")
+	t.Setenv("JWT_SECRET_KEY_FILE", writeFile(t, "jwt", "from-secret-file\n"))
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	settings := RegisterFlags(flags)
+	require.NoError(t, flags.Parse([]string{"-mail-from", "flag@example.com"}))
+
+	// Execute
+	cfg, err := Load(settings)
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, "4000", cfg.Port)                     // File over default
+	assert.Equal(t, "9292", cfg.GRPCPort)                 // Environment over file
+	assert.Equal(t, "flag@example.com", cfg.MailFrom)     // Flag over environment
+	assert.Equal(t, "from-secret-file", cfg.JWTSecretKey) // _FILE variant without its newline
+	assert.Equal(t, "admin:secret@tcp(db:3306)/interviews", cfg.DatabaseURL)
+	assert.Equal(t, "24h,1h", cfg.ReminderOffsets) // Default
+	assert.Equal(t, Development, cfg.Environment)
+}
+
+func TestLoad_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T)
+		err   string
+	}{
+		{
+			name: "unknown key in file",
+			setup: func(t *testing.T) {
+				t.Setenv("CONFIG_FILE", writeFile(t, "config.yaml", "prot: 4000\n"))
+			},
+			err: "field prot not found",
+		},
+		{
+			name: "missing file",
+			setup: func(t *testing.T) {
+				t.Setenv("CONFIG_FILE", filepath.Join(t.TempDir(), "missing.yaml"))
+			},
+			err: "failed to read configuration file",
+		},
+		{
+			name: "variable and its file",
+			setup: func(t *testing.T) {
+				t.Setenv("JWT_SECRET_KEY", "secret")
+				t.Setenv("JWT_SECRET_KEY_FILE", writeFile(t, "jwt", "secret"))
+			},
+			err: "JWT_SECRET_KEY and JWT_SECRET_KEY_FILE are both set, use only one",
+		},
+		{
+			name: "unreadable secret file",
+			setup: func(t *testing.T) {
+				t.Setenv("SMTP_PASSWORD_FILE", filepath.Join(t.TempDir(), "missing"))
+			},
+			err: "failed to read SMTP_PASSWORD_FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			clearEnv(t)
+			tt.setup(t)
+
+			// Execute
+			_, err := Load(nil)
+
+			// Assertions
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestRegisterFlags_SkipsSecrets(t *testing.T) {
+	// Setup
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+
+	// Execute
+	RegisterFlags(flags)
+
+	// Assertions
+	assert.NotNil(t, flags.Lookup("config"))
+	assert.NotNil(t, flags.Lookup("db-query-timeout"))
+	assert.NotNil(t, flags.Lookup("legacy-api-sunset"))
+	assert.Nil(t, flags.Lookup("jwt-secret-key"))
+	assert.Nil(t, flags.Lookup("database-url"))
+	assert.Nil(t, flags.Lookup("smtp-password"))
+	assert.Nil(t, flags.Lookup("service-token"))
+}
+
+func TestValidate(t *testing.T) {
+	// Setup
+	publish(t, "published-jwt-secret-of-more-than-32-characters")
+	publish(t, "published-db-password")
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		errs   []string
+	}{
+		{name: "valid development", modify: func(c *Config) {}},
+		{name: "valid production", modify: func(c *Config) { c.Environment = Production }},
+		{name: "memory without database", modify: func(c *Config) { c.Storage, c.DatabaseURL = "memory", "" }},
+		{
+			name:   "missing secrets",
+			modify: func(c *Config) { c.DatabaseURL, c.JWTSecretKey = "", "" },
+			errs:   []string{"DATABASE_URL is required when STORAGE is database", "JWT_SECRET_KEY is required"},
+		},
+		{
+			name: "unparsable settings",
+			modify: func(c *Config) {
+				c.Environment, c.Storage, c.QueryTimeout, c.AutoMigrate = "staging", "disk", "5", "yes"
+				c.Port, c.GRPCPort, c.FeedbackEscalation, c.LegacyAPISunset = "", "grpc", "1d", "30/04/2027"
+				c.LogLevel, c.TracesExporter, c.TracesSampleRatio = "verbose", "jaeger", "10%"
+			},
+			errs: []string{
+				`APP_ENV must be development or production, got "staging"`,
+				`STORAGE must be database or memory, got "disk"`,
+				`DB_QUERY_TIMEOUT must be a duration such as 5s, got "5"`,
+				`DB_AUTO_MIGRATE must be true or false, got "yes"`,
+				`PORT must be a port number, got ""`,
+				`GRPC_PORT must be a port number, got "grpc"`,
+				`FEEDBACK_ESCALATION must be a duration such as 24h, got "1d"`,
+				`LEGACY_API_SUNSET must be a date such as 2027-04-30, got "30/04/2027"`,
+				`LOG_LEVEL must be debug, info, warn or error, got "verbose"`,
+				`OTEL_TRACES_EXPORTER must be none, otlp or stdout, got "jaeger"`,
+				`OTEL_TRACES_SAMPLER_ARG must be a ratio between 0 and 1, got "10%"`,
+			},
+		},
+		{
+			name: "shutdown timing",
+			modify: func(c *Config) {
+				c.HTTPWriteTimeout, c.ShutdownGracePeriod, c.ShutdownDelay = "-1s", "10s", "10s"
+			},
+			errs: []string{
+				`HTTP_WRITE_TIMEOUT must be a duration such as 30s, got "-1s"`,
+				"SHUTDOWN_DELAY must be shorter than SHUTDOWN_GRACE_PERIOD to leave time to drain",
+			},
+		},
+		{
+			name:   "placeholders allowed in development",
+			modify: func(c *Config) { c.JWTSecretKey, c.ServiceToken = "tu-secreto-jwt", "token-jwt-de-servicio" },
+		},
+		{
+			name: "placeholders refused in production",
+			modify: func(c *Config) {
+				c.Environment, c.JWTSecretKey, c.ServiceToken = Production, "tu-secreto-jwt", "token-jwt-de-servicio"
+				c.DatabaseURL = "postgres://admin:changeme@db:5432/interviews"
+			},
+			errs: []string{
+				"JWT_SECRET_KEY must be a random value of at least 32 characters in production",
+				"DATABASE_URL uses a password published with the source code or a placeholder, rotate it",
+				"SERVICE_TOKEN is a placeholder",
+			},
+		},
+		{
+			name: "former defaults refused in production",
+			modify: func(c *Config) {
+				c.Environment = Production
+				c.JWTSecretKey = "published-jwt-secret-of-more-than-32-characters"
+				c.DatabaseURL = "admin_db:published-db-password@tcp(localhost:3306)/talent_management_db"
+			},
+			errs: []string{
+				"JWT_SECRET_KEY must be a random value of at least 32 characters in production",
+				"DATABASE_URL uses a password published with the source code or a placeholder, rotate it",
+			},
+		},
+		{
+			name:   "short secret refused in production",
+			modify: func(c *Config) { c.Environment, c.JWTSecretKey = Production, "s3cr3t-but-short" },
+			errs:   []string{"JWT_SECRET_KEY must be a random value of at least 32 characters in production"},
+		},
+		{
+			name:   "SMTP password required in production",
+			modify: func(c *Config) { c.Environment, c.SMTPHost, c.SMTPUsername = Production, "smtp", "mailer" },
+			errs:   []string{"SMTP_PASSWORD is required in production when SMTP_USERNAME is set"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			cfg := validConfig()
+			tt.modify(cfg)
+
+			// Execute
+			err := cfg.Validate()
+
+			// Assertions
+			if len(tt.errs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.ElementsMatch(t, tt.errs, strings.Split(err.Error(), "\n"))
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	tests := []struct {
+		dsn      string
+		redacted string
+	}{
+		{dsn: "admin:s3cr3t@tcp(db:3306)/interviews?parseTime=true", redacted: "admin:xxxxx@tcp(db:3306)/interviews?parseTime=true"},
+		{dsn: "admin_db:published-db-password@tcp(localhost:3306)/talent_management_db", redacted: "admin_db:xxxxx@tcp(localhost:3306)/talent_management_db"},
+		{dsn: "postgres://admin:s3cr3t@db:5432/interviews", redacted: "postgres://admin:xxxxx@db:5432/interviews"},
+		{dsn: "sqlite:///var/lib/interviews.db", redacted: "sqlite:///var/lib/interviews.db"},
+		{dsn: "", redacted: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dsn, func(t *testing.T) {
+			// Setup
+			cfg := validConfig()
+			cfg.DatabaseURL = tt.dsn
+
+			// Execute
+			redacted := cfg.Redacted()
+
+			// Assertions
+			assert.Equal(t, tt.redacted, redacted.DatabaseURL)
+			assert.Equal(t, "xxxxx", redacted.JWTSecretKey)
+			assert.Empty(t, redacted.SMTPPassword) // Unset secrets stay visibly unset
+			assert.Equal(t, cfg.Port, redacted.Port)
+			assert.Equal(t, productionSecret, cfg.JWTSecretKey) // The original is untouched
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	// Setup
+	clearEnv(t)
+	file := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv("CONFIG_FILE", file)
+	t.Setenv("JWT_SECRET_KEY", productionSecret)
+	require.NoError(t, os.WriteFile(file, []byte("database_url: admin:secret@tcp(db:3306)/interviews\n"), 0o600))
+	current, err := Load(nil)
+	require.NoError(t, err)
+
+	// Execute
+	require.NoError(t, os.WriteFile(file, []byte(`database_url: admin:secret@tcp(db:3306)/interviews
+port: 4000
+feedback_sla: 24h,onsite=48h
+legacy_api_sunset: 2027-10-31
+`), 0o600))
+	next, restart, err := current.Reload(nil)
+	require.NoError(t, os.WriteFile(file, []byte("storage: disk\n"), 0o600))
+	_, _, invalidErr := current.Reload(nil)
+
+	// Assertions
+	require.NoError(t, err)
+	assert.Equal(t, []string{"PORT"}, restart)
+	assert.Equal(t, "3000", next.Port) // Kept until a restart
+	assert.Equal(t, "24h,onsite=48h", next.FeedbackSLA)
+	assert.Equal(t, "2027-10-31", next.LegacyAPISunset)
+	assert.Equal(t, []string{"FEEDBACK_SLA", "LEGACY_API_SUNSET"}, current.Diff(next))
+	assert.Equal(t, "8h", current.FeedbackSLA) // The current configuration is untouched
+	assert.ErrorContains(t, invalidErr, `STORAGE must be database or memory, got "disk"`)
+}
+
+// validConfig returns a configuration that passes validation in production
+func validConfig() *Config {
+	cfg := Defaults()
+	cfg.DatabaseURL = "admin:s3cr3t-rotated@tcp(db:3306)/interviews"
+	cfg.JWTSecretKey = productionSecret
+	return cfg
+}
+
+// publish adds a secret to knownSecrets for the duration of a test, as if it had been published as a default
+func publish(t *testing.T, secret string) {
+	sum := sha256.Sum256([]byte(secret))
+	knownSecrets[hex.EncodeToString(sum[:])] = true
+	t.Cleanup(func() { delete(knownSecrets, hex.EncodeToString(sum[:])) })
+}
+
+// clearEnv unsets every configuration variable for the duration of a test
+func clearEnv(t *testing.T) {
+	t.Helper()
+	keys := []string{"CONFIG_FILE"}
+	for _, field := range reflect.VisibleFields(reflect.TypeOf(Config{})) {
+		keys = append(keys, field.Tag.Get("env"), field.Tag.Get("env")+"_FILE")
+	}
+	for _, key := range keys {
+		t.Setenv(key, "") // Restores the original value once the test ends
+		require.NoError(t, os.Unsetenv(key))
+	}
+}
+
+// writeFile writes a file in a temporary directory and returns its path
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
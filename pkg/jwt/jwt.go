@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/poolcamacho/interviews-service/pkg/problem"
 )
 
 // GenerateToken generates a JWT for a given user
@@ -48,6 +49,7 @@ func ValidateToken(secretKey string, tokenString string) (jwt.MapClaims, error)
 
 // AuthMiddleware is a middleware that validates JWT tokens in HTTP requests
 // @Description Middleware to validate JSON Web Tokens (JWT) for protected routes.
+// Requests without a valid token are answered with a 401 problem details document.
 // @Param secretKey string The secret key used to validate the token.
 // @Return gin.HandlerFunc The middleware function for Gin.
 func AuthMiddleware(secretKey string) gin.HandlerFunc {
@@ -55,16 +57,14 @@ func AuthMiddleware(secretKey string) gin.HandlerFunc {
 		// Extract the Authorization header
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is missing"})
-			c.Abort()
+			problem.Abort(c, problem.New(problem.TypeUnauthorized, http.StatusUnauthorized, "Authorization header is missing"))
 			return
 		}
 
 		// Ensure the token is prefixed with "Bearer "
 		parts := strings.Split(authHeader, " ")
 		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid Authorization header format"})
-			c.Abort()
+			problem.Abort(c, problem.New(problem.TypeUnauthorized, http.StatusUnauthorized, "Invalid Authorization header format"))
 			return
 		}
 
@@ -72,8 +72,7 @@ func AuthMiddleware(secretKey string) gin.HandlerFunc {
 		tokenString := parts[1]
 		claims, err := ValidateToken(secretKey, tokenString)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid token", "details": err.Error()})
-			c.Abort()
+			problem.Abort(c, problem.New(problem.TypeUnauthorized, http.StatusUnauthorized, "Invalid token: "+err.Error()))
 			return
 		}
 
@@ -84,3 +83,24 @@ func AuthMiddleware(secretKey string) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RequireRole is a middleware that only lets through callers whose token has one of the given roles
+// @Description Middleware to restrict a route to the callers whose "role" claim is one of the given roles.
+// It must run after AuthMiddleware; requests without claims or with another role are answered with a 403
+// problem details document.
+// @Param roles ...string The roles allowed to call the route.
+// @Return gin.HandlerFunc The middleware function for Gin.
+func RequireRole(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, _ := c.Get("claims")
+		mapClaims, _ := claims.(jwt.MapClaims)
+		role, _ := mapClaims["role"].(string)
+		for _, allowed := range roles {
+			if role == allowed {
+				c.Next()
+				return
+			}
+		}
+		problem.Abort(c, problem.New(problem.TypeForbidden, http.StatusForbidden, "This route requires one of the roles: "+strings.Join(roles, ", ")))
+	}
+}
@@ -1,17 +1,151 @@
 package logger
 
 import (
-	"log"
-	"os"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
 )
 
-// Init sets up a basic logger with a custom prefix
-// @Description Initializes the application logger with a predefined configuration.
-// Sets the output to standard output, adds date, time, and file information to log entries,
-// and logs an initialization message to indicate that the logger is ready to use.
-// @Success Logs a message "Logger initialized" to standard output.
-func Init() {
-	log.SetOutput(os.Stdout)                             // Log output set to standard output
-	log.SetFlags(log.Ldate | log.Ltime | log.Lshortfile) // Log format includes date, time, and short file name
-	log.Println("Logger initialized")                    // Log initialization message
+// Redacted replaces the value of sensitive attributes
+const Redacted = "[REDACTED]"
+
+// sensitiveParts mark a key as sensitive wherever they appear in it, e.g. service_token or Authorization
+var sensitiveParts = []string{"token", "secret", "password", "authorization", "cookie"}
+
+// sensitiveNames mark a key as sensitive when it is the whole key or its last word, e.g. candidate_email,
+// but not feedback_submitted_at
+var sensitiveNames = []string{"feedback", "email", "reason"}
+
+// contextKey is the type of the key the request logger is stored under
+type contextKey struct{}
+
+// ParseLevel parses a log level
+// @Description Accepts debug, info, warn and error, in any case.
+// @Param s string The level, e.g. from LOG_LEVEL.
+// @Return slog.Level The parsed level.
+// @Return error An error if the level is not one of the four accepted.
+func ParseLevel(s string) (slog.Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return 0, fmt.Errorf("unknown log level %q", s)
+}
+
+// New creates a logger writing one JSON object per line
+// @Description Attributes whose key looks sensitive, such as feedback, emails, cancellation reasons, tokens and
+// passwords, are written as [REDACTED]. Structs, maps and slices are inspected field by field under their JSON
+// names, so logging a whole interview does not leak its feedback.
+// @Param w io.Writer The output, usually os.Stdout.
+// @Param level slog.Leveler The lowest level written; a *slog.LevelVar can be changed while the logger is in use.
+// @Return *slog.Logger A pointer to the logger.
+func New(w io.Writer, level slog.Leveler) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level, ReplaceAttr: redact}))
+}
+
+// Init makes New the default logger
+// @Description Sets the slog default logger, through which the stdlib log package writes as well, so messages
+// logged with log.Printf become JSON lines at the info level.
+// @Param w io.Writer The output, usually os.Stdout.
+// @Param level slog.Leveler The lowest level written.
+func Init(w io.Writer, level slog.Leveler) {
+	slog.SetDefault(New(w, level))
+}
+
+// WithContext returns a copy of ctx carrying a logger
+// @Description Used by middleware to hand a logger annotated with the request ID to everything serving the request.
+// @Param ctx context.Context The parent context.
+// @Param l *slog.Logger The logger to carry.
+// @Return context.Context The context carrying the logger.
+func WithContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger carried by ctx
+// @Description Falls back to the default logger when ctx carries none, e.g. in background workers.
+// @Param ctx context.Context The context of the call.
+// @Return *slog.Logger A pointer to the logger.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(contextKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+// redact is the ReplaceAttr of the handler, hiding the values of sensitive attributes
+func redact(groups []string, a slog.Attr) slog.Attr {
+	for _, group := range groups {
+		if isSensitive(group) {
+			return slog.String(a.Key, Redacted)
+		}
+	}
+	if isSensitive(a.Key) {
+		return slog.String(a.Key, Redacted)
+	}
+	if a.Value.Kind() == slog.KindAny {
+		if _, ok := a.Value.Any().(error); !ok {
+			if cleaned, ok := redactValue(a.Value.Any()); ok {
+				return slog.Any(a.Key, cleaned)
+			}
+		}
+	}
+	return a
+}
+
+// redactValue returns the JSON form of a struct, map or slice with its sensitive keys redacted
+// Other values, and values that cannot be encoded, are reported as not changed.
+func redactValue(v any) (any, bool) {
+	encoded, err := json.Marshal(v)
+	if err != nil || len(encoded) == 0 || (encoded[0] != '{' && encoded[0] != '[') {
+		return nil, false
+	}
+	var decoded any
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, false
+	}
+	return redactJSON(decoded), true
+}
+
+// redactJSON redacts the sensitive keys of decoded JSON at any depth
+func redactJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = Redacted
+			} else {
+				v[key] = redactJSON(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactJSON(value)
+		}
+	}
+	return v
+}
+
+// isSensitive reports whether the value of a key must not be logged
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	for _, name := range sensitiveNames {
+		if key == name || strings.HasSuffix(key, "_"+name) {
+			return true
+		}
+	}
+	return false
 }
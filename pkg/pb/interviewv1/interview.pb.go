@@ -0,0 +1,863 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: interview/v1/interview.proto
+
+// Package interview.v1 exposes the interviews service over gRPC.
+// It mirrors the HTTP/JSON API and shares its service layer.
+
+package interviewv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Interview is an interview and the people taking part in it.
+type Interview struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CandidateId        int64                  `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	JobId              int64                  `protobuf:"varint,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	InterviewDate      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=interview_date,json=interviewDate,proto3" json:"interview_date,omitempty"`
+	Feedback           string                 `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Stage              string                 `protobuf:"bytes,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	CandidateEmail     string                 `protobuf:"bytes,7,opt,name=candidate_email,json=candidateEmail,proto3" json:"candidate_email,omitempty"`
+	Panel              []*Panelist            `protobuf:"bytes,8,rep,name=panel,proto3" json:"panel,omitempty"`
+	Status             string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	CancellationReason string                 `protobuf:"bytes,10,opt,name=cancellation_reason,json=cancellationReason,proto3" json:"cancellation_reason,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Interview) Reset() {
+	*x = Interview{}
+	mi := &file_interview_v1_interview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interview) ProtoMessage() {}
+
+func (x *Interview) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interview.ProtoReflect.Descriptor instead.
+func (*Interview) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Interview) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Interview) GetCandidateId() int64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *Interview) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *Interview) GetInterviewDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InterviewDate
+	}
+	return nil
+}
+
+func (x *Interview) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Interview) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *Interview) GetCandidateEmail() string {
+	if x != nil {
+		return x.CandidateEmail
+	}
+	return ""
+}
+
+func (x *Interview) GetPanel() []*Panelist {
+	if x != nil {
+		return x.Panel
+	}
+	return nil
+}
+
+func (x *Interview) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Interview) GetCancellationReason() string {
+	if x != nil {
+		return x.CancellationReason
+	}
+	return ""
+}
+
+// Panelist is an interviewer assigned to an interview.
+type Panelist struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InterviewId         int64                  `protobuf:"varint,2,opt,name=interview_id,json=interviewId,proto3" json:"interview_id,omitempty"`
+	Name                string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Email               string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Feedback            string                 `protobuf:"bytes,5,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	FeedbackSubmittedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=feedback_submitted_at,json=feedbackSubmittedAt,proto3" json:"feedback_submitted_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Panelist) Reset() {
+	*x = Panelist{}
+	mi := &file_interview_v1_interview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Panelist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Panelist) ProtoMessage() {}
+
+func (x *Panelist) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Panelist.ProtoReflect.Descriptor instead.
+func (*Panelist) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Panelist) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Panelist) GetInterviewId() int64 {
+	if x != nil {
+		return x.InterviewId
+	}
+	return 0
+}
+
+func (x *Panelist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Panelist) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Panelist) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Panelist) GetFeedbackSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FeedbackSubmittedAt
+	}
+	return nil
+}
+
+// InterviewEvent describes a change to an interview.
+type InterviewEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind of change: interview.created, interview.rescheduled or interview.cancelled.
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Interview     *Interview             `protobuf:"bytes,3,opt,name=interview,proto3" json:"interview,omitempty"`
+	PreviousDate  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=previous_date,json=previousDate,proto3" json:"previous_date,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterviewEvent) Reset() {
+	*x = InterviewEvent{}
+	mi := &file_interview_v1_interview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterviewEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterviewEvent) ProtoMessage() {}
+
+func (x *InterviewEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterviewEvent.ProtoReflect.Descriptor instead.
+func (*InterviewEvent) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InterviewEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InterviewEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InterviewEvent) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+func (x *InterviewEvent) GetPreviousDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PreviousDate
+	}
+	return nil
+}
+
+func (x *InterviewEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InterviewEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ListInterviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterviewsRequest) Reset() {
+	*x = ListInterviewsRequest{}
+	mi := &file_interview_v1_interview_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterviewsRequest) ProtoMessage() {}
+
+func (x *ListInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{3}
+}
+
+type ListInterviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interviews    []*Interview           `protobuf:"bytes,1,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterviewsResponse) Reset() {
+	*x = ListInterviewsResponse{}
+	mi := &file_interview_v1_interview_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterviewsResponse) ProtoMessage() {}
+
+func (x *ListInterviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListInterviewsResponse) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListInterviewsResponse) GetInterviews() []*Interview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+type GetInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInterviewRequest) Reset() {
+	*x = GetInterviewRequest{}
+	mi := &file_interview_v1_interview_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInterviewRequest) ProtoMessage() {}
+
+func (x *GetInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInterviewRequest.ProtoReflect.Descriptor instead.
+func (*GetInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetInterviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateInterviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId    int64                  `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	JobId          int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	InterviewDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=interview_date,json=interviewDate,proto3" json:"interview_date,omitempty"`
+	Feedback       string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	Stage          string                 `protobuf:"bytes,5,opt,name=stage,proto3" json:"stage,omitempty"`
+	CandidateEmail string                 `protobuf:"bytes,6,opt,name=candidate_email,json=candidateEmail,proto3" json:"candidate_email,omitempty"`
+	Panel          []*Panelist            `protobuf:"bytes,7,rep,name=panel,proto3" json:"panel,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateInterviewRequest) Reset() {
+	*x = CreateInterviewRequest{}
+	mi := &file_interview_v1_interview_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInterviewRequest) ProtoMessage() {}
+
+func (x *CreateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateInterviewRequest) GetCandidateId() int64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *CreateInterviewRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *CreateInterviewRequest) GetInterviewDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InterviewDate
+	}
+	return nil
+}
+
+func (x *CreateInterviewRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetCandidateEmail() string {
+	if x != nil {
+		return x.CandidateEmail
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetPanel() []*Panelist {
+	if x != nil {
+		return x.Panel
+	}
+	return nil
+}
+
+// UpdateInterviewRequest only changes the fields that are set.
+type UpdateInterviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	InterviewDate  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=interview_date,json=interviewDate,proto3" json:"interview_date,omitempty"`
+	Feedback       *string                `protobuf:"bytes,3,opt,name=feedback,proto3,oneof" json:"feedback,omitempty"`
+	Stage          *string                `protobuf:"bytes,4,opt,name=stage,proto3,oneof" json:"stage,omitempty"`
+	CandidateEmail *string                `protobuf:"bytes,5,opt,name=candidate_email,json=candidateEmail,proto3,oneof" json:"candidate_email,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateInterviewRequest) Reset() {
+	*x = UpdateInterviewRequest{}
+	mi := &file_interview_v1_interview_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterviewRequest) ProtoMessage() {}
+
+func (x *UpdateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateInterviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateInterviewRequest) GetInterviewDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InterviewDate
+	}
+	return nil
+}
+
+func (x *UpdateInterviewRequest) GetFeedback() string {
+	if x != nil && x.Feedback != nil {
+		return *x.Feedback
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetStage() string {
+	if x != nil && x.Stage != nil {
+		return *x.Stage
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetCandidateEmail() string {
+	if x != nil && x.CandidateEmail != nil {
+		return *x.CandidateEmail
+	}
+	return ""
+}
+
+type CancelInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInterviewRequest) Reset() {
+	*x = CancelInterviewRequest{}
+	mi := &file_interview_v1_interview_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInterviewRequest) ProtoMessage() {}
+
+func (x *CancelInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInterviewRequest.ProtoReflect.Descriptor instead.
+func (*CancelInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelInterviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelInterviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// WatchInterviewsRequest filters the streamed events; zero values match every interview.
+type WatchInterviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CandidateId   int64                  `protobuf:"varint,1,opt,name=candidate_id,json=candidateId,proto3" json:"candidate_id,omitempty"`
+	JobId         int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchInterviewsRequest) Reset() {
+	*x = WatchInterviewsRequest{}
+	mi := &file_interview_v1_interview_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInterviewsRequest) ProtoMessage() {}
+
+func (x *WatchInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_interview_v1_interview_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*WatchInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_interview_v1_interview_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchInterviewsRequest) GetCandidateId() int64 {
+	if x != nil {
+		return x.CandidateId
+	}
+	return 0
+}
+
+func (x *WatchInterviewsRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+var File_interview_v1_interview_proto protoreflect.FileDescriptor
+
+const file_interview_v1_interview_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinterview/v1/interview.proto\x12\finterview.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xea\x02\n" +
+	"\tInterview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\fcandidate_id\x18\x02 \x01(\x03R\vcandidateId\x12\x15\n" +
+	"\x06job_id\x18\x03 \x01(\x03R\x05jobId\x12A\n" +
+	"\x0einterview_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rinterviewDate\x12\x1a\n" +
+	"\bfeedback\x18\x05 \x01(\tR\bfeedback\x12\x14\n" +
+	"\x05stage\x18\x06 \x01(\tR\x05stage\x12'\n" +
+	"\x0fcandidate_email\x18\a \x01(\tR\x0ecandidateEmail\x12,\n" +
+	"\x05panel\x18\b \x03(\v2\x16.interview.v1.PanelistR\x05panel\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12/\n" +
+	"\x13cancellation_reason\x18\n" +
+	" \x01(\tR\x12cancellationReason\"\xd3\x01\n" +
+	"\bPanelist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\finterview_id\x18\x02 \x01(\x03R\vinterviewId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1a\n" +
+	"\bfeedback\x18\x05 \x01(\tR\bfeedback\x12N\n" +
+	"\x15feedback_submitted_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x13feedbackSubmittedAt\"\x81\x02\n" +
+	"\x0eInterviewEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x125\n" +
+	"\tinterview\x18\x03 \x01(\v2\x17.interview.v1.InterviewR\tinterview\x12?\n" +
+	"\rprevious_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\fpreviousDate\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x17\n" +
+	"\x15ListInterviewsRequest\"Q\n" +
+	"\x16ListInterviewsResponse\x127\n" +
+	"\n" +
+	"interviews\x18\x01 \x03(\v2\x17.interview.v1.InterviewR\n" +
+	"interviews\"%\n" +
+	"\x13GetInterviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x9e\x02\n" +
+	"\x16CreateInterviewRequest\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\x03R\vcandidateId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x03R\x05jobId\x12A\n" +
+	"\x0einterview_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rinterviewDate\x12\x1a\n" +
+	"\bfeedback\x18\x04 \x01(\tR\bfeedback\x12\x14\n" +
+	"\x05stage\x18\x05 \x01(\tR\x05stage\x12'\n" +
+	"\x0fcandidate_email\x18\x06 \x01(\tR\x0ecandidateEmail\x12,\n" +
+	"\x05panel\x18\a \x03(\v2\x16.interview.v1.PanelistR\x05panel\"\x80\x02\n" +
+	"\x16UpdateInterviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12A\n" +
+	"\x0einterview_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rinterviewDate\x12\x1f\n" +
+	"\bfeedback\x18\x03 \x01(\tH\x00R\bfeedback\x88\x01\x01\x12\x19\n" +
+	"\x05stage\x18\x04 \x01(\tH\x01R\x05stage\x88\x01\x01\x12,\n" +
+	"\x0fcandidate_email\x18\x05 \x01(\tH\x02R\x0ecandidateEmail\x88\x01\x01B\v\n" +
+	"\t_feedbackB\b\n" +
+	"\x06_stageB\x12\n" +
+	"\x10_candidate_email\"@\n" +
+	"\x16CancelInterviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"R\n" +
+	"\x16WatchInterviewsRequest\x12!\n" +
+	"\fcandidate_id\x18\x01 \x01(\x03R\vcandidateId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x03R\x05jobId2\x8a\x04\n" +
+	"\x10InterviewService\x12[\n" +
+	"\x0eListInterviews\x12#.interview.v1.ListInterviewsRequest\x1a$.interview.v1.ListInterviewsResponse\x12J\n" +
+	"\fGetInterview\x12!.interview.v1.GetInterviewRequest\x1a\x17.interview.v1.Interview\x12P\n" +
+	"\x0fCreateInterview\x12$.interview.v1.CreateInterviewRequest\x1a\x17.interview.v1.Interview\x12P\n" +
+	"\x0fUpdateInterview\x12$.interview.v1.UpdateInterviewRequest\x1a\x17.interview.v1.Interview\x12P\n" +
+	"\x0fCancelInterview\x12$.interview.v1.CancelInterviewRequest\x1a\x17.interview.v1.Interview\x12W\n" +
+	"\x0fWatchInterviews\x12$.interview.v1.WatchInterviewsRequest\x1a\x1c.interview.v1.InterviewEvent0\x01BJZHgithub.com/poolcamacho/interviews-service/pkg/pb/interviewv1;interviewv1b\x06proto3"
+
+var (
+	file_interview_v1_interview_proto_rawDescOnce sync.Once
+	file_interview_v1_interview_proto_rawDescData []byte
+)
+
+func file_interview_v1_interview_proto_rawDescGZIP() []byte {
+	file_interview_v1_interview_proto_rawDescOnce.Do(func() {
+		file_interview_v1_interview_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_interview_v1_interview_proto_rawDesc), len(file_interview_v1_interview_proto_rawDesc)))
+	})
+	return file_interview_v1_interview_proto_rawDescData
+}
+
+var file_interview_v1_interview_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_interview_v1_interview_proto_goTypes = []any{
+	(*Interview)(nil),              // 0: interview.v1.Interview
+	(*Panelist)(nil),               // 1: interview.v1.Panelist
+	(*InterviewEvent)(nil),         // 2: interview.v1.InterviewEvent
+	(*ListInterviewsRequest)(nil),  // 3: interview.v1.ListInterviewsRequest
+	(*ListInterviewsResponse)(nil), // 4: interview.v1.ListInterviewsResponse
+	(*GetInterviewRequest)(nil),    // 5: interview.v1.GetInterviewRequest
+	(*CreateInterviewRequest)(nil), // 6: interview.v1.CreateInterviewRequest
+	(*UpdateInterviewRequest)(nil), // 7: interview.v1.UpdateInterviewRequest
+	(*CancelInterviewRequest)(nil), // 8: interview.v1.CancelInterviewRequest
+	(*WatchInterviewsRequest)(nil), // 9: interview.v1.WatchInterviewsRequest
+	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
+}
+var file_interview_v1_interview_proto_depIdxs = []int32{
+	10, // 0: interview.v1.Interview.interview_date:type_name -> google.protobuf.Timestamp
+	1,  // 1: interview.v1.Interview.panel:type_name -> interview.v1.Panelist
+	10, // 2: interview.v1.Panelist.feedback_submitted_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: interview.v1.InterviewEvent.interview:type_name -> interview.v1.Interview
+	10, // 4: interview.v1.InterviewEvent.previous_date:type_name -> google.protobuf.Timestamp
+	10, // 5: interview.v1.InterviewEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: interview.v1.ListInterviewsResponse.interviews:type_name -> interview.v1.Interview
+	10, // 7: interview.v1.CreateInterviewRequest.interview_date:type_name -> google.protobuf.Timestamp
+	1,  // 8: interview.v1.CreateInterviewRequest.panel:type_name -> interview.v1.Panelist
+	10, // 9: interview.v1.UpdateInterviewRequest.interview_date:type_name -> google.protobuf.Timestamp
+	3,  // 10: interview.v1.InterviewService.ListInterviews:input_type -> interview.v1.ListInterviewsRequest
+	5,  // 11: interview.v1.InterviewService.GetInterview:input_type -> interview.v1.GetInterviewRequest
+	6,  // 12: interview.v1.InterviewService.CreateInterview:input_type -> interview.v1.CreateInterviewRequest
+	7,  // 13: interview.v1.InterviewService.UpdateInterview:input_type -> interview.v1.UpdateInterviewRequest
+	8,  // 14: interview.v1.InterviewService.CancelInterview:input_type -> interview.v1.CancelInterviewRequest
+	9,  // 15: interview.v1.InterviewService.WatchInterviews:input_type -> interview.v1.WatchInterviewsRequest
+	4,  // 16: interview.v1.InterviewService.ListInterviews:output_type -> interview.v1.ListInterviewsResponse
+	0,  // 17: interview.v1.InterviewService.GetInterview:output_type -> interview.v1.Interview
+	0,  // 18: interview.v1.InterviewService.CreateInterview:output_type -> interview.v1.Interview
+	0,  // 19: interview.v1.InterviewService.UpdateInterview:output_type -> interview.v1.Interview
+	0,  // 20: interview.v1.InterviewService.CancelInterview:output_type -> interview.v1.Interview
+	2,  // 21: interview.v1.InterviewService.WatchInterviews:output_type -> interview.v1.InterviewEvent
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_interview_v1_interview_proto_init() }
+func file_interview_v1_interview_proto_init() {
+	if File_interview_v1_interview_proto != nil {
+		return
+	}
+	file_interview_v1_interview_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_interview_v1_interview_proto_rawDesc), len(file_interview_v1_interview_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_interview_v1_interview_proto_goTypes,
+		DependencyIndexes: file_interview_v1_interview_proto_depIdxs,
+		MessageInfos:      file_interview_v1_interview_proto_msgTypes,
+	}.Build()
+	File_interview_v1_interview_proto = out.File
+	file_interview_v1_interview_proto_goTypes = nil
+	file_interview_v1_interview_proto_depIdxs = nil
+}
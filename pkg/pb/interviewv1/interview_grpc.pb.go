@@ -0,0 +1,336 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: interview/v1/interview.proto
+
+// Package interview.v1 exposes the interviews service over gRPC.
+// It mirrors the HTTP/JSON API and shares its service layer.
+
+package interviewv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	InterviewService_ListInterviews_FullMethodName  = "/interview.v1.InterviewService/ListInterviews"
+	InterviewService_GetInterview_FullMethodName    = "/interview.v1.InterviewService/GetInterview"
+	InterviewService_CreateInterview_FullMethodName = "/interview.v1.InterviewService/CreateInterview"
+	InterviewService_UpdateInterview_FullMethodName = "/interview.v1.InterviewService/UpdateInterview"
+	InterviewService_CancelInterview_FullMethodName = "/interview.v1.InterviewService/CancelInterview"
+	InterviewService_WatchInterviews_FullMethodName = "/interview.v1.InterviewService/WatchInterviews"
+)
+
+// InterviewServiceClient is the client API for InterviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// InterviewService manages interviews.
+// Every call requires a JWT in the "authorization: Bearer <token>" metadata entry.
+type InterviewServiceClient interface {
+	// ListInterviews returns every interview.
+	ListInterviews(ctx context.Context, in *ListInterviewsRequest, opts ...grpc.CallOption) (*ListInterviewsResponse, error)
+	// GetInterview returns a single interview with its panel.
+	GetInterview(ctx context.Context, in *GetInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	// CreateInterview schedules a new interview and notifies its participants.
+	CreateInterview(ctx context.Context, in *CreateInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	// UpdateInterview changes the fields that are set; moving the date notifies participants.
+	UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	// CancelInterview cancels a scheduled interview and notifies its participants.
+	CancelInterview(ctx context.Context, in *CancelInterviewRequest, opts ...grpc.CallOption) (*Interview, error)
+	// WatchInterviews streams interview changes as they are published.
+	WatchInterviews(ctx context.Context, in *WatchInterviewsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterviewEvent], error)
+}
+
+type interviewServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInterviewServiceClient(cc grpc.ClientConnInterface) InterviewServiceClient {
+	return &interviewServiceClient{cc}
+}
+
+func (c *interviewServiceClient) ListInterviews(ctx context.Context, in *ListInterviewsRequest, opts ...grpc.CallOption) (*ListInterviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInterviewsResponse)
+	err := c.cc.Invoke(ctx, InterviewService_ListInterviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) GetInterview(ctx context.Context, in *GetInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_GetInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) CreateInterview(ctx context.Context, in *CreateInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_CreateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) UpdateInterview(ctx context.Context, in *UpdateInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_UpdateInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) CancelInterview(ctx context.Context, in *CancelInterviewRequest, opts ...grpc.CallOption) (*Interview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Interview)
+	err := c.cc.Invoke(ctx, InterviewService_CancelInterview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *interviewServiceClient) WatchInterviews(ctx context.Context, in *WatchInterviewsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterviewEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InterviewService_ServiceDesc.Streams[0], InterviewService_WatchInterviews_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInterviewsRequest, InterviewEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InterviewService_WatchInterviewsClient = grpc.ServerStreamingClient[InterviewEvent]
+
+// InterviewServiceServer is the server API for InterviewService service.
+// All implementations must embed UnimplementedInterviewServiceServer
+// for forward compatibility.
+//
+// InterviewService manages interviews.
+// Every call requires a JWT in the "authorization: Bearer <token>" metadata entry.
+type InterviewServiceServer interface {
+	// ListInterviews returns every interview.
+	ListInterviews(context.Context, *ListInterviewsRequest) (*ListInterviewsResponse, error)
+	// GetInterview returns a single interview with its panel.
+	GetInterview(context.Context, *GetInterviewRequest) (*Interview, error)
+	// CreateInterview schedules a new interview and notifies its participants.
+	CreateInterview(context.Context, *CreateInterviewRequest) (*Interview, error)
+	// UpdateInterview changes the fields that are set; moving the date notifies participants.
+	UpdateInterview(context.Context, *UpdateInterviewRequest) (*Interview, error)
+	// CancelInterview cancels a scheduled interview and notifies its participants.
+	CancelInterview(context.Context, *CancelInterviewRequest) (*Interview, error)
+	// WatchInterviews streams interview changes as they are published.
+	WatchInterviews(*WatchInterviewsRequest, grpc.ServerStreamingServer[InterviewEvent]) error
+	mustEmbedUnimplementedInterviewServiceServer()
+}
+
+// UnimplementedInterviewServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInterviewServiceServer struct{}
+
+func (UnimplementedInterviewServiceServer) ListInterviews(context.Context, *ListInterviewsRequest) (*ListInterviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInterviews not implemented")
+}
+func (UnimplementedInterviewServiceServer) GetInterview(context.Context, *GetInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) CreateInterview(context.Context, *CreateInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) UpdateInterview(context.Context, *UpdateInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) CancelInterview(context.Context, *CancelInterviewRequest) (*Interview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInterview not implemented")
+}
+func (UnimplementedInterviewServiceServer) WatchInterviews(*WatchInterviewsRequest, grpc.ServerStreamingServer[InterviewEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInterviews not implemented")
+}
+func (UnimplementedInterviewServiceServer) mustEmbedUnimplementedInterviewServiceServer() {}
+func (UnimplementedInterviewServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInterviewServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InterviewServiceServer will
+// result in compilation errors.
+type UnsafeInterviewServiceServer interface {
+	mustEmbedUnimplementedInterviewServiceServer()
+}
+
+func RegisterInterviewServiceServer(s grpc.ServiceRegistrar, srv InterviewServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInterviewServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InterviewService_ServiceDesc, srv)
+}
+
+func _InterviewService_ListInterviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInterviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).ListInterviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_ListInterviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).ListInterviews(ctx, req.(*ListInterviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_GetInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).GetInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_GetInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).GetInterview(ctx, req.(*GetInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_CreateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).CreateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_CreateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).CreateInterview(ctx, req.(*CreateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_UpdateInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).UpdateInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_UpdateInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).UpdateInterview(ctx, req.(*UpdateInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_CancelInterview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInterviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InterviewServiceServer).CancelInterview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InterviewService_CancelInterview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InterviewServiceServer).CancelInterview(ctx, req.(*CancelInterviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InterviewService_WatchInterviews_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInterviewsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InterviewServiceServer).WatchInterviews(m, &grpc.GenericServerStream[WatchInterviewsRequest, InterviewEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InterviewService_WatchInterviewsServer = grpc.ServerStreamingServer[InterviewEvent]
+
+// InterviewService_ServiceDesc is the grpc.ServiceDesc for InterviewService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InterviewService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "interview.v1.InterviewService",
+	HandlerType: (*InterviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListInterviews",
+			Handler:    _InterviewService_ListInterviews_Handler,
+		},
+		{
+			MethodName: "GetInterview",
+			Handler:    _InterviewService_GetInterview_Handler,
+		},
+		{
+			MethodName: "CreateInterview",
+			Handler:    _InterviewService_CreateInterview_Handler,
+		},
+		{
+			MethodName: "UpdateInterview",
+			Handler:    _InterviewService_UpdateInterview_Handler,
+		},
+		{
+			MethodName: "CancelInterview",
+			Handler:    _InterviewService_CancelInterview_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInterviews",
+			Handler:       _InterviewService_WatchInterviews_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "interview/v1/interview.proto",
+}
@@ -0,0 +1,76 @@
+package problem
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ContentType is the media type of problem details documents (RFC 7807)
+const ContentType = "application/problem+json"
+
+// Stable identifiers of the problem types returned by the service
+// They are relative URIs, resolved against the service's base URL, and never change once published.
+const (
+	TypeInvalidRequest = "/problems/invalid-request"  // The request could not be parsed
+	TypeValidation     = "/problems/validation-error" // The request was parsed but some fields are invalid
+	TypeUnauthorized   = "/problems/unauthorized"     // The request lacks valid credentials
+	TypeForbidden      = "/problems/forbidden"        // The caller is not allowed to perform the operation
+	TypeNotFound       = "/problems/not-found"        // The resource does not exist
+	TypeConflict       = "/problems/conflict"         // The resource is in a state that does not allow the operation
+	TypeGone           = "/problems/gone"             // The route was removed
+	TypeInternal       = "/problems/internal-error"   // The service failed unexpectedly
+	TypeUnavailable    = "/problems/unavailable"      // A service this one depends on cannot be reached
+)
+
+// FieldError describes why a single request field is invalid
+type FieldError struct {
+	Field   string `json:"field"`   // Name of the field as sent by the client, e.g. candidate_id
+	Message string `json:"message"` // What is wrong with the value
+}
+
+// Problem is a problem details document (RFC 7807)
+type Problem struct {
+	Type     string       `json:"type"`             // URI identifying the problem type
+	Title    string       `json:"title"`            // Short summary of the problem type
+	Status   int          `json:"status"`           // HTTP status code
+	Detail   string       `json:"detail,omitempty"` // Explanation specific to this occurrence
+	Instance string       `json:"instance"`         // Path of the request that caused the problem
+	Errors   []FieldError `json:"errors,omitempty"` // Invalid fields, only set for validation errors
+}
+
+// titles holds the title of every problem type
+var titles = map[string]string{
+	TypeInvalidRequest: "Invalid request",
+	TypeValidation:     "Validation failed",
+	TypeUnauthorized:   "Unauthorized",
+	TypeForbidden:      "Forbidden",
+	TypeNotFound:       "Resource not found",
+	TypeConflict:       "Conflict",
+	TypeGone:           "Gone",
+	TypeInternal:       "Internal server error",
+	TypeUnavailable:    "Service unavailable",
+}
+
+// New builds a problem of the given type
+// @Description Builds a problem details document for the status code; the title is derived from the type,
+// one of the Type constants, and detail explains this occurrence and may be empty.
+// @Return Problem The problem, without an instance.
+func New(problemType string, status int, detail string) Problem {
+	title, ok := titles[problemType]
+	if !ok {
+		title = http.StatusText(status)
+	}
+	return Problem{Type: problemType, Title: title, Status: status, Detail: detail}
+}
+
+// Abort writes a problem as the response and stops the handler chain
+// @Description Sends the problem with the application/problem+json content type. The instance is set to
+// the request path when empty.
+func Abort(c *gin.Context, p Problem) {
+	if p.Instance == "" {
+		p.Instance = c.Request.URL.Path
+	}
+	c.Header("Content-Type", ContentType)
+	c.AbortWithStatusJSON(p.Status, p)
+}